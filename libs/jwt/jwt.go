@@ -13,18 +13,27 @@ import (
 var ErrTokenInvalid = errors.New("token.invalid")
 
 func Create(ttl time.Duration, userID, userRole, privateKey string) (string, error) {
+	return CreateWithClaims(ttl, map[string]any{
+		"sub":  userID,
+		"role": userRole,
+	}, privateKey)
+}
+
+// CreateWithClaims signs claims with exp set to ttl from now. claims is not
+// modified.
+func CreateWithClaims(ttl time.Duration, claims map[string]any, privateKey string) (string, error) {
 	key, err := parse.ParsePrivateKey(privateKey)
 	if err != nil {
 		return "", err
 	}
 
-	claims := jwt.MapClaims{
-		"sub":  userID,
-		"role": userRole,
-		"exp":  time.Now().Add(ttl).Unix(),
+	mapClaims := make(jwt.MapClaims, len(claims)+1)
+	for name, value := range claims {
+		mapClaims[name] = value
 	}
+	mapClaims["exp"] = time.Now().Add(ttl).Unix()
 
-	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(key)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, mapClaims).SignedString(key)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,3 @@
+package adapters
+
+const ErrUnknownFormat = "unknown log format"
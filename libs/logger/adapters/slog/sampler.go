@@ -0,0 +1,69 @@
+package adapters
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger/domain"
+)
+
+// sampler mirrors zap's sampler: records are counted by level and message
+// within every tick.
+type sampler struct {
+	slog.Handler
+	sampling domain.Sampling
+	state    *samplerState
+}
+
+type samplerState struct {
+	mu     sync.Mutex
+	start  time.Time
+	counts map[samplerKey]int
+}
+
+type samplerKey struct {
+	level slog.Level
+	msg   string
+}
+
+func newSampler(handler slog.Handler, sampling domain.Sampling) slog.Handler {
+	return &sampler{handler, sampling, &samplerState{counts: make(map[samplerKey]int)}}
+}
+
+func (s *sampler) Handle(ctx context.Context, record slog.Record) error {
+	if !s.allow(record) {
+		return nil
+	}
+
+	return s.Handler.Handle(ctx, record)
+}
+
+func (s *sampler) allow(record slog.Record) bool {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+
+	if record.Time.Sub(s.state.start) >= s.sampling.Tick {
+		s.state.start = record.Time
+		clear(s.state.counts)
+	}
+
+	key := samplerKey{record.Level, record.Message}
+	s.state.counts[key]++
+	n := s.state.counts[key]
+
+	if n <= s.sampling.Initial {
+		return true
+	}
+
+	return s.sampling.Thereafter > 0 && (n-s.sampling.Initial)%s.sampling.Thereafter == 0
+}
+
+func (s *sampler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &sampler{s.Handler.WithAttrs(attrs), s.sampling, s.state}
+}
+
+func (s *sampler) WithGroup(name string) slog.Handler {
+	return &sampler{s.Handler.WithGroup(name), s.sampling, s.state}
+}
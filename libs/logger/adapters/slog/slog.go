@@ -0,0 +1,231 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger/domain"
+	"github.com/BlazeCoder04/online_store/libs/logger/pkg/formatter"
+)
+
+const (
+	componentKey = "component"
+	errorKey     = "error"
+
+	// levelFatal and levelPanic sit above slog.LevelError, which is the
+	// highest level slog knows about, in the order of the domain levels.
+	levelFatal = slog.Level(10)
+	levelPanic = slog.Level(12)
+)
+
+type SlogAdapter struct {
+	handler   slog.Handler
+	attrs     []slog.Attr
+	formatter *formatter.Formatter
+	levels    *domain.LevelSet
+}
+
+func toRouterAttrs(fields []domain.Field) []slog.Attr {
+	converted := make([]slog.Attr, 0, len(fields))
+	for _, field := range fields {
+		key := field.Key
+		if _, ok := field.Value.(error); ok && key == "" {
+			key = errorKey
+		}
+
+		converted = append(converted, slog.Any(key, field.Value))
+	}
+
+	return converted
+}
+
+func (s *SlogAdapter) log(ctx context.Context, level domain.Level, tag, msg string, fields []domain.Field) {
+	formattedMsg := s.formatter.FormatMessage(msg)
+
+	if s.levels.Enabled(tag, level) {
+		if ctx == nil {
+			ctx = context.Background()
+		}
+
+		record := slog.NewRecord(time.Now(), toRouterLevel(level), formattedMsg, 0)
+		record.AddAttrs(slog.String(componentKey, tag))
+		record.AddAttrs(s.attrs...)
+		record.AddAttrs(toRouterAttrs(fields)...)
+
+		_ = s.handler.Handle(ctx, record)
+	}
+
+	// Panic and Fatal behave as in zap, even when the entry was filtered out.
+	switch level {
+	case domain.LevelPanic:
+		panic(formattedMsg)
+	case domain.LevelFatal:
+		os.Exit(1)
+	}
+}
+
+func (s *SlogAdapter) Debug(tag, msg string, fields ...domain.Field) {
+	s.log(context.Background(), domain.LevelDebug, tag, msg, fields)
+}
+
+func (s *SlogAdapter) Info(tag, msg string, fields ...domain.Field) {
+	s.log(context.Background(), domain.LevelInfo, tag, msg, fields)
+}
+
+func (s *SlogAdapter) Warn(tag, msg string, fields ...domain.Field) {
+	s.log(context.Background(), domain.LevelWarn, tag, msg, fields)
+}
+
+func (s *SlogAdapter) Error(tag, msg string, fields ...domain.Field) {
+	s.log(context.Background(), domain.LevelError, tag, msg, fields)
+}
+
+func (s *SlogAdapter) Panic(tag, msg string, fields ...domain.Field) {
+	s.log(context.Background(), domain.LevelPanic, tag, msg, fields)
+}
+
+func (s *SlogAdapter) Fatal(tag, msg string, fields ...domain.Field) {
+	s.log(context.Background(), domain.LevelFatal, tag, msg, fields)
+}
+
+func (s *SlogAdapter) DebugCtx(ctx context.Context, tag, msg string, fields ...domain.Field) {
+	s.log(ctx, domain.LevelDebug, tag, msg, append(fields, domain.FieldsFromContext(ctx)...))
+}
+
+func (s *SlogAdapter) InfoCtx(ctx context.Context, tag, msg string, fields ...domain.Field) {
+	s.log(ctx, domain.LevelInfo, tag, msg, append(fields, domain.FieldsFromContext(ctx)...))
+}
+
+func (s *SlogAdapter) WarnCtx(ctx context.Context, tag, msg string, fields ...domain.Field) {
+	s.log(ctx, domain.LevelWarn, tag, msg, append(fields, domain.FieldsFromContext(ctx)...))
+}
+
+func (s *SlogAdapter) ErrorCtx(ctx context.Context, tag, msg string, fields ...domain.Field) {
+	s.log(ctx, domain.LevelError, tag, msg, append(fields, domain.FieldsFromContext(ctx)...))
+}
+
+func (s *SlogAdapter) WithContext(ctx context.Context) domain.Logger {
+	return s.WithFields(domain.FieldsFromContext(ctx)...)
+}
+
+func (s *SlogAdapter) WithFields(fields ...domain.Field) domain.Logger {
+	attrs := make([]slog.Attr, 0, len(fields)+len(s.attrs))
+	attrs = append(attrs, toRouterAttrs(fields)...)
+	attrs = append(attrs, s.attrs...)
+
+	return &SlogAdapter{
+		handler:   s.handler,
+		attrs:     attrs,
+		formatter: s.formatter,
+		levels:    s.levels,
+	}
+}
+
+// Named returns a child whose messages are prefixed with "[prefix]".
+func (s *SlogAdapter) Named(prefix string) domain.Logger {
+	return &SlogAdapter{
+		handler:   s.handler,
+		attrs:     s.attrs,
+		formatter: s.formatter.Named(prefix),
+		levels:    s.levels,
+	}
+}
+
+func (s *SlogAdapter) Levels() domain.LevelController {
+	return s.levels
+}
+
+func toRouterLevel(level domain.Level) slog.Level {
+	switch level {
+	case domain.LevelDebug:
+		return slog.LevelDebug
+	case domain.LevelInfo:
+		return slog.LevelInfo
+	case domain.LevelWarn:
+		return slog.LevelWarn
+	case domain.LevelError:
+		return slog.LevelError
+	case domain.LevelFatal:
+		return levelFatal
+	case domain.LevelPanic:
+		return levelPanic
+	default:
+		return slog.LevelDebug
+	}
+}
+
+// NewAdapter builds the logger on log/slog. Console and logfmt both use the
+// text handler, which already writes key=value pairs; output is never
+// coloured.
+func NewAdapter(config domain.Options) (domain.Logger, error) {
+	output := config.Output
+	if output == nil {
+		output = os.Stderr
+	}
+
+	levelVar := new(slog.LevelVar)
+	levels := domain.NewLevelSet(config.Level, config.TagLevels, func(lowest domain.Level) {
+		levelVar.Set(toRouterLevel(lowest))
+	})
+
+	redaction := domain.DefaultRedaction
+	if config.Redaction != nil {
+		redaction = *config.Redaction
+	}
+
+	opts := &slog.HandlerOptions{
+		Level:       levelVar,
+		ReplaceAttr: replaceAttr(redaction),
+	}
+
+	var handler slog.Handler
+	switch config.Format {
+	case "", domain.FormatConsole, domain.FormatLogfmt:
+		handler = slog.NewTextHandler(output, opts)
+	case domain.FormatJSON:
+		handler = slog.NewJSONHandler(output, opts)
+	default:
+		return nil, fmt.Errorf("%s: %q", ErrUnknownFormat, config.Format)
+	}
+
+	sampling := domain.DefaultSampling
+	if config.Sampling != nil {
+		sampling = *config.Sampling
+	}
+	if sampling.Initial > 0 {
+		handler = newSampler(handler, sampling)
+	}
+
+	return &SlogAdapter{
+		handler:   handler,
+		attrs:     make([]slog.Attr, 0),
+		formatter: formatter.NewFormatter(""),
+		levels:    levels,
+	}, nil
+}
+
+func replaceAttr(redaction domain.Redaction) func(groups []string, attr slog.Attr) slog.Attr {
+	return func(_ []string, attr slog.Attr) slog.Attr {
+		if attr.Key == slog.LevelKey {
+			if level, ok := attr.Value.Any().(slog.Level); ok {
+				switch level {
+				case levelPanic:
+					return slog.String(slog.LevelKey, domain.LevelPanic.String())
+				case levelFatal:
+					return slog.String(slog.LevelKey, domain.LevelFatal.String())
+				}
+			}
+
+			return attr
+		}
+
+		if redaction.Sensitive(attr.Key) {
+			return slog.String(attr.Key, redaction.Mask(attr.Value.Resolve().String()))
+		}
+
+		return attr
+	}
+}
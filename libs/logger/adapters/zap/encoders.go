@@ -0,0 +1,70 @@
+package adapters
+
+import (
+	"sync"
+
+	"github.com/BlazeCoder04/online_store/libs/logger/domain"
+	zaplogfmt "github.com/jsternberg/zap-logfmt"
+	"go.uber.org/zap"
+	"go.uber.org/zap/buffer"
+	"go.uber.org/zap/zapcore"
+)
+
+type EncoderConstructor func(cfg zapcore.EncoderConfig) zapcore.Encoder
+
+var (
+	encodersMu sync.RWMutex
+	encoders   = map[domain.Format]EncoderConstructor{
+		domain.FormatConsole: zapcore.NewConsoleEncoder,
+		domain.FormatJSON:    zapcore.NewJSONEncoder,
+		domain.FormatLogfmt:  newLogfmtEncoder,
+	}
+)
+
+// RegisterEncoder makes a custom encoder available under format, replacing
+// any encoder already registered with that name.
+func RegisterEncoder(format domain.Format, constructor EncoderConstructor) {
+	encodersMu.Lock()
+	defer encodersMu.Unlock()
+
+	encoders[format] = constructor
+}
+
+func newEncoder(format domain.Format, cfg zapcore.EncoderConfig) (zapcore.Encoder, bool) {
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+
+	if format == "" {
+		format = domain.FormatConsole
+	}
+
+	constructor, ok := encoders[format]
+	if !ok {
+		return nil, false
+	}
+
+	return constructor(cfg), true
+}
+
+// logfmtEncoder carries the logger name as a regular field, because the
+// logfmt encoder ignores NameKey and would drop the component.
+type logfmtEncoder struct {
+	zapcore.Encoder
+	nameKey string
+}
+
+func newLogfmtEncoder(cfg zapcore.EncoderConfig) zapcore.Encoder {
+	return &logfmtEncoder{zaplogfmt.NewEncoder(cfg), cfg.NameKey}
+}
+
+func (e *logfmtEncoder) Clone() zapcore.Encoder {
+	return &logfmtEncoder{e.Encoder.Clone(), e.nameKey}
+}
+
+func (e *logfmtEncoder) EncodeEntry(entry zapcore.Entry, fields []zapcore.Field) (*buffer.Buffer, error) {
+	if entry.LoggerName != "" && e.nameKey != "" {
+		fields = append([]zapcore.Field{zap.String(e.nameKey, entry.LoggerName)}, fields...)
+	}
+
+	return e.Encoder.EncodeEntry(entry, fields)
+}
@@ -1,3 +1,6 @@
 package adapters
 
-const ErrZapBuild = "error when building the logger"
+const (
+	ErrZapBuild       = "error when building the logger"
+	ErrUnknownEncoder = "unknown log encoder"
+)
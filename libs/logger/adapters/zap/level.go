@@ -0,0 +1,67 @@
+package adapters
+
+import (
+	"github.com/BlazeCoder04/online_store/libs/logger/domain"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// newLevels keeps the core's zap.AtomicLevel at the lowest level any tag can
+// log at; the per-tag decision is made by the LevelSet before an entry
+// reaches zap, otherwise an override below the base level would be dropped.
+func newLevels(level domain.Level, tags map[string]domain.Level) (*domain.LevelSet, zap.AtomicLevel) {
+	atomic := zap.NewAtomicLevelAt(toFilterLevel(level))
+
+	levels := domain.NewLevelSet(level, tags, func(lowest domain.Level) {
+		atomic.SetLevel(toFilterLevel(lowest))
+	})
+
+	return levels, atomic
+}
+
+// toFilterLevel is toRouterLevel for the core's filter. zap orders Panic
+// below Fatal, so a filter at Fatal has to open down to zap's Panic level for
+// Panic entries, which rank above Fatal here, to get through.
+func toFilterLevel(level domain.Level) zapcore.Level {
+	if level >= domain.LevelFatal {
+		return zap.PanicLevel
+	}
+
+	return toRouterLevel(level)
+}
+
+func toRouterLevel(level domain.Level) zapcore.Level {
+	switch level {
+	case domain.LevelDebug:
+		return zap.DebugLevel
+	case domain.LevelInfo:
+		return zap.InfoLevel
+	case domain.LevelWarn:
+		return zap.WarnLevel
+	case domain.LevelError:
+		return zap.ErrorLevel
+	case domain.LevelFatal:
+		return zap.FatalLevel
+	case domain.LevelPanic:
+		return zap.PanicLevel
+	default:
+		return zap.DebugLevel
+	}
+}
+
+func fromRouterLevel(level zapcore.Level) domain.Level {
+	switch level {
+	case zap.DebugLevel:
+		return domain.LevelDebug
+	case zap.InfoLevel:
+		return domain.LevelInfo
+	case zap.WarnLevel:
+		return domain.LevelWarn
+	case zap.ErrorLevel:
+		return domain.LevelError
+	case zap.DPanicLevel, zap.PanicLevel:
+		return domain.LevelPanic
+	default:
+		return domain.LevelFatal
+	}
+}
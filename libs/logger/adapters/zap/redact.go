@@ -0,0 +1,84 @@
+package adapters
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger/domain"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// redactCore rewrites sensitive fields before they are handed to the
+// encoder, whichever way they were attached.
+type redactCore struct {
+	zapcore.Core
+	redaction domain.Redaction
+}
+
+func newRedactCore(core zapcore.Core, redaction domain.Redaction) zapcore.Core {
+	if len(redaction.Keys) == 0 {
+		return core
+	}
+
+	return &redactCore{core, redaction}
+}
+
+func (c *redactCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactCore{c.Core.With(c.redact(fields)), c.redaction}
+}
+
+func (c *redactCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+
+	return checked
+}
+
+func (c *redactCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(entry, c.redact(fields))
+}
+
+func (c *redactCore) redact(fields []zapcore.Field) []zapcore.Field {
+	var redacted []zapcore.Field
+
+	for i, field := range fields {
+		if !c.redaction.Sensitive(field.Key) {
+			continue
+		}
+
+		// Copy on first hit, the caller may reuse its slice.
+		if redacted == nil {
+			redacted = make([]zapcore.Field, len(fields))
+			copy(redacted, fields)
+		}
+
+		redacted[i] = zap.String(field.Key, c.redaction.Mask(fieldValue(field)))
+	}
+
+	if redacted == nil {
+		return fields
+	}
+
+	return redacted
+}
+func fieldValue(field zapcore.Field) string {
+	switch field.Type {
+	case zapcore.StringType:
+		return field.String
+	case zapcore.BoolType:
+		return fmt.Sprint(field.Integer == 1)
+	case zapcore.Int64Type, zapcore.Int32Type, zapcore.Int16Type, zapcore.Int8Type:
+		return fmt.Sprint(field.Integer)
+	case zapcore.Uint64Type, zapcore.Uint32Type, zapcore.Uint16Type, zapcore.Uint8Type, zapcore.UintptrType:
+		return fmt.Sprint(uint64(field.Integer))
+	case zapcore.Float64Type:
+		return fmt.Sprint(math.Float64frombits(uint64(field.Integer)))
+	case zapcore.DurationType:
+		return time.Duration(field.Integer).String()
+	default:
+		return fmt.Sprint(field.Interface)
+	}
+}
@@ -1,8 +1,9 @@
 package adapters
 
 import (
-	"errors"
+	"context"
 	"fmt"
+	"os"
 	"sync"
 
 	"github.com/BlazeCoder04/online_store/libs/logger/domain"
@@ -12,10 +13,16 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
+// componentKey holds the tag passed to every call, so it stays a separate
+// field instead of being baked into the message.
+const componentKey = "component"
+
 type ZapAdapter struct {
 	logger    *zap.Logger
 	fields    []zap.Field
 	formatter *formatter.Formatter
+	levels    *domain.LevelSet
+	color     bool
 	mu        sync.RWMutex
 }
 
@@ -37,9 +44,32 @@ func toRouterFields(fields []domain.Field) []zap.Field {
 	return converted
 }
 
-func (z *ZapAdapter) log(level zapcore.Level, msg string, fields []domain.Field, color colorise.Color) {
+func (z *ZapAdapter) log(level domain.Level, tag, msg string, fields []domain.Field, color colorise.Color) {
 	formattedMsg := z.formatter.FormatMessage(msg)
-	formattedMsg = colorise.ColorString(formattedMsg, color)
+
+	// Like zap, Panic and Fatal still panic or exit when their entry is
+	// filtered out.
+	if !z.levels.Enabled(tag, level) {
+		switch level {
+		case domain.LevelPanic:
+			panic(formattedMsg)
+		case domain.LevelFatal:
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	if z.color {
+		formattedMsg = colorise.ColorString(formattedMsg, color)
+	}
+
+	// Check applies zap's own behaviour for the level, i.e. panicking after
+	// a Panic entry and exiting after a Fatal one.
+	entry := z.logger.Named(tag).Check(toRouterLevel(level), formattedMsg)
+	if entry == nil {
+		return
+	}
 
 	zapFields := toRouterFields(fields)
 
@@ -47,38 +77,51 @@ func (z *ZapAdapter) log(level zapcore.Level, msg string, fields []domain.Field,
 	allFields := append(zapFields, z.fields...)
 	z.mu.RUnlock()
 
-	switch level {
-	case zap.DebugLevel:
-		z.logger.Debug(formattedMsg, allFields...)
-	case zap.InfoLevel:
-		z.logger.Info(formattedMsg, allFields...)
-	case zap.WarnLevel:
-		z.logger.Info(formattedMsg, allFields...)
-	case zap.ErrorLevel:
-		z.logger.Error(formattedMsg, allFields...)
-	case zap.FatalLevel:
-		z.logger.Fatal(formattedMsg, allFields...)
-	}
+	entry.Write(allFields...)
 }
 
 func (z *ZapAdapter) Debug(tag, msg string, fields ...domain.Field) {
-	z.log(zap.DebugLevel, fmt.Sprintf("[%s] %s", tag, msg), fields, colorise.ColorReset)
+	z.log(domain.LevelDebug, tag, msg, fields, colorise.ColorReset)
 }
 
 func (z *ZapAdapter) Info(tag, msg string, fields ...domain.Field) {
-	z.log(zap.InfoLevel, fmt.Sprintf("[%s] %s", tag, msg), fields, colorise.ColorGreen)
+	z.log(domain.LevelInfo, tag, msg, fields, colorise.ColorGreen)
 }
 
 func (z *ZapAdapter) Warn(tag, msg string, fields ...domain.Field) {
-	z.log(zap.WarnLevel, fmt.Sprintf("[%s] %s", tag, msg), fields, colorise.ColorYellow)
+	z.log(domain.LevelWarn, tag, msg, fields, colorise.ColorYellow)
 }
 
 func (z *ZapAdapter) Error(tag, msg string, fields ...domain.Field) {
-	z.log(zap.ErrorLevel, fmt.Sprintf("[%s] %s", tag, msg), fields, colorise.ColorOrange)
+	z.log(domain.LevelError, tag, msg, fields, colorise.ColorOrange)
+}
+
+func (z *ZapAdapter) Panic(tag, msg string, fields ...domain.Field) {
+	z.log(domain.LevelPanic, tag, msg, fields, colorise.ColorRed)
 }
 
 func (z *ZapAdapter) Fatal(tag, msg string, fields ...domain.Field) {
-	z.log(zap.FatalLevel, fmt.Sprintf("[%s] %s", tag, msg), fields, colorise.ColorRed)
+	z.log(domain.LevelFatal, tag, msg, fields, colorise.ColorRed)
+}
+
+func (z *ZapAdapter) DebugCtx(ctx context.Context, tag, msg string, fields ...domain.Field) {
+	z.Debug(tag, msg, append(fields, domain.FieldsFromContext(ctx)...)...)
+}
+
+func (z *ZapAdapter) InfoCtx(ctx context.Context, tag, msg string, fields ...domain.Field) {
+	z.Info(tag, msg, append(fields, domain.FieldsFromContext(ctx)...)...)
+}
+
+func (z *ZapAdapter) WarnCtx(ctx context.Context, tag, msg string, fields ...domain.Field) {
+	z.Warn(tag, msg, append(fields, domain.FieldsFromContext(ctx)...)...)
+}
+
+func (z *ZapAdapter) ErrorCtx(ctx context.Context, tag, msg string, fields ...domain.Field) {
+	z.Error(tag, msg, append(fields, domain.FieldsFromContext(ctx)...)...)
+}
+
+func (z *ZapAdapter) WithContext(ctx context.Context) domain.Logger {
+	return z.WithFields(domain.FieldsFromContext(ctx)...)
 }
 
 func (z *ZapAdapter) WithFields(fields ...domain.Field) domain.Logger {
@@ -91,41 +134,82 @@ func (z *ZapAdapter) WithFields(fields ...domain.Field) domain.Logger {
 		logger:    z.logger,
 		fields:    append(zapFields, z.fields...),
 		formatter: z.formatter,
+		levels:    z.levels,
+		color:     z.color,
 	}
 }
 
-func toRouterLevel(level domain.Level) zap.AtomicLevel {
-	switch level {
-	case domain.LevelDebug:
-		return zap.NewAtomicLevelAt(zap.DebugLevel)
-	case domain.LevelInfo:
-		return zap.NewAtomicLevelAt(zap.InfoLevel)
-	case domain.LevelWarn:
-		return zap.NewAtomicLevelAt(zap.WarnLevel)
-	case domain.LevelError:
-		return zap.NewAtomicLevelAt(zap.ErrorLevel)
-	case domain.LevelFatal:
-		return zap.NewAtomicLevelAt(zap.FatalLevel)
-	default:
-		return zap.NewAtomicLevelAt(zap.DebugLevel)
+// Named returns a child whose messages are prefixed with "[prefix]". Nested
+// prefixes are joined with a dot; fields and levels are shared with the
+// parent.
+func (z *ZapAdapter) Named(prefix string) domain.Logger {
+	z.mu.RLock()
+	defer z.mu.RUnlock()
+
+	fields := make([]zap.Field, len(z.fields))
+	copy(fields, z.fields)
+
+	return &ZapAdapter{
+		logger:    z.logger,
+		fields:    fields,
+		formatter: z.formatter.Named(prefix),
+		levels:    z.levels,
+		color:     z.color,
 	}
 }
 
-func NewAdapter(level domain.Level) (domain.Logger, error) {
-	cfg := zap.NewProductionConfig()
-	cfg.Encoding = "console"
-	cfg.Level = toRouterLevel(level)
-	cfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
-	cfg.EncoderConfig.CallerKey = ""
+func (z *ZapAdapter) Levels() domain.LevelController {
+	return z.levels
+}
+
+func NewAdapter(config domain.Options) (domain.Logger, error) {
+	output := config.Output
+	if output == nil {
+		output = os.Stderr
+	}
+
+	// Colours only make sense for people reading a terminal; JSON and
+	// logfmt are meant for machines and stay plain.
+	color := colorise.IsTerminal(output) && (config.Format == "" || config.Format == domain.FormatConsole)
+
+	encoderCfg := zap.NewProductionEncoderConfig()
+	encoderCfg.EncodeTime = zapcore.ISO8601TimeEncoder
+	encoderCfg.CallerKey = zapcore.OmitKey
+	encoderCfg.NameKey = componentKey
+	if color {
+		encoderCfg.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	}
+
+	encoder, ok := newEncoder(config.Format, encoderCfg)
+	if !ok {
+		return nil, fmt.Errorf("%s: %s: %q", ErrZapBuild, ErrUnknownEncoder, config.Format)
+	}
+
+	levels, atomicLevel := newLevels(config.Level, config.TagLevels)
+
+	core := zapcore.NewCore(encoder, zapcore.Lock(zapcore.AddSync(output)), atomicLevel)
 
-	logger, err := cfg.Build()
-	if err != nil {
-		return nil, errors.New(ErrZapBuild)
+	redaction := domain.DefaultRedaction
+	if config.Redaction != nil {
+		redaction = *config.Redaction
+	}
+	core = newRedactCore(core, redaction)
+
+	// The sampler decides in Check, so it has to be the outermost core.
+	sampling := domain.DefaultSampling
+	if config.Sampling != nil {
+		sampling = *config.Sampling
+	}
+	if sampling.Initial > 0 {
+		core = zapcore.NewSamplerWithOptions(core, sampling.Tick, sampling.Initial, sampling.Thereafter)
 	}
 
+	logger := zap.New(core, zap.AddStacktrace(zap.ErrorLevel), zap.ErrorOutput(zapcore.Lock(os.Stderr)))
 	return &ZapAdapter{
 		logger:    logger,
 		fields:    make([]zap.Field, 0),
 		formatter: formatter.NewFormatter(""),
+		levels:    levels,
+		color:     color,
 	}, nil
 }
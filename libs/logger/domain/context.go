@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"context"
+	"sync"
+)
+
+type fieldsKey struct{}
+
+// ContextExtractor pulls extra fields out of a context, e.g. trace IDs kept
+// by a tracing library the logger does not depend on.
+type ContextExtractor func(ctx context.Context) []Field
+
+var (
+	extractorsMu sync.RWMutex
+	extractors   []ContextExtractor
+)
+
+// ContextWithFields returns a copy of ctx carrying fields on top of the ones
+// already stored in it. Every *Ctx call made with the returned context logs
+// them.
+func ContextWithFields(ctx context.Context, fields ...Field) context.Context {
+	stored := FieldsFromContext(ctx)
+
+	merged := make([]Field, 0, len(stored)+len(fields))
+	merged = append(merged, stored...)
+	merged = append(merged, fields...)
+
+	return context.WithValue(ctx, fieldsKey{}, merged)
+}
+
+// FieldsFromContext returns the fields stored with ContextWithFields followed
+// by the ones produced by the registered extractors.
+func FieldsFromContext(ctx context.Context) []Field {
+	if ctx == nil {
+		return nil
+	}
+
+	fields, _ := ctx.Value(fieldsKey{}).([]Field)
+
+	extractorsMu.RLock()
+	defer extractorsMu.RUnlock()
+
+	for _, extract := range extractors {
+		fields = append(fields[:len(fields):len(fields)], extract(ctx)...)
+	}
+
+	return fields
+}
+
+func RegisterContextExtractor(extractor ContextExtractor) {
+	extractorsMu.Lock()
+	defer extractorsMu.Unlock()
+
+	extractors = append(extractors, extractor)
+}
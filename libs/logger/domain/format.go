@@ -0,0 +1,42 @@
+package domain
+
+import "fmt"
+
+type Format string
+
+const (
+	FormatConsole Format = "console"
+	FormatJSON    Format = "json"
+	FormatLogfmt  Format = "logfmt"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch Format(s) {
+	case "", FormatConsole:
+		return FormatConsole, nil
+	case FormatJSON:
+		return FormatJSON, nil
+	case FormatLogfmt:
+		return FormatLogfmt, nil
+	default:
+		return "", fmt.Errorf("unknown log format %q", s)
+	}
+}
+
+type Backend string
+
+const (
+	BackendZap  Backend = "zap"
+	BackendSlog Backend = "slog"
+)
+
+func ParseBackend(s string) (Backend, error) {
+	switch Backend(s) {
+	case "", BackendZap:
+		return BackendZap, nil
+	case BackendSlog:
+		return BackendSlog, nil
+	default:
+		return "", fmt.Errorf("unknown log backend %q", s)
+	}
+}
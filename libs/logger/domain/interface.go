@@ -1,6 +1,9 @@
 package domain
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type Field struct {
 	Key   string
@@ -9,12 +12,15 @@ type Field struct {
 
 type Level int
 
+// The values are fixed: Fatal kept 4 when Panic was added after it, so
+// levels stored or compared as numbers keep their meaning.
 const (
-	LevelDebug Level = iota
-	LevelInfo
-	LevelWarn
-	LevelError
-	LevelFatal
+	LevelDebug Level = 0
+	LevelInfo  Level = 1
+	LevelWarn  Level = 2
+	LevelError Level = 3
+	LevelFatal Level = 4
+	LevelPanic Level = 5
 )
 
 func (l Level) String() string {
@@ -29,6 +35,8 @@ func (l Level) String() string {
 		return "ERROR"
 	case LevelFatal:
 		return "FATAL"
+	case LevelPanic:
+		return "PANIC"
 	default:
 		return fmt.Sprintf("Level(%d)", l)
 	}
@@ -39,8 +47,23 @@ type Logger interface {
 	Info(tag, msg string, fields ...Field)
 	Warn(tag, msg string, fields ...Field)
 	Error(tag, msg string, fields ...Field)
+	// Panic logs and then panics with msg.
+	Panic(tag, msg string, fields ...Field)
 	Fatal(tag, msg string, fields ...Field)
 	WithFields(fields ...Field) Logger
+	// Named returns a child logger that prefixes every message.
+	Named(prefix string) Logger
+
+	// The *Ctx variants append the fields carried by ctx, see
+	// ContextWithFields.
+	DebugCtx(ctx context.Context, tag, msg string, fields ...Field)
+	InfoCtx(ctx context.Context, tag, msg string, fields ...Field)
+	WarnCtx(ctx context.Context, tag, msg string, fields ...Field)
+	ErrorCtx(ctx context.Context, tag, msg string, fields ...Field)
+	WithContext(ctx context.Context) Logger
+
+	// Levels is shared by the logger and every child derived from it.
+	Levels() LevelController
 }
 
 func String(msg, value string) Field {
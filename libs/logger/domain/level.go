@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LevelController changes log levels at runtime. Tag patterns are either an
+// exact tag or a prefix ending in ".*", e.g. "auth.service.*"; the longest
+// matching pattern wins over the base level.
+type LevelController interface {
+	Level() Level
+	SetLevel(level Level)
+	TagLevels() map[string]Level
+	SetTagLevels(levels map[string]Level)
+}
+
+func ParseLevel(s string) (Level, error) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "DEBUG":
+		return LevelDebug, nil
+	case "INFO":
+		return LevelInfo, nil
+	case "WARN", "WARNING":
+		return LevelWarn, nil
+	case "ERROR":
+		return LevelError, nil
+	case "FATAL":
+		return LevelFatal, nil
+	case "PANIC":
+		return LevelPanic, nil
+	default:
+		return 0, fmt.Errorf("unknown log level %q", s)
+	}
+}
+
+// ParseTagLevels reads overrides written as "pattern=level,pattern=level".
+func ParseTagLevels(s string) (map[string]Level, error) {
+	levels := make(map[string]Level)
+
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		pattern, rawLevel, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(pattern) == "" {
+			return nil, fmt.Errorf("invalid tag level %q", pair)
+		}
+
+		level, err := ParseLevel(rawLevel)
+		if err != nil {
+			return nil, err
+		}
+
+		levels[strings.TrimSpace(pattern)] = level
+	}
+
+	return levels, nil
+}
+
+// MatchTag reports whether tag is covered by pattern.
+func MatchTag(pattern, tag string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, ".*"); ok {
+		return tag == prefix || strings.HasPrefix(tag, prefix+".")
+	}
+
+	return pattern == tag
+}
@@ -0,0 +1,105 @@
+package domain
+
+import "sync"
+
+// LevelSet is the LevelController shared by the adapters. It resolves the
+// effective level of a tag and calls onChange with the lowest level any tag
+// can log at, so a backend can open its own filter far enough.
+type LevelSet struct {
+	mu       sync.RWMutex
+	base     Level
+	tags     map[string]Level
+	cache    sync.Map
+	onChange func(lowest Level)
+}
+
+func NewLevelSet(base Level, tags map[string]Level, onChange func(lowest Level)) *LevelSet {
+	s := &LevelSet{
+		base:     base,
+		onChange: onChange,
+	}
+	s.SetTagLevels(tags)
+
+	return s
+}
+
+func (s *LevelSet) Enabled(tag string, level Level) bool {
+	return level >= s.levelFor(tag)
+}
+
+func (s *LevelSet) levelFor(tag string) Level {
+	if level, ok := s.cache.Load(tag); ok {
+		return level.(Level)
+	}
+
+	// The level is cached under the read lock so that a change, which clears
+	// the cache under the write lock, cannot land between resolving the level
+	// and storing it and leave a stale entry behind.
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	level := s.base
+	matched := -1
+	for pattern, tagLevel := range s.tags {
+		if MatchTag(pattern, tag) && len(pattern) > matched {
+			level, matched = tagLevel, len(pattern)
+		}
+	}
+
+	s.cache.Store(tag, level)
+
+	return level
+}
+
+func (s *LevelSet) Level() Level {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.base
+}
+
+func (s *LevelSet) SetLevel(level Level) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.base = level
+	s.changed()
+}
+
+func (s *LevelSet) TagLevels() map[string]Level {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	levels := make(map[string]Level, len(s.tags))
+	for pattern, level := range s.tags {
+		levels[pattern] = level
+	}
+
+	return levels
+}
+
+func (s *LevelSet) SetTagLevels(levels map[string]Level) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tags = make(map[string]Level, len(levels))
+	for pattern, level := range levels {
+		s.tags[pattern] = level
+	}
+	s.changed()
+}
+
+// changed must be called with mu held.
+func (s *LevelSet) changed() {
+	s.cache.Clear()
+
+	if s.onChange == nil {
+		return
+	}
+
+	lowest := s.base
+	for _, level := range s.tags {
+		lowest = min(lowest, level)
+	}
+	s.onChange(lowest)
+}
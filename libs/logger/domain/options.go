@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"io"
+	"time"
+)
+
+// Sampling caps repeated entries: within every Tick the first Initial
+// entries with the same level and message are written, then only every
+// Thereafter-th one. A zero Thereafter drops the rest of the tick.
+type Sampling struct {
+	Tick       time.Duration
+	Initial    int
+	Thereafter int
+}
+
+type RedactMode string
+
+const (
+	// RedactMask replaces the value with a fixed placeholder.
+	RedactMask RedactMode = "mask"
+	// RedactHash replaces the value with a short SHA-256 digest, so the same
+	// value can still be correlated across log lines.
+	RedactHash RedactMode = "hash"
+)
+
+// Redaction hides the values of fields whose key contains one of Keys,
+// compared case-insensitively.
+type Redaction struct {
+	Keys []string
+	Mode RedactMode
+}
+
+var (
+	DefaultSampling = Sampling{
+		Tick:       time.Second,
+		Initial:    100,
+		Thereafter: 100,
+	}
+
+	DefaultRedaction = Redaction{
+		Keys: []string{"password", "token", "authorization", "email"},
+		Mode: RedactMask,
+	}
+)
+
+// Options is what every adapter is built from; see logger.Config for the
+// meaning and defaults of each field.
+type Options struct {
+	Level     Level
+	TagLevels map[string]Level
+	Format    Format
+	Output    io.Writer
+	Sampling  *Sampling
+	Redaction *Redaction
+}
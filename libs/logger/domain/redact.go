@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+const RedactedValue = "[REDACTED]"
+
+// Sensitive reports whether the value logged under key has to be hidden.
+func (r Redaction) Sensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, pattern := range r.Keys {
+		if strings.Contains(key, strings.ToLower(pattern)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Mask returns what is logged in place of value.
+func (r Redaction) Mask(value string) string {
+	if r.Mode != RedactHash {
+		return RedactedValue
+	}
+
+	sum := sha256.Sum256([]byte(value))
+
+	return "sha256:" + hex.EncodeToString(sum[:8])
+}
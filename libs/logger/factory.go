@@ -1,14 +1,26 @@
 package logger
 
 import (
+	"context"
+	"fmt"
+	"io"
+
+	slogAdapter "github.com/BlazeCoder04/online_store/libs/logger/adapters/slog"
 	adapters "github.com/BlazeCoder04/online_store/libs/logger/adapters/zap"
 	"github.com/BlazeCoder04/online_store/libs/logger/domain"
 )
 
 type (
-	Level  = domain.Level
-	Field  = domain.Field
-	Logger = domain.Logger
+	Level            = domain.Level
+	Format           = domain.Format
+	Backend          = domain.Backend
+	Field            = domain.Field
+	Logger           = domain.Logger
+	ContextExtractor = domain.ContextExtractor
+	LevelController  = domain.LevelController
+	Sampling         = domain.Sampling
+	Redaction        = domain.Redaction
+	RedactMode       = domain.RedactMode
 )
 
 const (
@@ -16,13 +28,103 @@ const (
 	LevelInfo  = domain.LevelInfo
 	LevelWarn  = domain.LevelWarn
 	LevelError = domain.LevelError
+	LevelPanic = domain.LevelPanic
 	LevelFatal = domain.LevelFatal
 )
 
+const (
+	RedactMask = domain.RedactMask
+	RedactHash = domain.RedactHash
+)
+
+var (
+	DefaultSampling  = domain.DefaultSampling
+	DefaultRedaction = domain.DefaultRedaction
+)
+
+const (
+	BackendZap  = domain.BackendZap
+	BackendSlog = domain.BackendSlog
+)
+
+const (
+	FormatConsole = domain.FormatConsole
+	FormatJSON    = domain.FormatJSON
+	FormatLogfmt  = domain.FormatLogfmt
+)
+
 type Config struct {
-	Level Level
+	// Backend picks the library the logger is built on; zap when empty.
+	Backend Backend
+	Level   Level
+	// TagLevels overrides Level for matching tags, see LevelController.
+	TagLevels map[string]Level
+	// Format selects the encoder; console is used when empty.
+	Format Format
+	// Output defaults to stderr. The zap backend colours console output
+	// when it is a terminal.
+	Output io.Writer
+	// Sampling defaults to DefaultSampling; set Initial to 0 to turn it off.
+	Sampling *Sampling
+	// Redaction defaults to DefaultRedaction; pass an empty one to log
+	// every value as is.
+	Redaction *Redaction
 }
 
 func NewAdapter(config *Config) (Logger, error) {
-	return adapters.NewAdapter(config.Level)
+	options := domain.Options{
+		Level:     config.Level,
+		TagLevels: config.TagLevels,
+		Format:    config.Format,
+		Output:    config.Output,
+		Sampling:  config.Sampling,
+		Redaction: config.Redaction,
+	}
+
+	switch config.Backend {
+	case "", BackendZap:
+		return adapters.NewAdapter(options)
+	case BackendSlog:
+		return slogAdapter.NewAdapter(options)
+	default:
+		return nil, fmt.Errorf("unknown log backend %q", config.Backend)
+	}
+}
+
+func ParseBackend(s string) (Backend, error) {
+	return domain.ParseBackend(s)
+}
+
+func ParseLevel(s string) (Level, error) {
+	return domain.ParseLevel(s)
+}
+
+// ParseTagLevels reads per-tag overrides such as
+// "auth.service.*=debug,profile.handler.*=warn".
+func ParseTagLevels(s string) (map[string]Level, error) {
+	return domain.ParseTagLevels(s)
+}
+
+func ParseFormat(s string) (Format, error) {
+	return domain.ParseFormat(s)
+}
+
+// RegisterEncoder plugs in an encoder for a custom format name. Encoders
+// only apply to the zap backend.
+func RegisterEncoder(format Format, constructor adapters.EncoderConstructor) {
+	adapters.RegisterEncoder(format, constructor)
+}
+
+func ContextWithFields(ctx context.Context, fields ...Field) context.Context {
+	return domain.ContextWithFields(ctx, fields...)
+}
+
+func FieldsFromContext(ctx context.Context) []Field {
+	return domain.FieldsFromContext(ctx)
+}
+
+// RegisterContextExtractor adds fields taken from every context passed to
+// the *Ctx methods, e.g. the current trace and span IDs.
+func RegisterContextExtractor(extractor ContextExtractor) {
+	domain.RegisterContextExtractor(extractor)
 }
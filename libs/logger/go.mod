@@ -2,6 +2,15 @@ module github.com/BlazeCoder04/online_store/libs/logger
 
 go 1.24.4
 
-require go.uber.org/zap v1.27.0
+require (
+	github.com/jsternberg/zap-logfmt v1.3.0
+	github.com/stretchr/testify v1.10.0
+	go.uber.org/zap v1.27.0
+)
 
-require go.uber.org/multierr v1.10.0 // indirect
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	go.uber.org/multierr v1.10.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
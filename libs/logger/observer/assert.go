@@ -0,0 +1,32 @@
+package observer
+
+import (
+	"testing"
+
+	"github.com/BlazeCoder04/online_store/libs/logger/domain"
+)
+
+// RequireLogged fails the test unless an entry with the given level and tag
+// pattern whose message contains msg was recorded.
+func RequireLogged(t testing.TB, l *Logger, level domain.Level, tag, msg string) Entry {
+	t.Helper()
+
+	found := l.Entries().Level(level).Tag(tag).Message(msg)
+	if found.Len() == 0 {
+		t.Fatalf("expected a %s entry tagged %q containing %q, got:\n%s", level, tag, msg, l.Entries())
+	}
+
+	return found[0]
+}
+
+// RequireNotLogged fails the test if anything at level or above was
+// recorded.
+func RequireNotLogged(t testing.TB, l *Logger, level domain.Level) {
+	t.Helper()
+
+	for _, entry := range l.Entries() {
+		if entry.Level >= level {
+			t.Fatalf("expected nothing at %s or above, got:\n%s", level, entry)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// Package observer provides a Logger that keeps entries in memory so tests
+// can check what was logged.
+package observer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/BlazeCoder04/online_store/libs/logger/domain"
+	"github.com/BlazeCoder04/online_store/libs/logger/pkg/formatter"
+)
+
+type Entry struct {
+	Level   domain.Level
+	Tag     string
+	Message string
+	Fields  []domain.Field
+}
+
+// Field returns the value of the last field logged under key.
+func (e Entry) Field(key string) (any, bool) {
+	for i := len(e.Fields) - 1; i >= 0; i-- {
+		if e.Fields[i].Key == key {
+			return e.Fields[i].Value, true
+		}
+	}
+
+	return nil, false
+}
+
+func (e Entry) String() string {
+	return fmt.Sprintf("%s [%s] %s %v", e.Level, e.Tag, e.Message, e.Fields)
+}
+
+type Entries []Entry
+
+func (e Entries) filter(keep func(entry Entry) bool) Entries {
+	filtered := make(Entries, 0, len(e))
+	for _, entry := range e {
+		if keep(entry) {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	return filtered
+}
+
+func (e Entries) Level(level domain.Level) Entries {
+	return e.filter(func(entry Entry) bool { return entry.Level == level })
+}
+
+// Tag accepts the same patterns as the level overrides, e.g. "auth.service.*".
+func (e Entries) Tag(pattern string) Entries {
+	return e.filter(func(entry Entry) bool { return domain.MatchTag(pattern, entry.Tag) })
+}
+
+func (e Entries) Message(substr string) Entries {
+	return e.filter(func(entry Entry) bool { return strings.Contains(entry.Message, substr) })
+}
+
+func (e Entries) Field(key string, value any) Entries {
+	return e.filter(func(entry Entry) bool {
+		got, ok := entry.Field(key)
+
+		return ok && got == value
+	})
+}
+
+func (e Entries) Len() int {
+	return len(e)
+}
+
+func (e Entries) String() string {
+	lines := make([]string, 0, len(e))
+	for _, entry := range e {
+		lines = append(lines, entry.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+type recorder struct {
+	mu      sync.RWMutex
+	entries Entries
+}
+
+// Logger records every entry at or above its level. Fatal is recorded like
+// any other level and does not exit, so tests can assert on it; Panic still
+// panics after recording. Messages are stored with the Named prefix.
+type Logger struct {
+	recorder  *recorder
+	fields    []domain.Field
+	formatter *formatter.Formatter
+	levels    *domain.LevelSet
+}
+
+func New(level domain.Level) *Logger {
+	return &Logger{
+		recorder:  &recorder{},
+		formatter: formatter.NewFormatter(""),
+		levels:    domain.NewLevelSet(level, nil, nil),
+	}
+}
+
+// Entries returns a snapshot of everything recorded by the logger and its
+// children.
+func (l *Logger) Entries() Entries {
+	l.recorder.mu.RLock()
+	defer l.recorder.mu.RUnlock()
+
+	entries := make(Entries, len(l.recorder.entries))
+	copy(entries, l.recorder.entries)
+
+	return entries
+}
+
+func (l *Logger) Reset() {
+	l.recorder.mu.Lock()
+	defer l.recorder.mu.Unlock()
+
+	l.recorder.entries = nil
+}
+
+func (l *Logger) log(level domain.Level, tag, msg string, fields []domain.Field) {
+	if !l.levels.Enabled(tag, level) {
+		return
+	}
+
+	all := make([]domain.Field, 0, len(fields)+len(l.fields))
+	all = append(all, fields...)
+	all = append(all, l.fields...)
+
+	l.recorder.mu.Lock()
+	defer l.recorder.mu.Unlock()
+
+	l.recorder.entries = append(l.recorder.entries, Entry{level, tag, l.formatter.FormatMessage(msg), all})
+}
+
+func (l *Logger) Debug(tag, msg string, fields ...domain.Field) {
+	l.log(domain.LevelDebug, tag, msg, fields)
+}
+
+func (l *Logger) Info(tag, msg string, fields ...domain.Field) {
+	l.log(domain.LevelInfo, tag, msg, fields)
+}
+
+func (l *Logger) Warn(tag, msg string, fields ...domain.Field) {
+	l.log(domain.LevelWarn, tag, msg, fields)
+}
+
+func (l *Logger) Error(tag, msg string, fields ...domain.Field) {
+	l.log(domain.LevelError, tag, msg, fields)
+}
+
+func (l *Logger) Panic(tag, msg string, fields ...domain.Field) {
+	l.log(domain.LevelPanic, tag, msg, fields)
+
+	panic(l.formatter.FormatMessage(msg))
+}
+
+func (l *Logger) Fatal(tag, msg string, fields ...domain.Field) {
+	l.log(domain.LevelFatal, tag, msg, fields)
+}
+
+func (l *Logger) DebugCtx(ctx context.Context, tag, msg string, fields ...domain.Field) {
+	l.Debug(tag, msg, append(fields, domain.FieldsFromContext(ctx)...)...)
+}
+
+func (l *Logger) InfoCtx(ctx context.Context, tag, msg string, fields ...domain.Field) {
+	l.Info(tag, msg, append(fields, domain.FieldsFromContext(ctx)...)...)
+}
+
+func (l *Logger) WarnCtx(ctx context.Context, tag, msg string, fields ...domain.Field) {
+	l.Warn(tag, msg, append(fields, domain.FieldsFromContext(ctx)...)...)
+}
+
+func (l *Logger) ErrorCtx(ctx context.Context, tag, msg string, fields ...domain.Field) {
+	l.Error(tag, msg, append(fields, domain.FieldsFromContext(ctx)...)...)
+}
+
+func (l *Logger) WithContext(ctx context.Context) domain.Logger {
+	return l.WithFields(domain.FieldsFromContext(ctx)...)
+}
+
+func (l *Logger) WithFields(fields ...domain.Field) domain.Logger {
+	all := make([]domain.Field, 0, len(fields)+len(l.fields))
+	all = append(all, fields...)
+	all = append(all, l.fields...)
+
+	return &Logger{
+		recorder:  l.recorder,
+		fields:    all,
+		formatter: l.formatter,
+		levels:    l.levels,
+	}
+}
+
+func (l *Logger) Named(prefix string) domain.Logger {
+	return &Logger{
+		recorder:  l.recorder,
+		fields:    l.fields,
+		formatter: l.formatter.Named(prefix),
+		levels:    l.levels,
+	}
+}
+
+func (l *Logger) Levels() domain.LevelController {
+	return l.levels
+}
@@ -0,0 +1,22 @@
+package colorise
+
+import (
+	"io"
+	"os"
+)
+
+// IsTerminal reports whether w is a character device, so colour codes are
+// only written where a human is reading.
+func IsTerminal(w io.Writer) bool {
+	file, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -19,3 +19,15 @@ func (f *Formatter) FormatMessage(msg string) string {
 
 	return fmt.Sprintf("[%s] %s", f.prefix, msg)
 }
+
+// Named returns a formatter for a child logger, joining prefixes with a dot.
+func (f *Formatter) Named(prefix string) *Formatter {
+	switch {
+	case prefix == "":
+		return f
+	case f.prefix == "":
+		return NewFormatter(prefix)
+	default:
+		return NewFormatter(f.prefix + "." + prefix)
+	}
+}
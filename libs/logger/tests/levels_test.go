@@ -0,0 +1,267 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/logger/observer"
+	"github.com/stretchr/testify/require"
+)
+
+const fatalEnv = "LOGGER_TEST_FATAL_BACKEND"
+
+type record struct {
+	Level     string `json:"level"`
+	Message   string `json:"msg"`
+	Component string `json:"component"`
+}
+
+func decode(t *testing.T, out *bytes.Buffer) []record {
+	t.Helper()
+
+	var records []record
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+
+		var r record
+		require.NoError(t, json.Unmarshal([]byte(line), &r))
+		records = append(records, r)
+	}
+
+	return records
+}
+
+func TestLogger_Levels(t *testing.T) {
+	type args struct {
+		log func(log logger.Logger)
+	}
+
+	type expect struct {
+		level  logger.Level
+		panics bool
+	}
+
+	tests := []struct {
+		name   string
+		args   args
+		expect expect
+	}{
+		{
+			name: "debug case",
+			args: args{
+				log: func(log logger.Logger) { log.Debug("migrator.up", "message") },
+			},
+			expect: expect{
+				level: logger.LevelDebug,
+			},
+		},
+		{
+			name: "info case",
+			args: args{
+				log: func(log logger.Logger) { log.Info("migrator.up", "message") },
+			},
+			expect: expect{
+				level: logger.LevelInfo,
+			},
+		},
+		{
+			name: "warn case",
+			args: args{
+				log: func(log logger.Logger) { log.Warn("migrator.up", "message") },
+			},
+			expect: expect{
+				level: logger.LevelWarn,
+			},
+		},
+		{
+			name: "error case",
+			args: args{
+				log: func(log logger.Logger) { log.Error("migrator.up", "message") },
+			},
+			expect: expect{
+				level: logger.LevelError,
+			},
+		},
+		{
+			name: "panic case",
+			args: args{
+				log: func(log logger.Logger) { log.Panic("migrator.up", "message") },
+			},
+			expect: expect{
+				level:  logger.LevelPanic,
+				panics: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, backend := range backends {
+				var out bytes.Buffer
+
+				log, err := logger.NewAdapter(&logger.Config{
+					Backend: backend,
+					Level:   logger.LevelDebug,
+					Format:  logger.FormatJSON,
+					Output:  &out,
+				})
+				require.NoError(t, err)
+
+				if tt.expect.panics {
+					require.PanicsWithValue(t, "message", func() { tt.args.log(log) }, "backend %s", backend)
+				} else {
+					tt.args.log(log)
+				}
+
+				records := decode(t, &out)
+				require.Len(t, records, 1, "backend %s", backend)
+				require.True(t, strings.EqualFold(tt.expect.level.String(), records[0].Level), "backend %s: got level %s", backend, records[0].Level)
+				require.Equal(t, "message", records[0].Message)
+				require.Equal(t, "migrator.up", records[0].Component)
+			}
+
+			log := observer.New(logger.LevelDebug)
+			if tt.expect.panics {
+				require.Panics(t, func() { tt.args.log(log) })
+			} else {
+				tt.args.log(log)
+			}
+			observer.RequireLogged(t, log, tt.expect.level, "migrator.up", "message")
+		})
+	}
+}
+
+func TestLogger_LevelFiltering(t *testing.T) {
+	for _, backend := range backends {
+		var out bytes.Buffer
+
+		log, err := logger.NewAdapter(&logger.Config{
+			Backend: backend,
+			Level:   logger.LevelWarn,
+			Format:  logger.FormatJSON,
+			Output:  &out,
+		})
+		require.NoError(t, err)
+
+		log.Debug("tag", "debug")
+		log.Info("tag", "info")
+		log.Warn("tag", "warn")
+		log.Error("tag", "error")
+		require.Panics(t, func() { log.Panic("tag", "panic") })
+
+		var messages []string
+		for _, r := range decode(t, &out) {
+			messages = append(messages, r.Message)
+		}
+		require.Equal(t, []string{"warn", "error", "panic"}, messages, "backend %s", backend)
+
+		// Panic must still panic when its entry is filtered out. No level
+		// ranks above Panic, so the tag is filtered with the one past it.
+		log.Levels().SetTagLevels(map[string]logger.Level{"quiet": logger.LevelPanic + 1})
+		require.Panics(t, func() { log.Panic("quiet", "panic") }, "backend %s", backend)
+	}
+}
+
+func TestLogger_Fatal(t *testing.T) {
+	if backend := os.Getenv(fatalEnv); backend != "" {
+		log, _ := logger.NewAdapter(&logger.Config{
+			Backend: logger.Backend(backend),
+			Level:   logger.LevelDebug,
+			Format:  logger.FormatJSON,
+			Output:  os.Stdout,
+		})
+		log.Fatal("main", "message")
+
+		return
+	}
+
+	for _, backend := range backends {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestLogger_Fatal$")
+		cmd.Env = append(os.Environ(), fatalEnv+"="+string(backend))
+
+		var out bytes.Buffer
+		cmd.Stdout = &out
+
+		err := cmd.Run()
+
+		var exitErr *exec.ExitError
+		require.ErrorAs(t, err, &exitErr, "backend %s", backend)
+		require.Equal(t, 1, exitErr.ExitCode())
+
+		records := decode(t, &out)
+		require.Len(t, records, 1, "backend %s", backend)
+		require.True(t, strings.EqualFold(logger.LevelFatal.String(), records[0].Level), "backend %s: got level %s", backend, records[0].Level)
+	}
+}
+
+func TestLogger_Named(t *testing.T) {
+	for _, backend := range backends {
+		var out bytes.Buffer
+
+		log, err := logger.NewAdapter(&logger.Config{
+			Backend: backend,
+			Level:   logger.LevelDebug,
+			Format:  logger.FormatJSON,
+			Output:  &out,
+		})
+		require.NoError(t, err)
+
+		auth := log.Named("auth")
+		auth.Info("tag", "first")
+		auth.Named("service").WithFields(logger.Field{Key: "k", Value: "v"}).Info("tag", "second")
+		log.Info("tag", "third")
+
+		var messages []string
+		for _, r := range decode(t, &out) {
+			messages = append(messages, r.Message)
+		}
+		require.Equal(t, []string{"[auth] first", "[auth.service] second", "third"}, messages, "backend %s", backend)
+	}
+
+	log := observer.New(logger.LevelDebug)
+	log.Named("auth").Warn("auth.service.login", "message")
+	observer.RequireLogged(t, log, logger.LevelWarn, "auth.service.login", "[auth] message")
+}
+
+func TestLogger_WithFieldsConcurrent(t *testing.T) {
+	for _, backend := range backends {
+		var out bytes.Buffer
+
+		log, err := logger.NewAdapter(&logger.Config{
+			Backend:  backend,
+			Level:    logger.LevelDebug,
+			Format:   logger.FormatJSON,
+			Output:   &out,
+			Sampling: &logger.Sampling{},
+		})
+		require.NoError(t, err)
+
+		parent := log.WithFields(logger.Field{Key: "parent", Value: "1"})
+
+		var wg sync.WaitGroup
+		for i := range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				child := parent.WithFields(logger.Field{Key: "child", Value: i}).Named("worker")
+				child.Info("tag", "message")
+				parent.Info("tag", "message")
+			}()
+		}
+		wg.Wait()
+
+		require.Len(t, decode(t, &out), 16, "backend %s", backend)
+	}
+}
@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/logger/observer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserver(t *testing.T) {
+	log := observer.New(logger.LevelInfo)
+	log.Levels().SetTagLevels(map[string]logger.Level{"auth.service.*": logger.LevelDebug})
+
+	ctx := logger.ContextWithFields(context.Background(), logger.Field{Key: "request_id", Value: "42"})
+
+	log.Debug("profile.service.get", "dropped")
+	log.Debug("auth.service.login", "kept")
+	log.WithFields(logger.Field{Key: "user_id", Value: "7"}).ErrorCtx(ctx, "auth.service.login", "failed find user: boom")
+	log.Fatal("main", "does not exit")
+
+	require.Equal(t, 3, log.Entries().Len())
+	require.Equal(t, 0, log.Entries().Message("dropped").Len())
+
+	entry := observer.RequireLogged(t, log, logger.LevelError, "auth.service.*", "failed find user")
+	value, ok := entry.Field("request_id")
+	require.True(t, ok)
+	require.Equal(t, "42", value)
+	require.Equal(t, 1, log.Entries().Field("user_id", "7").Len())
+
+	observer.RequireLogged(t, log, logger.LevelFatal, "main", "does not exit")
+
+	log.Reset()
+	observer.RequireNotLogged(t, log, logger.LevelDebug)
+}
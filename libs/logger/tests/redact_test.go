@@ -0,0 +1,272 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/stretchr/testify/require"
+)
+
+var backends = []logger.Backend{logger.BackendZap, logger.BackendSlog}
+
+const (
+	secretToken = "eyJhbGciOiJSUzI1NiJ9.secret-payload.signature"
+	secretEmail = "john.doe@example.com"
+)
+
+func TestLogger_Redaction(t *testing.T) {
+	type args struct {
+		log func(log logger.Logger)
+	}
+
+	type expect struct {
+		hidden []string
+		shown  []string
+	}
+
+	tests := []struct {
+		name      string
+		redaction *logger.Redaction
+		args      args
+		expect    expect
+	}{
+		{
+			name: "token field case",
+			args: args{
+				log: func(log logger.Logger) {
+					log.Info("auth.service.login", "Token issued", logger.Field{Key: "access_token", Value: secretToken})
+				},
+			},
+			expect: expect{
+				hidden: []string{secretToken},
+				shown:  []string{"access_token", "[REDACTED]"},
+			},
+		},
+		{
+			name: "key case insensitive case",
+			args: args{
+				log: func(log logger.Logger) {
+					log.Info("auth.handler.login", "Request", logger.Field{Key: "Authorization", Value: "Bearer " + secretToken})
+				},
+			},
+			expect: expect{
+				hidden: []string{secretToken},
+			},
+		},
+		{
+			name: "non string value case",
+			args: args{
+				log: func(log logger.Logger) {
+					log.Error("auth.service.refresh", "Refresh failed", logger.Field{
+						Key:   "refresh_token",
+						Value: []byte(secretToken),
+					}, logger.Field{
+						Key:   "token_claims",
+						Value: map[string]string{"raw": secretToken},
+					})
+				},
+			},
+			expect: expect{
+				hidden: []string{secretToken, "secret-payload"},
+			},
+		},
+		{
+			name: "with fields case",
+			args: args{
+				log: func(log logger.Logger) {
+					log.WithFields(logger.Field{Key: "refresh_token", Value: secretToken}).Warn("auth.service.logout", "Logout")
+				},
+			},
+			expect: expect{
+				hidden: []string{secretToken},
+			},
+		},
+		{
+			name: "context fields case",
+			args: args{
+				log: func(log logger.Logger) {
+					ctx := logger.ContextWithFields(context.Background(), logger.Field{Key: "token", Value: secretToken})
+
+					log.ErrorCtx(ctx, "profile.service.get", "Failed")
+					log.WithContext(ctx).Info("profile.service.get", "Done")
+				},
+			},
+			expect: expect{
+				hidden: []string{secretToken},
+			},
+		},
+		{
+			name: "email and password case",
+			args: args{
+				log: func(log logger.Logger) {
+					log.Info("auth.service.register", "Registered", logger.Field{Key: "email", Value: secretEmail}, logger.Field{Key: "new_password", Value: "qwerty123"})
+				},
+			},
+			expect: expect{
+				hidden: []string{secretEmail, "qwerty123"},
+			},
+		},
+		{
+			name: "hash mode case",
+			redaction: &logger.Redaction{
+				Keys: []string{"token"},
+				Mode: logger.RedactHash,
+			},
+			args: args{
+				log: func(log logger.Logger) {
+					log.Info("auth.service.login", "Token issued", logger.Field{Key: "access_token", Value: secretToken})
+				},
+			},
+			expect: expect{
+				hidden: []string{secretToken, "[REDACTED]"},
+				shown:  []string{"sha256:"},
+			},
+		},
+		{
+			name: "other fields untouched case",
+			args: args{
+				log: func(log logger.Logger) {
+					log.Info("auth.service.login", "Logged in", logger.Field{Key: "user_id", Value: "42"}, logger.Field{Key: "error", Value: errors.New("boom")})
+				},
+			},
+			expect: expect{
+				shown: []string{`"user_id":"42"`, "boom"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, backend := range backends {
+				for _, format := range []logger.Format{logger.FormatConsole, logger.FormatJSON, logger.FormatLogfmt} {
+					var out bytes.Buffer
+
+					log, err := logger.NewAdapter(&logger.Config{
+						Backend:   backend,
+						Level:     logger.LevelDebug,
+						Format:    format,
+						Output:    &out,
+						Redaction: tt.redaction,
+					})
+					require.NoError(t, err)
+
+					tt.args.log(log)
+
+					require.NotEmpty(t, out.String())
+					for _, hidden := range tt.expect.hidden {
+						require.NotContains(t, out.String(), hidden, "backend %s, format %s", backend, format)
+					}
+					if format == logger.FormatJSON {
+						for _, shown := range tt.expect.shown {
+							require.Contains(t, out.String(), shown, "backend %s", backend)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestLogger_RedactionHashIsStable(t *testing.T) {
+	hashes := make(map[string]struct{})
+
+	for _, backend := range backends {
+		var out bytes.Buffer
+
+		log, err := logger.NewAdapter(&logger.Config{
+			Backend: backend,
+			Level:   logger.LevelDebug,
+			Format:  logger.FormatJSON,
+			Output:  &out,
+			Redaction: &logger.Redaction{
+				Keys: []string{"email"},
+				Mode: logger.RedactHash,
+			},
+		})
+		require.NoError(t, err)
+
+		log.Info("auth.service.login", "first", logger.Field{Key: "email", Value: secretEmail})
+		log.Info("auth.service.login", "second", logger.Field{Key: "email", Value: secretEmail})
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		require.Len(t, lines, 2)
+
+		for _, line := range lines {
+			_, after, ok := strings.Cut(line, `"email":"`)
+			require.True(t, ok)
+
+			hashes[after[:strings.Index(after, `"`)]] = struct{}{}
+		}
+		require.NotContains(t, out.String(), secretEmail)
+	}
+
+	require.Len(t, hashes, 1)
+}
+
+func TestLogger_Sampling(t *testing.T) {
+	tests := []struct {
+		name     string
+		sampling *logger.Sampling
+		expect   int
+	}{
+		{
+			name: "limited case",
+			sampling: &logger.Sampling{
+				Tick:       time.Minute,
+				Initial:    3,
+				Thereafter: 0,
+			},
+			expect: 3,
+		},
+		{
+			name: "every nth case",
+			sampling: &logger.Sampling{
+				Tick:       time.Minute,
+				Initial:    2,
+				Thereafter: 4,
+			},
+			expect: 4,
+		},
+		{
+			name: "disabled case",
+			sampling: &logger.Sampling{
+				Initial: 0,
+			},
+			expect: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, backend := range backends {
+				var out bytes.Buffer
+
+				log, err := logger.NewAdapter(&logger.Config{
+					Backend:  backend,
+					Level:    logger.LevelDebug,
+					Format:   logger.FormatJSON,
+					Output:   &out,
+					Sampling: tt.sampling,
+				})
+				require.NoError(t, err)
+
+				for range 10 {
+					log.Error("auth.service.login", "failed compare password")
+				}
+
+				require.Equal(t, tt.expect, strings.Count(out.String(), "\n"), "backend %s", backend)
+			}
+		})
+	}
+}
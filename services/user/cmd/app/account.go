@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/app"
+)
+
+// minPasswordLength matches the rule on the Register and Login requests.
+const minPasswordLength = 6
+
+func createAdminCommand(cfg *configs.Config, args []string, in io.Reader, out io.Writer) error {
+	fs := flag.NewFlagSet("create-admin", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	email := fs.String("email", "", "email of the administrator")
+	firstName := fs.String("first-name", "Admin", "first name")
+	lastName := fs.String("last-name", "Admin", "last name")
+	passwordStdin := fs.Bool("password-stdin", false, "read the password from standard input")
+
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %v", errUsage, err)
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%w: create-admin takes no arguments", errUsage)
+	}
+	if address, err := mail.ParseAddress(*email); err != nil || address.Address != *email {
+		return fmt.Errorf("%w: create-admin needs a valid --email", errUsage)
+	}
+
+	// The password is never taken from a flag, where it would show up in
+	// the process list and the shell history.
+	var (
+		password  string
+		generated bool
+		err       error
+	)
+	if *passwordStdin {
+		password, err = readPassword(in)
+	} else {
+		password, err = generatePassword()
+		generated = true
+	}
+	if err != nil {
+		return err
+	}
+
+	log, err := commandLogger(cfg)
+	if err != nil {
+		return err
+	}
+
+	accountService, closeAll, err := app.NewAccountService(log, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeAll()
+
+	user, err := accountService.CreateAdmin(context.Background(), *email, password, *firstName, *lastName)
+	if err != nil {
+		return fmt.Errorf("Error during creation of admin: %v", err)
+	}
+
+	fmt.Fprintf(out, "Created admin %s <%s>\n", user.ID, user.Email)
+	if generated {
+		fmt.Fprintf(out, "Password: %s\n", password)
+	}
+
+	return nil
+}
+
+func userCommand(cfg *configs.Config, args []string, out io.Writer) error {
+	if len(args) != 2 || (args[0] != "block" && args[0] != "unblock") {
+		return fmt.Errorf("%w: user needs block or unblock and a user ID or email", errUsage)
+	}
+
+	log, err := commandLogger(cfg)
+	if err != nil {
+		return err
+	}
+
+	accountService, closeAll, err := app.NewAccountService(log, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeAll()
+
+	ctx := context.Background()
+
+	if args[0] == "block" {
+		user, err := accountService.Block(ctx, args[1])
+		if err != nil {
+			return fmt.Errorf("Error during blocking of user: %v", err)
+		}
+
+		fmt.Fprintf(out, "Blocked %s <%s> since %s\n", user.ID, user.Email, user.BlockedAt.Format(time.RFC3339))
+
+		return nil
+	}
+
+	user, err := accountService.Unblock(ctx, args[1])
+	if err != nil {
+		return fmt.Errorf("Error during unblocking of user: %v", err)
+	}
+
+	fmt.Fprintf(out, "Unblocked %s <%s>\n", user.ID, user.Email)
+
+	return nil
+}
+
+func readPassword(in io.Reader) (string, error) {
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", fmt.Errorf("Error during reading of password: %v", err)
+	}
+
+	password := strings.TrimRight(line, "\r\n")
+	if len(password) < minPasswordLength {
+		return "", fmt.Errorf("password must be at least %d characters", minPasswordLength)
+	}
+
+	return password, nil
+}
+
+func generatePassword() (string, error) {
+	buf := make([]byte, 18)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("Error during generation of password: %v", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
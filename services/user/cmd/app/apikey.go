@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/app"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainService "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+)
+
+// apiKeyCommand manages the API keys of services. Users manage their own
+// keys through the API.
+func apiKeyCommand(cfg *configs.Config, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: apikey needs create, list or revoke", errUsage)
+	}
+
+	switch args[0] {
+	case "create":
+		return apiKeyCreateCommand(cfg, args[1:], out)
+	case "list":
+		return apiKeyListCommand(cfg, args[1:], out)
+	case "revoke":
+		return apiKeyRevokeCommand(cfg, args[1:], out)
+	default:
+		return fmt.Errorf("%w: apikey needs create, list or revoke", errUsage)
+	}
+}
+
+func apiKeyCreateCommand(cfg *configs.Config, args []string, out io.Writer) error {
+	var scopes listFlag
+
+	fs := flag.NewFlagSet("apikey create", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	service := fs.String("service", "", "service the key belongs to")
+	name := fs.String("name", "", "name of the key")
+	fs.Var(&scopes, "scope", "granted scope, may be repeated")
+	expiresIn := fs.Duration("expires-in", 0, "lifetime of the key, it does not expire by default")
+
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %v", errUsage, err)
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%w: apikey create takes no arguments", errUsage)
+	}
+	if *service == "" || *name == "" || len(scopes) == 0 {
+		return fmt.Errorf("%w: apikey create needs a --service, a --name and a --scope", errUsage)
+	}
+
+	log, err := commandLogger(cfg)
+	if err != nil {
+		return err
+	}
+
+	accountService, closeAll, err := app.NewAccountService(log, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeAll()
+
+	apiKey, key, err := accountService.CreateServiceAPIKey(context.Background(), &domainService.CreateServiceAPIKeyArgs{
+		Service:   *service,
+		Name:      *name,
+		Scopes:    scopes,
+		ExpiresIn: *expiresIn,
+	})
+	if err != nil {
+		return fmt.Errorf("Error during creation of API key: %v", err)
+	}
+
+	fmt.Fprintf(out, "Created API key %s (%s) for %s\n", apiKey.ID, apiKey.Name, apiKey.Service)
+	fmt.Fprintf(out, "Key: %s\n", key)
+
+	return nil
+}
+
+func apiKeyListCommand(cfg *configs.Config, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("apikey list", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	service := fs.String("service", "", "service the keys belong to")
+
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %v", errUsage, err)
+	}
+	if fs.NArg() > 0 || *service == "" {
+		return fmt.Errorf("%w: apikey list needs a --service", errUsage)
+	}
+
+	log, err := commandLogger(cfg)
+	if err != nil {
+		return err
+	}
+
+	accountService, closeAll, err := app.NewAccountService(log, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeAll()
+
+	apiKeys, err := accountService.ListServiceAPIKeys(context.Background(), *service)
+	if err != nil {
+		return fmt.Errorf("Error during listing of API keys: %v", err)
+	}
+
+	now := time.Now()
+	for _, apiKey := range apiKeys {
+		fmt.Fprintf(out, "%s  %s  %-20s  %-7s  %s\n", apiKey.ID, apiKey.Prefix, apiKey.Name, apiKeyState(apiKey, now), strings.Join(apiKey.Scopes, ","))
+	}
+
+	return nil
+}
+
+func apiKeyRevokeCommand(cfg *configs.Config, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: apikey revoke needs a key ID", errUsage)
+	}
+
+	log, err := commandLogger(cfg)
+	if err != nil {
+		return err
+	}
+
+	accountService, closeAll, err := app.NewAccountService(log, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeAll()
+
+	apiKey, err := accountService.RevokeAPIKey(context.Background(), args[0])
+	if err != nil {
+		return fmt.Errorf("Error during revoking of API key: %v", err)
+	}
+
+	fmt.Fprintf(out, "Revoked API key %s (%s)\n", apiKey.ID, apiKey.Name)
+
+	return nil
+}
+
+func apiKeyState(apiKey *models.APIKey, now time.Time) string {
+	switch {
+	case apiKey.Revoked:
+		return "revoked"
+	case !apiKey.Usable(now):
+		return "expired"
+	default:
+		return "active"
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/app"
+	domainService "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+)
+
+// listFlag collects a flag that may be repeated.
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(value string) error {
+	*l = append(*l, value)
+
+	return nil
+}
+
+// clientCommand registers an OAuth client. The secret is printed once and
+// only its hash is kept.
+func clientCommand(cfg *configs.Config, args []string, out io.Writer) error {
+	if len(args) == 0 || args[0] != "create" {
+		return fmt.Errorf("%w: client needs create", errUsage)
+	}
+
+	var redirectURIs, grantTypes, scopes listFlag
+
+	fs := flag.NewFlagSet("client create", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	name := fs.String("name", "", "name of the client")
+	fs.Var(&redirectURIs, "redirect-uri", "redirect URI, may be repeated")
+	fs.Var(&grantTypes, "grant-type", "allowed grant type, may be repeated")
+	fs.Var(&scopes, "scope", "allowed scope, may be repeated")
+	public := fs.Bool("public", false, "register a public client, which gets no secret")
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return fmt.Errorf("%w: %v", errUsage, err)
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%w: client create takes no arguments", errUsage)
+	}
+	if *name == "" || len(grantTypes) == 0 {
+		return fmt.Errorf("%w: client create needs a --name and a --grant-type", errUsage)
+	}
+
+	log, err := commandLogger(cfg)
+	if err != nil {
+		return err
+	}
+
+	accountService, closeAll, err := app.NewAccountService(log, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeAll()
+
+	client, secret, err := accountService.CreateOAuthClient(context.Background(), &domainService.CreateOAuthClientArgs{
+		Name:         *name,
+		RedirectURIs: redirectURIs,
+		GrantTypes:   grantTypes,
+		Scopes:       scopes,
+		Public:       *public,
+	})
+	if err != nil {
+		return fmt.Errorf("Error during creation of client: %v", err)
+	}
+
+	fmt.Fprintf(out, "Created client %s (%s)\n", client.ID, client.Name)
+	if secret != "" {
+		fmt.Fprintf(out, "Secret: %s\n", secret)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+)
+
+// configCommand only runs once Load has succeeded, so what is left to check
+// is what the validation tags cannot express: that each private key matches
+// its public key.
+func configCommand(cfg *configs.Config, args []string, out io.Writer) error {
+	if len(args) != 1 || args[0] != "check" {
+		return fmt.Errorf("%w: config needs check", errUsage)
+	}
+
+	if err := keys.Check(cfg.AccessTokenPrivateKey, cfg.AccessTokenPublicKey); err != nil {
+		return fmt.Errorf("%s: access token keys: %v", configs.ErrInvalidConfig, err)
+	}
+
+	if err := keys.Check(cfg.RefreshTokenPrivateKey, cfg.RefreshTokenPublicKey); err != nil {
+		return fmt.Errorf("%s: refresh token keys: %v", configs.ErrInvalidConfig, err)
+	}
+
+	if err := cfg.Print(out); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out, "\nConfiguration is valid")
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+)
+
+// keysCommand prints the token keys as environment variables, ready to be
+// appended to an .env file.
+func keysCommand(args []string, out io.Writer) error {
+	if len(args) == 0 || args[0] != "generate" {
+		return fmt.Errorf("%w: keys needs generate", errUsage)
+	}
+
+	fs := flag.NewFlagSet("keys generate", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	bits := fs.Int("bits", keys.DefaultBits, "RSA key size")
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return fmt.Errorf("%w: %v", errUsage, err)
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%w: keys generate takes no arguments", errUsage)
+	}
+
+	for _, prefix := range []string{"ACCESS_TOKEN", "REFRESH_TOKEN"} {
+		privateKey, publicKey, err := keys.Generate(*bits)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(out, "%s_PRIVATE_KEY=%s\n", prefix, privateKey)
+		fmt.Fprintf(out, "%s_PUBLIC_KEY=%s\n", prefix, publicKey)
+	}
+
+	return nil
+}
@@ -1,13 +1,15 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"path/filepath"
 
 	"github.com/BlazeCoder04/online_store/libs/logger"
 	"github.com/BlazeCoder04/online_store/services/user/configs"
-	"github.com/BlazeCoder04/online_store/services/user/internal/app"
-	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/migrator"
 )
 
 const (
@@ -15,27 +17,148 @@ const (
 	loggerMigratorTag = "main.migrator"
 )
 
+// errUsage marks errors caused by a malformed command line; they are
+// followed by the usage text and exit with status 2.
+var errUsage = errors.New("usage")
+
 func main() {
-	cfg := configs.Load()
+	args := os.Args[1:]
+
+	// Commands that need no configuration run before it is loaded, so they
+	// work without a database or token keys set up.
+	if len(args) > 0 {
+		switch args[0] {
+		case "help", "-h", "-help", "--help":
+			usage(os.Stdout)
+
+			return
+		case "keys":
+			exit(keysCommand(args[1:], os.Stdout))
+
+			return
+		}
+	}
+
+	cfg, err := configs.Load(args)
+	if err != nil {
+		log.Fatalf("[%s] %v", loggerTag, err)
+	}
+
+	if cfg.PrintConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			log.Fatalf("[%s] Error during printing of config: %v", loggerTag, err)
+		}
+
+		return
+	}
+
+	command, commandArgs := "serve", []string(nil)
+	if len(cfg.Args) > 0 {
+		command, commandArgs = cfg.Args[0], cfg.Args[1:]
+	}
+
+	switch command {
+	case "serve":
+		if len(commandArgs) > 0 {
+			exit(fmt.Errorf("%w: serve takes no arguments", errUsage))
+		}
+
+		serve(&cfg, args)
+	case "migrate":
+		exit(migrateCommand(&cfg, commandArgs, os.Stdout))
+	case "create-admin":
+		exit(createAdminCommand(&cfg, commandArgs, os.Stdin, os.Stdout))
+	case "user":
+		exit(userCommand(&cfg, commandArgs, os.Stdout))
+	case "client":
+		exit(clientCommand(&cfg, commandArgs, os.Stdout))
+	case "apikey":
+		exit(apiKeyCommand(&cfg, commandArgs, os.Stdout))
+	case "keys":
+		exit(keysCommand(commandArgs, os.Stdout))
+	case "config":
+		exit(configCommand(&cfg, commandArgs, os.Stdout))
+	default:
+		exit(fmt.Errorf("%w: unknown command %q", errUsage, command))
+	}
+}
 
-	logger, err := logger.NewAdapter(&logger.Config{
-		Level: logger.LevelDebug - 1,
-	})
+func usage(w io.Writer) {
+	fmt.Fprintf(w, `Usage: %s [config flags] <command> [arguments]
+
+Commands:
+  serve                            run the gRPC and admin servers (default)
+  migrate up                       apply every pending migration
+  migrate down [N|--all]           revert the last N migrations, one by default
+  migrate to|force V               move to version V, or only record it
+  migrate status                   show the current version
+  create-admin --email EMAIL       create an administrator account
+  keys generate [--bits N]         print new RSA key pairs for both tokens
+  user block|unblock USER          block or unblock a user by ID or email
+  client create --name NAME ...    register an OAuth client and print its secret
+  apikey create --service NAME ... create an API key for a service and print it
+  apikey list --service NAME       list the API keys of a service
+  apikey revoke ID                 revoke an API key
+  config check                     validate the configuration and print it
+
+Config flags, such as --config FILE or --server-port 8081, go before the
+command and override the config file and the environment.
+`, filepath.Base(os.Args[0]))
+}
+
+func exit(err error) {
+	switch {
+	case err == nil:
+	case errors.Is(err, errUsage):
+		fmt.Fprintf(os.Stderr, "%v\n\n", err)
+		usage(os.Stderr)
+		os.Exit(2)
+	default:
+		log.Fatalf("[%s] %v", loggerTag, err)
+	}
+}
+
+func loggerConfig(cfg *configs.Config) (*logger.Config, error) {
+	backend, err := logger.ParseBackend(cfg.LogBackend)
 	if err != nil {
-		log.Fatalf("[%s] Error during initialization of logger: %v", loggerTag, err)
+		return nil, err
 	}
 
-	migrator := migrator.NewMigrator(&cfg, logger)
-	if err = migrator.Up(); err != nil {
-		logger.Fatal(loggerMigratorTag, err.Error())
+	level, err := logger.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		return nil, err
 	}
 
-	app, err := app.NewApplication(logger, &cfg)
+	tagLevels, err := logger.ParseTagLevels(cfg.LogTagLevels)
 	if err != nil {
-		logger.Fatal(loggerTag, fmt.Sprintf("Error during initializtion application: %v", err))
+		return nil, err
 	}
 
-	if err := app.Run(); err != nil {
-		logger.Fatal(loggerTag, fmt.Sprintf("Error during run application: %v", err))
+	format, err := logger.ParseFormat(cfg.LogFormat)
+	if err != nil {
+		return nil, err
 	}
+
+	return &logger.Config{
+		Backend:   backend,
+		Level:     level,
+		TagLevels: tagLevels,
+		Format:    format,
+	}, nil
+}
+
+// commandLogger builds the logger for the one-off commands. Unless a level
+// is set explicitly they only log warnings and errors, so initialization
+// messages do not drown their output.
+func commandLogger(cfg *configs.Config) (logger.Logger, error) {
+	logConfig, err := loggerConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("Error during initialization of logger: %v", err)
+	}
+
+	if cfg.Source("log_level") == configs.SourceDefault {
+		logConfig.Level = logger.LevelWarn
+	}
+
+	return logger.NewAdapter(logConfig)
 }
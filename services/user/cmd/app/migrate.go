@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/migrator"
+)
+
+// migrateCommand runs one of:
+//
+//	migrate up            apply every pending migration
+//	migrate down [N]      revert the last N migrations, one by default
+//	migrate down --all    revert every migration
+//	migrate to V          move up or down to version V
+//	migrate force V       set the version and clear the dirty flag
+//	migrate status        show the current version
+//
+// Every command but status prints the version it left the database at.
+func migrateCommand(cfg *configs.Config, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: migrate needs up, down, to, force or status", errUsage)
+	}
+
+	run, err := migrateAction(args[0], args[1:])
+	if err != nil {
+		return err
+	}
+
+	log, err := commandLogger(cfg)
+	if err != nil {
+		return err
+	}
+
+	migrator := migrator.NewMigrator(cfg, log)
+
+	if run != nil {
+		if err := run(migrator); err != nil {
+			return err
+		}
+	}
+
+	version, dirty, err := migrator.Version()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case dirty:
+		fmt.Fprintf(out, "Version %d (dirty)\n", version)
+	case version == 0:
+		fmt.Fprintln(out, "No migrations applied")
+	default:
+		fmt.Fprintf(out, "Version %d\n", version)
+	}
+
+	return nil
+}
+
+// migrateAction validates the arguments before anything connects to the
+// database. status has no action.
+func migrateAction(command string, args []string) (func(m *migrator.Migrator) error, error) {
+	switch command {
+	case "up":
+		if len(args) > 0 {
+			return nil, fmt.Errorf("%w: migrate up takes no arguments", errUsage)
+		}
+
+		return (*migrator.Migrator).Up, nil
+	case "status":
+		if len(args) > 0 {
+			return nil, fmt.Errorf("%w: migrate status takes no arguments", errUsage)
+		}
+
+		return nil, nil
+	case "down":
+		switch {
+		case len(args) == 0:
+			return func(m *migrator.Migrator) error { return m.Steps(-1) }, nil
+		case len(args) == 1 && args[0] == "--all":
+			return (*migrator.Migrator).Down, nil
+		case len(args) == 1:
+			n, err := strconv.Atoi(args[0])
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("%w: invalid number of migrations %q", errUsage, args[0])
+			}
+
+			return func(m *migrator.Migrator) error { return m.Steps(-n) }, nil
+		}
+
+		return nil, fmt.Errorf("%w: migrate down takes a number or --all", errUsage)
+	case "to":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%w: migrate to needs a version", errUsage)
+		}
+
+		version, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid version %q", errUsage, args[0])
+		}
+
+		return func(m *migrator.Migrator) error { return m.To(uint(version)) }, nil
+	case "force":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%w: migrate force needs a version", errUsage)
+		}
+
+		// -1 is what golang-migrate uses for "no version".
+		version, err := strconv.Atoi(args[0])
+		if err != nil || version < -1 {
+			return nil, fmt.Errorf("%w: invalid version %q", errUsage, args[0])
+		}
+
+		return func(m *migrator.Migrator) error { return m.Force(version) }, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown migrate command %q", errUsage, command)
+}
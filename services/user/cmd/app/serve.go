@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/app"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/migrator"
+)
+
+// serve runs the application until SIGINT or SIGTERM. args are kept for the
+// config store, which re-reads the flags on every reload.
+func serve(cfg *configs.Config, args []string) {
+	logConfig, err := loggerConfig(cfg)
+	if err != nil {
+		log.Fatalf("[%s] Error during initialization of logger: %v", loggerTag, err)
+	}
+
+	logger, err := logger.NewAdapter(logConfig)
+	if err != nil {
+		log.Fatalf("[%s] Error during initialization of logger: %v", loggerTag, err)
+	}
+
+	if cfg.ConfigFile != "" {
+		logger.Info(loggerTag, fmt.Sprintf("Configuration loaded from %s", cfg.ConfigFile))
+	}
+
+	if cfg.MigrateOnStart {
+		migrator := migrator.NewMigrator(cfg, logger)
+		if err = migrator.Up(); err != nil {
+			logger.Fatal(loggerMigratorTag, err.Error())
+		}
+	}
+
+	app, err := app.NewApplication(logger, configs.NewStore(*cfg, args))
+	if err != nil {
+		logger.Fatal(loggerTag, fmt.Sprintf("Error during initializtion application: %v", err))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	go reloadOnHangup(ctx, app)
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run()
+	}()
+
+	select {
+	case err := <-runErr:
+		if err != nil {
+			logger.Fatal(loggerTag, fmt.Sprintf("Error during run application: %v", err))
+		}
+	case <-ctx.Done():
+		logger.Info(loggerTag, "Shutdown signal received")
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+		defer cancel()
+
+		if err := app.Shutdown(shutdownCtx); err != nil {
+			logger.Error(loggerTag, fmt.Sprintf("Error during shutdown application: %v", err))
+		}
+	}
+}
+
+// reloadOnHangup reloads the configuration on SIGHUP, in addition to the
+// config file watcher.
+func reloadOnHangup(ctx context.Context, app domain.Application) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangup:
+			app.Reload()
+		}
+	}
+}
@@ -1,45 +1,149 @@
 package configs
 
-import (
-	"os"
-	"strconv"
-	"time"
-)
+import "time"
 
+// Config is filled by Load from, in increasing priority: the default tag, a
+// YAML or TOML file, environment variables and command-line flags. The
+// config tag names the key in the file; the environment variable is the
+// upper-cased key and the flag the key with dashes, e.g. server_port,
+// SERVER_PORT and --server-port. Any variable can instead be read from a
+// file named by <VAR>_FILE, which is meant for mounted secrets. Fields tagged
+// reload:"true" are picked up by Store.Reload without a restart.
 type Config struct {
-	ServerPort int
+	ServiceName     string        `config:"service_name" default:"user-service" validate:"required"`
+	ServerPort      int           `config:"server_port" validate:"required,min=1,max=65535"`
+	ShutdownTimeout time.Duration `config:"shutdown_timeout" default:"15s" validate:"min=1s"`
 
-	PostgresDSN          string
-	PostgresMigrationDSN string
+	LogBackend   string `config:"log_backend" default:"zap" validate:"oneof=zap slog"`
+	LogLevel     string `config:"log_level" default:"debug" validate:"oneof=debug info warn error panic fatal" reload:"true"`
+	LogTagLevels string `config:"log_tag_levels" reload:"true"`
+	LogFormat    string `config:"log_format" default:"console" validate:"oneof=console json logfmt"`
 
-	RedisPassword string
-	RedisURI      string
+	AdminPort           int           `config:"admin_port" default:"9090" validate:"min=1,max=65535"`
+	HealthCheckInterval time.Duration `config:"health_check_interval" default:"5s" validate:"min=100ms"`
+	HealthCheckTimeout  time.Duration `config:"health_check_timeout" default:"2s" validate:"min=100ms"`
+	MetricsPath         string        `config:"metrics_path" default:"/metrics" validate:"required"`
 
-	AccessTokenPrivateKey string
-	AccessTokenPublicKey  string
-	AccessTokenExpiresIn  time.Duration
+	TracingExporter    string  `config:"tracing_exporter" default:"none" validate:"oneof=none stdout otlp"`
+	TracingEndpoint    string  `config:"tracing_endpoint"`
+	TracingInsecure    bool    `config:"tracing_insecure"`
+	TracingSampleRatio float64 `config:"tracing_sample_ratio" default:"1" validate:"min=0,max=1"`
 
-	RefreshTokenPrivateKey string
-	RefreshTokenPublicKey  string
-	RefreshTokenExpiresIn  time.Duration
-}
+	// RateLimitAuthRPS is the number of auth RPCs a single client IP may make
+	// per second; zero turns the limit off.
+	RateLimitAuthRPS   float64 `config:"rate_limit_auth_rps" default:"5" validate:"min=0" reload:"true"`
+	RateLimitAuthBurst int     `config:"rate_limit_auth_burst" default:"10" validate:"min=1" reload:"true"`
+
+	// TrustedProxies are the addresses or CIDR prefixes of the proxies in
+	// front of the service, such as the gateway. The client IP of a request
+	// coming through one of them is read from its x-forwarded-for metadata;
+	// any other peer is the client itself.
+	TrustedProxies []string `config:"trusted_proxies" validate:"cidr" reload:"true"`
+
+	// SmsSender delivers the phone verification codes; "log" only logs them.
+	SmsSender           string        `config:"sms_sender" default:"log" validate:"oneof=log"`
+	PhoneOTPExpiresIn   time.Duration `config:"phone_otp_expires_in" default:"5m" validate:"min=30s" reload:"true"`
+	PhoneOTPMaxAttempts int           `config:"phone_otp_max_attempts" default:"5" validate:"min=1" reload:"true"`
+	PhoneOTPResendAfter time.Duration `config:"phone_otp_resend_after" default:"30s" validate:"min=0s" reload:"true"`
+
+	// EmailSender delivers the sign-in links; "log" only logs them.
+	// MagicLinkURL is the page of the storefront that takes the token from
+	// its query and passes it to ConsumeMagicLink.
+	EmailSender        string        `config:"email_sender" default:"log" validate:"oneof=log"`
+	MagicLinkURL       string        `config:"magic_link_url" default:"http://localhost:3000/auth/magic-link" validate:"required" reload:"true"`
+	MagicLinkExpiresIn time.Duration `config:"magic_link_expires_in" default:"5m" validate:"min=1m,max=15m" reload:"true"`
+
+	// BlobStorage keeps uploaded files: "local" writes them under
+	// BlobLocalDir, "s3" to BlobS3Bucket on any S3-compatible service. Files
+	// are linked as BlobPublicURL followed by their key, so that URL has to be
+	// served by a file server, a CDN or the bucket itself.
+	BlobStorage           string `config:"blob_storage" default:"local" validate:"oneof=local s3"`
+	BlobPublicURL         string `config:"blob_public_url" default:"/media" validate:"required"`
+	BlobLocalDir          string `config:"blob_local_dir" default:"data/blobs"`
+	BlobS3Endpoint        string `config:"blob_s3_endpoint"`
+	BlobS3Region          string `config:"blob_s3_region"`
+	BlobS3Bucket          string `config:"blob_s3_bucket"`
+	BlobS3AccessKeyID     string `config:"blob_s3_access_key_id" secret:"true"`
+	BlobS3SecretAccessKey string `config:"blob_s3_secret_access_key" secret:"true"`
+	BlobS3UseSSL          bool   `config:"blob_s3_use_ssl" default:"true"`
+
+	// AvatarMaxSize is in bytes. AvatarSizes are the edge lengths, in pixels,
+	// of the square thumbnails made from every uploaded avatar.
+	AvatarMaxSize int64 `config:"avatar_max_size" default:"5242880" validate:"min=1024" reload:"true"`
+	AvatarSizes   []int `config:"avatar_sizes" default:"64,128,256" validate:"required,min=16,max=1024" reload:"true"`
+
+	// An identity provider is enabled by setting its client ID. Its redirect
+	// URL must lead to the storefront page that passes the code and state on
+	// to /v1/auth/oauth/<provider>/callback, along with the browser nonce.
+	// OAuthStateExpiresIn is how long the user has to sign in at the provider.
+	OAuthStateExpiresIn     time.Duration `config:"oauth_state_expires_in" default:"10m" validate:"min=1m" reload:"true"`
+	OAuthGoogleIssuer       string        `config:"oauth_google_issuer" default:"https://accounts.google.com" validate:"required"`
+	OAuthGoogleClientID     string        `config:"oauth_google_client_id"`
+	OAuthGoogleClientSecret string        `config:"oauth_google_client_secret" secret:"true"`
+	OAuthGoogleRedirectURL  string        `config:"oauth_google_redirect_url"`
+	OAuthGitHubURL          string        `config:"oauth_github_url" default:"https://github.com" validate:"required"`
+	OAuthGitHubAPIURL       string        `config:"oauth_github_api_url" default:"https://api.github.com" validate:"required"`
+	OAuthGitHubClientID     string        `config:"oauth_github_client_id"`
+	OAuthGitHubClientSecret string        `config:"oauth_github_client_secret" secret:"true"`
+	OAuthGitHubRedirectURL  string        `config:"oauth_github_redirect_url"`
+
+	// OAuthCodeExpiresIn is how long a client has to exchange an
+	// authorization code issued by /v1/oauth/authorize.
+	OAuthCodeExpiresIn time.Duration `config:"oauth_code_expires_in" default:"1m" validate:"min=10s,max=10m" reload:"true"`
+
+	// WebAuthnRPID is the domain passkeys are bound to; the storefront must
+	// be served from it or one of its subdomains. WebAuthnRPOrigins are the
+	// origins allowed to run the ceremonies, and PasskeyChallengeExpiresIn is
+	// how long the user has to answer one.
+	WebAuthnRPID              string        `config:"webauthn_rp_id" default:"localhost" validate:"required"`
+	WebAuthnRPName            string        `config:"webauthn_rp_name" default:"Online Store" validate:"required"`
+	WebAuthnRPOrigins         []string      `config:"webauthn_rp_origins" default:"http://localhost:3000" validate:"required"`
+	PasskeyChallengeExpiresIn time.Duration `config:"passkey_challenge_expires_in" default:"5m" validate:"min=30s,max=10m"`
+
+	// MigrateOnStart makes serve apply pending migrations before starting.
+	// MigrationLockTimeout bounds the wait for another replica to finish
+	// migrating.
+	MigrateOnStart       bool          `config:"migrate_on_start" default:"true"`
+	MigrationLockTimeout time.Duration `config:"migration_lock_timeout" default:"5m" validate:"min=1s"`
+
+	StartupPingAttempts int           `config:"startup_ping_attempts" default:"5" validate:"min=1"`
+	StartupPingBackoff  time.Duration `config:"startup_ping_backoff" default:"1s" validate:"min=0s"`
+
+	PostgresDSN               string        `config:"postgres_dsn" validate:"required" secret:"true"`
+	PostgresMigrationDSN      string        `config:"postgres_migration_dsn" validate:"required" secret:"true"`
+	PostgresMaxConns          int32         `config:"postgres_max_conns" validate:"min=0"`
+	PostgresMinConns          int32         `config:"postgres_min_conns" validate:"min=0"`
+	PostgresMaxConnLifetime   time.Duration `config:"postgres_max_conn_lifetime" validate:"min=0s"`
+	PostgresMaxConnIdleTime   time.Duration `config:"postgres_max_conn_idle_time" validate:"min=0s"`
+	PostgresHealthCheckPeriod time.Duration `config:"postgres_health_check_period" validate:"min=0s"`
+	PostgresConnectTimeout    time.Duration `config:"postgres_connect_timeout" validate:"min=0s"`
 
-func Load() (cfg Config) {
-	cfg.ServerPort, _ = strconv.Atoi(os.Getenv("SERVER_PORT"))
+	RedisPassword     string        `config:"redis_password" secret:"true"`
+	RedisURI          string        `config:"redis_uri" validate:"required"`
+	RedisPoolSize     int           `config:"redis_pool_size" validate:"min=0"`
+	RedisDialTimeout  time.Duration `config:"redis_dial_timeout" validate:"min=0s"`
+	RedisReadTimeout  time.Duration `config:"redis_read_timeout" validate:"min=0s"`
+	RedisWriteTimeout time.Duration `config:"redis_write_timeout" validate:"min=0s"`
 
-	cfg.PostgresDSN = os.Getenv("POSTGRES_DSN")
-	cfg.PostgresMigrationDSN = os.Getenv("POSTGRES_MIGRATION_DSN")
+	AccessTokenPrivateKey string        `config:"access_token_private_key" validate:"required" secret:"true"`
+	AccessTokenPublicKey  string        `config:"access_token_public_key" validate:"required"`
+	AccessTokenExpiresIn  time.Duration `config:"access_token_expires_in" validate:"required,min=1s" reload:"true"`
 
-	cfg.RedisPassword = os.Getenv("REDIS_PASSWORD")
-	cfg.RedisURI = os.Getenv("REDIS_URI")
+	RefreshTokenPrivateKey string        `config:"refresh_token_private_key" validate:"required" secret:"true"`
+	RefreshTokenPublicKey  string        `config:"refresh_token_public_key" validate:"required"`
+	RefreshTokenExpiresIn  time.Duration `config:"refresh_token_expires_in" validate:"required,min=1s" reload:"true"`
 
-	cfg.AccessTokenPrivateKey = os.Getenv("ACCESS_TOKEN_PRIVATE_KEY")
-	cfg.AccessTokenPublicKey = os.Getenv("ACCESS_TOKEN_PUBLIC_KEY")
-	cfg.AccessTokenExpiresIn, _ = time.ParseDuration(os.Getenv("ACCESS_TOKEN_EXPIRES_IN"))
+	// ImpersonationTokenExpiresIn is the lifetime of the access tokens issued
+	// to admins acting as another user. They cannot be refreshed.
+	ImpersonationTokenExpiresIn time.Duration `config:"impersonation_token_expires_in" default:"15m" validate:"min=1m,max=1h" reload:"true"`
 
-	cfg.RefreshTokenPrivateKey = os.Getenv("REFRESH_TOKEN_PRIVATE_KEY")
-	cfg.RefreshTokenPublicKey = os.Getenv("REFRESH_TOKEN_PUBLIC_KEY")
-	cfg.RefreshTokenExpiresIn, _ = time.ParseDuration(os.Getenv("REFRESH_TOKEN_EXPIRES_IN"))
+	// ConfigFile is taken from --config or CONFIG_FILE; PrintConfig from
+	// --print-config. Neither can be set in the file itself. Args holds what
+	// is left after the flags: the command and its own arguments.
+	ConfigFile  string
+	PrintConfig bool
+	Args        []string
 
-	return cfg
+	sources map[string]Source
+	invalid map[string]bool
 }
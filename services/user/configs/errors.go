@@ -0,0 +1,11 @@
+package configs
+
+const (
+	ErrInvalidConfig   = "invalid configuration"
+	ErrReadFile        = "error reading config file"
+	ErrUnsupportedFile = "unsupported config file extension"
+	ErrUnknownKey      = "unknown key"
+	ErrNotScalar       = "value must be a scalar"
+	ErrBothValueFile   = "both the variable and its _FILE variant are set"
+	ErrWatchFile       = "error watching config file"
+)
@@ -0,0 +1,317 @@
+package configs
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+type Source string
+
+const (
+	SourceDefault Source = "default"
+	SourceFile    Source = "file"
+	SourceEnv     Source = "env"
+	SourceFlag    Source = "flag"
+)
+
+const (
+	configFileEnv  = "CONFIG_FILE"
+	configFileFlag = "config"
+	printFlag      = "print-config"
+	fileEnvSuffix  = "_FILE"
+)
+
+type field struct {
+	key   string
+	value reflect.Value
+	tag   reflect.StructTag
+}
+
+func (f field) env() string {
+	return strings.ToUpper(f.key)
+}
+
+func (f field) flag() string {
+	return strings.ReplaceAll(f.key, "_", "-")
+}
+
+func (f field) secret() bool {
+	return f.tag.Get("secret") == "true"
+}
+
+func fieldsOf(cfg *Config) []field {
+	v := reflect.ValueOf(cfg).Elem()
+	t := v.Type()
+
+	fields := make([]field, 0, t.NumField())
+	for i := range t.NumField() {
+		key := t.Field(i).Tag.Get("config")
+		if key == "" {
+			continue
+		}
+
+		fields = append(fields, field{key, v.Field(i), t.Field(i).Tag})
+	}
+
+	return fields
+}
+
+// Load builds the configuration from every layer and validates it. All
+// problems are reported together in the returned error, one per line.
+func Load(args []string) (Config, error) {
+	cfg := Config{
+		sources: make(map[string]Source),
+		invalid: make(map[string]bool),
+	}
+	fields := fieldsOf(&cfg)
+
+	var errs []error
+
+	for _, f := range fields {
+		if raw, ok := f.tag.Lookup("default"); ok {
+			errs = append(errs, cfg.set(f, raw, SourceDefault))
+		}
+	}
+
+	flagValues, err := parseFlags(&cfg, fields, args)
+	if err != nil {
+		return cfg, fmt.Errorf("%s:\n  - %v", ErrInvalidConfig, err)
+	}
+
+	if cfg.ConfigFile == "" {
+		cfg.ConfigFile = os.Getenv(configFileEnv)
+	}
+	if cfg.ConfigFile != "" {
+		errs = append(errs, loadFile(&cfg, fields, cfg.ConfigFile)...)
+	}
+
+	for _, f := range fields {
+		raw, ok, err := lookupEnv(f.env())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", f.env(), err))
+
+			continue
+		}
+		if ok {
+			errs = append(errs, cfg.set(f, raw, SourceEnv))
+		}
+	}
+
+	for _, f := range fields {
+		if raw, ok := flagValues[f.flag()]; ok {
+			errs = append(errs, cfg.set(f, raw, SourceFlag))
+		}
+	}
+
+	errs = append(errs, validate(fields, cfg.invalid)...)
+
+	if err := joinErrors(errs); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) set(f field, raw string, source Source) error {
+	if err := setValue(f.value, raw); err != nil {
+		c.invalid[f.key] = true
+
+		return fmt.Errorf("%s (%s): %v", f.key, source, err)
+	}
+
+	c.sources[f.key] = source
+
+	return nil
+}
+
+// Source reports which layer the value of key came from.
+func (c *Config) Source(key string) Source {
+	if source, ok := c.sources[key]; ok {
+		return source
+	}
+
+	return SourceDefault
+}
+
+func setValue(v reflect.Value, raw string) error {
+	raw = strings.TrimSpace(raw)
+
+	switch v.Interface().(type) {
+	case time.Duration:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", raw)
+		}
+		v.SetInt(int64(d))
+
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", raw)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", raw)
+		}
+		v.SetInt(n)
+	case reflect.Float64:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", raw)
+		}
+		v.SetFloat(n)
+	case reflect.Slice:
+		switch v.Type().Elem().Kind() {
+		case reflect.Int, reflect.String:
+		default:
+			return fmt.Errorf("unsupported type %s", v.Type())
+		}
+
+		var items []string
+		if raw != "" {
+			items = strings.Split(raw, ",")
+		}
+
+		list := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := setValue(list.Index(i), item); err != nil {
+				return fmt.Errorf("%v in list", err)
+			}
+		}
+		v.Set(list)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	return nil
+}
+
+// lookupEnv reads NAME, or the file named by NAME_FILE. Setting both is an
+// error rather than a silent precedence rule.
+func lookupEnv(name string) (string, bool, error) {
+	value, ok := os.LookupEnv(name)
+	path, fileOk := os.LookupEnv(name + fileEnvSuffix)
+
+	switch {
+	case ok && fileOk:
+		return "", false, errors.New(ErrBothValueFile)
+	case fileOk:
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", false, fmt.Errorf("%s: %v", ErrReadFile, err)
+		}
+
+		return strings.TrimRight(string(content), "\r\n"), true, nil
+	default:
+		return value, ok, nil
+	}
+}
+
+func loadFile(cfg *Config, fields []field, path string) []error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return []error{fmt.Errorf("%s: %v", ErrReadFile, err)}
+	}
+
+	values := make(map[string]any)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &values)
+	case ".toml":
+		err = toml.Unmarshal(content, &values)
+	default:
+		return []error{fmt.Errorf("%s: %q", ErrUnsupportedFile, filepath.Ext(path))}
+	}
+	if err != nil {
+		return []error{fmt.Errorf("%s %s: %v", ErrReadFile, path, err)}
+	}
+
+	byKey := make(map[string]field, len(fields))
+	for _, f := range fields {
+		byKey[f.key] = f
+	}
+
+	var errs []error
+	for key, value := range values {
+		f, ok := byKey[key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s (%s): %s", key, SourceFile, ErrUnknownKey))
+
+			continue
+		}
+
+		switch value.(type) {
+		case map[string]any, []any:
+			errs = append(errs, fmt.Errorf("%s (%s): %s", key, SourceFile, ErrNotScalar))
+
+			continue
+		}
+
+		errs = append(errs, cfg.set(f, fmt.Sprint(value), SourceFile))
+	}
+
+	return errs
+}
+
+// parseFlags returns the raw values of the flags that were passed, so they
+// can be applied after the environment.
+func parseFlags(cfg *Config, fields []field, args []string) (map[string]string, error) {
+	fs := flag.NewFlagSet("user", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	fs.StringVar(&cfg.ConfigFile, configFileFlag, "", "path to a YAML or TOML config file")
+	fs.BoolVar(&cfg.PrintConfig, printFlag, false, "print the effective config and exit")
+
+	for _, f := range fields {
+		fs.String(f.flag(), "", f.key)
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	cfg.Args = fs.Args()
+
+	values := make(map[string]string)
+	fs.Visit(func(fl *flag.Flag) {
+		if fl.Name != configFileFlag && fl.Name != printFlag {
+			values[fl.Name] = fl.Value.String()
+		}
+	})
+
+	return values, nil
+}
+
+func joinErrors(errs []error) error {
+	var lines []string
+	for _, err := range errs {
+		if err != nil {
+			lines = append(lines, "  - "+err.Error())
+		}
+	}
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s:\n%s", ErrInvalidConfig, strings.Join(lines, "\n"))
+}
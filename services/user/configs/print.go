@@ -0,0 +1,44 @@
+package configs
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+const redacted = "******"
+
+// Redacted returns every key with its effective value; secrets that are set
+// are replaced with a placeholder.
+func (c *Config) Redacted() map[string]string {
+	values := make(map[string]string)
+	for _, f := range fieldsOf(c) {
+		values[f.key] = c.redactedValue(f)
+	}
+
+	return values
+}
+
+func (c *Config) redactedValue(f field) string {
+	if f.secret() && !f.value.IsZero() {
+		return redacted
+	}
+
+	return format(f.value)
+}
+
+// Print writes the effective configuration in declaration order, with the
+// layer every value came from.
+func (c *Config) Print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	if c.ConfigFile != "" {
+		fmt.Fprintf(tw, "# config file: %s\n", c.ConfigFile)
+	}
+
+	for _, f := range fieldsOf(c) {
+		fmt.Fprintf(tw, "%s\t%s\t# %s\n", f.key, c.redactedValue(f), c.Source(f.key))
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,158 @@
+package configs
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadDebounce groups the burst of events an editor or a Kubernetes
+// ConfigMap swap produces into one reload.
+const reloadDebounce = 200 * time.Millisecond
+
+// Provider gives access to the current configuration. Code that reads
+// hot-reloadable values keeps a Provider and calls Current on every use
+// instead of keeping the *Config it was built with. A *Config is a Provider
+// that never changes, which is what tests pass.
+type Provider interface {
+	Current() *Config
+}
+
+func (c *Config) Current() *Config {
+	return c
+}
+
+// Store holds the active snapshot and swaps it atomically on reload. Only
+// fields tagged reload:"true" are taken from a reloaded configuration; a
+// change to any other field is reported and needs a restart.
+type Store struct {
+	current     atomic.Pointer[Config]
+	args        []string
+	mu          sync.Mutex
+	subscribers []func(previous, current *Config)
+}
+
+func NewStore(cfg Config, args []string) *Store {
+	s := &Store{args: args}
+	s.current.Store(&cfg)
+
+	return s
+}
+
+func (s *Store) Current() *Config {
+	return s.current.Load()
+}
+
+// Subscribe registers fn to be called after every successful reload.
+func (s *Store) Subscribe(fn func(previous, current *Config)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscribers = append(s.subscribers, fn)
+}
+
+// Reload loads and validates the configuration again. On error the current
+// snapshot stays in place. The returned keys are the static fields whose
+// new value was ignored.
+func (s *Store) Reload() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	loaded, err := Load(s.args)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := s.current.Load()
+	next := *previous
+	next.sources = make(map[string]Source, len(previous.sources))
+	for key, source := range previous.sources {
+		next.sources[key] = source
+	}
+
+	var ignored []string
+	nextFields, loadedFields := fieldsOf(&next), fieldsOf(&loaded)
+	for i, f := range nextFields {
+		if reflect.DeepEqual(f.value.Interface(), loadedFields[i].value.Interface()) {
+			continue
+		}
+
+		if f.tag.Get("reload") != "true" {
+			ignored = append(ignored, f.key)
+
+			continue
+		}
+
+		f.value.Set(loadedFields[i].value)
+		next.sources[f.key] = loaded.Source(f.key)
+	}
+
+	s.current.Store(&next)
+
+	for _, fn := range s.subscribers {
+		fn(previous, &next)
+	}
+
+	return ignored, nil
+}
+
+// Watch reloads the configuration whenever its file changes, until ctx is
+// done. The directory is watched rather than the file, so files replaced by
+// rename keep being picked up. Results are passed to onReload.
+func (s *Store) Watch(ctx context.Context, onReload func(ignored []string, err error)) error {
+	path := s.Current().ConfigFile
+	if path == "" {
+		return nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("%s: %v", ErrWatchFile, err)
+	}
+
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+
+		return fmt.Errorf("%s: %v", ErrWatchFile, err)
+	}
+
+	go func() {
+		defer watcher.Close()
+
+		var debounce <-chan time.Time
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Has(fsnotify.Chmod) {
+					continue
+				}
+
+				debounce = time.After(reloadDebounce)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+
+				onReload(nil, fmt.Errorf("%s: %v", ErrWatchFile, err))
+			case <-debounce:
+				debounce = nil
+
+				onReload(s.Reload())
+			}
+		}
+	}()
+
+	return nil
+}
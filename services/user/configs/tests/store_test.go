@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_Reload(t *testing.T) {
+	for key, value := range requiredEnv {
+		if key == "ACCESS_TOKEN_EXPIRES_IN" {
+			continue
+		}
+
+		t.Setenv(key, value)
+	}
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("access_token_expires_in: 15m\nadmin_port: 9090\n"), 0o600))
+	args := []string{"--config", path}
+
+	cfg, err := configs.Load(args)
+	require.NoError(t, err)
+
+	store := configs.NewStore(cfg, args)
+	first := store.Current()
+
+	var notified int
+	store.Subscribe(func(previous, current *configs.Config) {
+		notified++
+		require.Equal(t, 15*time.Minute, previous.AccessTokenExpiresIn)
+	})
+
+	require.NoError(t, os.WriteFile(path, []byte("access_token_expires_in: 5m\nadmin_port: 9191\nlog_level: info\n"), 0o600))
+
+	ignored, err := store.Reload()
+	require.NoError(t, err)
+	require.Equal(t, []string{"admin_port"}, ignored)
+	require.Equal(t, 1, notified)
+
+	current := store.Current()
+	require.Equal(t, 5*time.Minute, current.AccessTokenExpiresIn)
+	require.Equal(t, "info", current.LogLevel)
+	require.Equal(t, 9090, current.AdminPort)
+	require.Equal(t, configs.SourceFile, current.Source("log_level"))
+
+	// The previous snapshot is never mutated.
+	require.Equal(t, 15*time.Minute, first.AccessTokenExpiresIn)
+
+	require.NoError(t, os.WriteFile(path, []byte("access_token_expires_in: 0s\n"), 0o600))
+
+	_, err = store.Reload()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "access_token_expires_in")
+	require.Equal(t, 5*time.Minute, store.Current().AccessTokenExpiresIn)
+	require.Equal(t, 1, notified)
+}
+
+func TestStore_Watch(t *testing.T) {
+	for key, value := range requiredEnv {
+		t.Setenv(key, value)
+	}
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("rate_limit_auth_rps: 5\n"), 0o600))
+	args := []string{"--config", path}
+
+	cfg, err := configs.Load(args)
+	require.NoError(t, err)
+
+	store := configs.NewStore(cfg, args)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reloads := make(chan error, 8)
+	require.NoError(t, store.Watch(ctx, func(_ []string, err error) {
+		reloads <- err
+	}))
+
+	// Replace the file by rename, the way editors and ConfigMaps do.
+	tmp := path + ".tmp"
+	require.NoError(t, os.WriteFile(tmp, []byte("rate_limit_auth_rps: 50\n"), 0o600))
+	require.NoError(t, os.Rename(tmp, path))
+
+	select {
+	case err := <-reloads:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("config file change was not picked up")
+	}
+
+	require.Equal(t, 50.0, store.Current().RateLimitAuthRPS)
+}
@@ -0,0 +1,140 @@
+package configs
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// validate checks the rules in the validate tag: required, min=, max=,
+// oneof= (space separated) and cidr. Bounds of durations are durations
+// themselves, and bounds and cidr of lists apply to every element.
+// Keys that could not be parsed are skipped, and only the first broken rule
+// of a key is reported.
+func validate(fields []field, invalid map[string]bool) []error {
+	var errs []error
+
+	for _, f := range fields {
+		rules := f.tag.Get("validate")
+		if rules == "" || invalid[f.key] {
+			continue
+		}
+
+		for _, rule := range strings.Split(rules, ",") {
+			name, arg, _ := strings.Cut(rule, "=")
+
+			if err := check(f.value, name, arg); err != nil {
+				errs = append(errs, fmt.Errorf("%s (%s): %v", f.key, f.env(), err))
+
+				break
+			}
+		}
+	}
+
+	return errs
+}
+
+func check(v reflect.Value, rule, arg string) error {
+	switch rule {
+	case "required":
+		if v.IsZero() {
+			return errors.New("is required")
+		}
+	case "min", "max", "cidr":
+		if v.Kind() == reflect.Slice {
+			for i := range v.Len() {
+				if err := check(v.Index(i), rule, arg); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+
+		if rule == "cidr" {
+			if !validPrefix(v.String()) {
+				return fmt.Errorf("must be an IP address or a CIDR prefix, got %q", v.String())
+			}
+
+			return nil
+		}
+
+		value, bound, err := compare(v, arg)
+		if err != nil {
+			return err
+		}
+		if rule == "min" && value < bound {
+			return fmt.Errorf("must be at least %s, got %s", arg, format(v))
+		}
+		if rule == "max" && value > bound {
+			return fmt.Errorf("must be at most %s, got %s", arg, format(v))
+		}
+	case "oneof":
+		allowed := strings.Fields(arg)
+		if !slices.Contains(allowed, v.String()) {
+			return fmt.Errorf("must be one of %s, got %q", strings.Join(allowed, ", "), v.String())
+		}
+	default:
+		return fmt.Errorf("unknown validation rule %q", rule)
+	}
+
+	return nil
+}
+
+// validPrefix accepts a CIDR prefix, e.g. 10.0.0.0/8, or a single address.
+func validPrefix(s string) bool {
+	if _, err := netip.ParsePrefix(s); err == nil {
+		return true
+	}
+
+	_, err := netip.ParseAddr(s)
+
+	return err == nil
+}
+
+func compare(v reflect.Value, arg string) (float64, float64, error) {
+	if d, ok := v.Interface().(time.Duration); ok {
+		bound, err := time.ParseDuration(arg)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid duration bound %q", arg)
+		}
+
+		return float64(d), float64(bound), nil
+	}
+
+	bound, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid bound %q", arg)
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), bound, nil
+	case reflect.Float64:
+		return v.Float(), bound, nil
+	default:
+		return 0, 0, fmt.Errorf("min and max do not apply to %s", v.Type())
+	}
+}
+
+func format(v reflect.Value) string {
+	if d, ok := v.Interface().(time.Duration); ok {
+		return d.String()
+	}
+
+	if v.Kind() == reflect.Slice {
+		items := make([]string, v.Len())
+		for i := range v.Len() {
+			items[i] = format(v.Index(i))
+		}
+
+		return strings.Join(items, ",")
+	}
+
+	return fmt.Sprint(v.Interface())
+}
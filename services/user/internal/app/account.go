@@ -0,0 +1,83 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainService "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+	redisClient "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/cache/redis"
+	tokenAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/cache/redis/token"
+	database "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/database/postgres"
+	apiKeyRepo "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/repositories/apikey"
+	oauthClientRepo "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/repositories/oauthclient"
+	roleRepo "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/repositories/role"
+	userRepo "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/repositories/user"
+	accountService "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/account"
+)
+
+// NewAccountService wires the account service for the one-off commands,
+// which need the database and redis but none of the servers. The returned
+// function releases both connections.
+func NewAccountService(logger logger.Logger, cfg *configs.Config) (domainService.AccountService, func(), error) {
+	db, err := database.NewPool(logger, cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error initializing database pool: %v", err)
+	}
+
+	userRepository, err := userRepo.NewUserRepository(db, logger, cfg)
+	if err != nil {
+		db.Close()
+
+		return nil, nil, fmt.Errorf("error initializing user repository: %v", err)
+	}
+
+	oauthClientRepository, err := oauthClientRepo.NewOAuthClientRepository(db, logger, cfg)
+	if err != nil {
+		db.Close()
+
+		return nil, nil, fmt.Errorf("error initializing oauth client repository: %v", err)
+	}
+
+	apiKeyRepository, err := apiKeyRepo.NewAPIKeyRepository(db, logger, cfg)
+	if err != nil {
+		db.Close()
+
+		return nil, nil, fmt.Errorf("error initializing api key repository: %v", err)
+	}
+
+	roleRepository, err := roleRepo.NewRoleRepository(db, logger, cfg)
+	if err != nil {
+		db.Close()
+
+		return nil, nil, fmt.Errorf("error initializing role repository: %v", err)
+	}
+
+	redisClient, err := redisClient.NewClient(logger, cfg)
+	if err != nil {
+		db.Close()
+
+		return nil, nil, fmt.Errorf("error initializing redis client: %v", err)
+	}
+
+	closeAll := func() {
+		db.Close()
+		_ = redisClient.Close()
+	}
+
+	tokenAdapter, err := tokenAdapter.NewTokenAdapter(redisClient, logger, cfg)
+	if err != nil {
+		closeAll()
+
+		return nil, nil, fmt.Errorf("error initializing token repository: %v", err)
+	}
+
+	accountService, err := accountService.NewAccountService(userRepository, oauthClientRepository, apiKeyRepository, roleRepository, database.NewTxManager(db), tokenAdapter, logger)
+	if err != nil {
+		closeAll()
+
+		return nil, nil, fmt.Errorf("error initializing account service: %v", err)
+	}
+
+	return accountService, closeAll, nil
+}
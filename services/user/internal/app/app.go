@@ -1,51 +1,239 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/BlazeCoder04/online_store/libs/logger"
 	"github.com/BlazeCoder04/online_store/services/user/configs"
 	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
 	server "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure"
+	redisClient "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/cache/redis"
+	authCodeAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/cache/redis/authcode"
+	magicLinkAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/cache/redis/magiclink"
+	oauthStateAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/cache/redis/oauthstate"
+	otpAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/cache/redis/otp"
+	passkeyChallengeAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/cache/redis/passkeychallenge"
 	tokenAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/cache/redis/token"
+	emailAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/email"
+	oauthAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/oauth"
+	smsAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/sms"
+	storageAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/storage"
+	webauthnAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/webauthn"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/admin"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/authn"
+	database "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/database/postgres"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/health"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/impersonation"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/logging"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/metrics"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/ratelimit"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/rbac"
+	addressRepo "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/repositories/address"
+	apiKeyRepo "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/repositories/apikey"
+	identityRepo "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/repositories/identity"
+	oauthClientRepo "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/repositories/oauthclient"
+	passkeyRepo "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/repositories/passkey"
+	roleRepo "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/repositories/role"
 	userRepo "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/repositories/user"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/scope"
+	adminService "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/admin"
 	authService "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/auth"
+	oauthService "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/oauth"
 	profileService "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/profile"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/tracing"
+	adminHandler "github.com/BlazeCoder04/online_store/services/user/internal/interfaces/handlers/admin"
 	authHandler "github.com/BlazeCoder04/online_store/services/user/internal/interfaces/handlers/auth"
+	oauthHandler "github.com/BlazeCoder04/online_store/services/user/internal/interfaces/handlers/oauth"
 	profileHandler "github.com/BlazeCoder04/online_store/services/user/internal/interfaces/handlers/profile"
+	authDesc "github.com/BlazeCoder04/online_store/services/user/pkg/auth/v1"
+	oauthDesc "github.com/BlazeCoder04/online_store/services/user/pkg/oauth/v1"
+	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgx/v5/pgxpool"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc"
 )
 
 type Application struct {
-	server domain.Server
-	logger logger.Logger
-	cfg    *configs.Config
+	server      domain.Server
+	adminServer domain.Server
+	monitor     *health.Monitor
+	tracer      *sdktrace.TracerProvider
+	db          *pgxpool.Pool
+	redisClient *redis.Client
+	store       *configs.Store
+	stopWatch   context.CancelFunc
+	logger      logger.Logger
+	cfg         *configs.Config
 }
 
-func NewApplication(logger logger.Logger, cfg *configs.Config) (domain.Application, error) {
+// NewApplication builds every component from the current snapshot of store.
+// Components that read hot-reloadable values get the store itself. If any
+// step fails, whatever was opened before it is released again.
+func NewApplication(logger logger.Logger, store *configs.Store) (_ domain.Application, err error) {
 	loggerTag := "application.newApplication"
 
+	cfg := store.Current()
+
 	logger.Info(loggerTag, "Initializing application")
 
-	userRepository, err := userRepo.NewUserRepository(logger, cfg)
+	var cleanup []func()
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		for i := len(cleanup) - 1; i >= 0; i-- {
+			cleanup[i]()
+		}
+	}()
+
+	tracer, err := tracing.NewProvider(logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing tracing: %v", err)
+	}
+
+	cleanup = append(cleanup, func() {
+		_ = tracer.Shutdown(context.Background())
+	})
+
+	metrics := metrics.NewMetrics()
+
+	db, err := database.NewPool(logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing database pool: %v", err)
+	}
+
+	cleanup = append(cleanup, db.Close)
+
+	if err = metrics.RegisterPool(db); err != nil {
+		return nil, fmt.Errorf("error registering database pool metrics: %v", err)
+	}
+
+	txManager := database.NewTxManager(db)
+
+	userRepository, err := userRepo.NewUserRepository(db, logger, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing user repository: %v", err)
 	}
 
-	tokenAdapter, err := tokenAdapter.NewTokenAdapter(logger, cfg)
+	addressRepository, err := addressRepo.NewAddressRepository(db, logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing address repository: %v", err)
+	}
+
+	identityRepository, err := identityRepo.NewIdentityRepository(db, logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing identity repository: %v", err)
+	}
+
+	passkeyRepository, err := passkeyRepo.NewPasskeyRepository(db, logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing passkey repository: %v", err)
+	}
+
+	apiKeyRepository, err := apiKeyRepo.NewAPIKeyRepository(db, logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing api key repository: %v", err)
+	}
+
+	roleRepository, err := roleRepo.NewRoleRepository(db, logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing role repository: %v", err)
+	}
+
+	oauthClientRepository, err := oauthClientRepo.NewOAuthClientRepository(db, logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing oauth client repository: %v", err)
+	}
+
+	redisClient, err := redisClient.NewClient(logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing redis client: %v", err)
+	}
+
+	cleanup = append(cleanup, func() {
+		_ = redisClient.Close()
+	})
+
+	redisClient.AddHook(metrics.RedisHook())
+
+	tokenAdapter, err := tokenAdapter.NewTokenAdapter(redisClient, logger, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing token repository: %v", err)
 	}
 
-	authService, err := authService.NewAuthService(userRepository, tokenAdapter, logger, cfg)
+	otpAdapter, err := otpAdapter.NewOTPAdapter(redisClient, logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing otp adapter: %v", err)
+	}
+
+	oauthStateAdapter, err := oauthStateAdapter.NewOAuthStateAdapter(redisClient, logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing oauth state adapter: %v", err)
+	}
+
+	magicLinkAdapter, err := magicLinkAdapter.NewMagicLinkAdapter(redisClient, logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing magic link adapter: %v", err)
+	}
+
+	passkeyChallengeAdapter, err := passkeyChallengeAdapter.NewPasskeyChallengeAdapter(redisClient, logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing passkey challenge adapter: %v", err)
+	}
+
+	authCodeAdapter, err := authCodeAdapter.NewAuthCodeAdapter(redisClient, logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing auth code adapter: %v", err)
+	}
+
+	identityProviders, err := oauthAdapter.NewProviders(logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing identity providers: %v", err)
+	}
+
+	passkeys, err := webauthnAdapter.NewPasskeys(logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing passkeys: %v", err)
+	}
+
+	smsSender, err := smsAdapter.NewSender(logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing sms sender: %v", err)
+	}
+
+	emailSender, err := emailAdapter.NewSender(logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing email sender: %v", err)
+	}
+
+	blobStorage, err := storageAdapter.NewStorage(logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing blob storage: %v", err)
+	}
+
+	authService, err := authService.NewAuthService(userRepository, identityRepository, passkeyRepository, apiKeyRepository, txManager, tokenAdapter, oauthStateAdapter, magicLinkAdapter, passkeyChallengeAdapter, identityProviders, passkeys, emailSender, metrics, logger, store)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing auth service: %v", err)
 	}
 
-	profileService, err := profileService.NewProfileService(userRepository, tokenAdapter, logger, cfg)
+	profileService, err := profileService.NewProfileService(userRepository, addressRepository, passkeyRepository, apiKeyRepository, txManager, tokenAdapter, otpAdapter, passkeyChallengeAdapter, passkeys, smsSender, blobStorage, logger, store)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing profile service: %v", err)
 	}
 
+	oauthService, err := oauthService.NewOAuthService(oauthClientRepository, userRepository, tokenAdapter, authCodeAdapter, metrics, logger, store)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing oauth service: %v", err)
+	}
+
+	adminService, err := adminService.NewAdminService(userRepository, roleRepository, tokenAdapter, logger, store)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing admin service: %v", err)
+	}
+
 	authHandler, err := authHandler.NewAuthHandler(authService, logger)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing auth handler: %v", err)
@@ -56,18 +244,66 @@ func NewApplication(logger logger.Logger, cfg *configs.Config) (domain.Applicati
 		return nil, fmt.Errorf("error initializing profile handler: %v", err)
 	}
 
-	server, err := server.NewServer(authHandler, profileHandler, logger, cfg)
+	oauthHandler, err := oauthHandler.NewOAuthHandler(oauthService, logger)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing oauth handler: %v", err)
+	}
+
+	adminHandler, err := adminHandler.NewAdminHandler(adminService, logger)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing admin handler: %v", err)
+	}
+
+	monitor := health.NewMonitor([]domain.HealthChecker{
+		health.NewPostgresChecker(db),
+		health.NewRedisChecker(redisClient),
+	}, logger, cfg)
+
+	limiter := ratelimit.NewLimiter(logger, store)
+
+	server, err := server.NewServer(authHandler, profileHandler, oauthHandler, adminHandler, monitor, []grpc.UnaryServerInterceptor{
+		logging.UnaryServerInterceptor(logger),
+		metrics.UnaryServerInterceptor(),
+		limiter.UnaryServerInterceptor(authDesc.AuthV1_ServiceDesc.ServiceName, oauthDesc.OAuthV1_ServiceDesc.ServiceName),
+		authn.UnaryServerInterceptor(authService, cfg),
+		scope.UnaryServerInterceptor(),
+		rbac.UnaryServerInterceptor(),
+		impersonation.UnaryServerInterceptor(logger),
+	}, []grpc.StreamServerInterceptor{
+		logging.StreamServerInterceptor(logger),
+		metrics.StreamServerInterceptor(),
+		authn.StreamServerInterceptor(authService, cfg),
+		scope.StreamServerInterceptor(),
+		rbac.StreamServerInterceptor(),
+		impersonation.StreamServerInterceptor(logger),
+	}, logger, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing server: %v", err)
 	}
 
+	adminServer, err := admin.NewServer(monitor, metrics, logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing admin server: %v", err)
+	}
+
 	logger.Info(loggerTag, "Application initialized successfully")
 
-	return &Application{
+	app := &Application{
 		server,
+		adminServer,
+		monitor,
+		tracer,
+		db,
+		redisClient,
+		store,
+		func() {},
 		logger,
 		cfg,
-	}, nil
+	}
+
+	store.Subscribe(app.applyLogLevels)
+
+	return app, nil
 }
 
 func (a *Application) Run() error {
@@ -75,5 +311,61 @@ func (a *Application) Run() error {
 
 	a.logger.Info(loggerTag, "Running the application")
 
-	return a.server.Run()
+	a.monitor.Start()
+
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	a.stopWatch = stopWatch
+	if err := a.store.Watch(watchCtx, a.reloaded); err != nil {
+		a.logger.Error(loggerTag, fmt.Sprintf("Config file is not watched: %v", err))
+	}
+
+	errs := make(chan error, 2)
+	go func() {
+		errs <- a.adminServer.Run()
+	}()
+	go func() {
+		errs <- a.server.Run()
+	}()
+
+	return <-errs
+}
+
+// Shutdown turns readiness off, drains the gRPC server so in-flight RPCs can
+// still use the database and redis, and only then releases the connections.
+// Spans are flushed last so the drained RPCs are exported too.
+func (a *Application) Shutdown(ctx context.Context) error {
+	loggerTag := "application.shutdown"
+
+	a.logger.Info(loggerTag, "Shutting down the application")
+
+	var errs []error
+
+	a.stopWatch()
+	a.monitor.Shutdown()
+
+	if err := a.server.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("error shutting down server: %v", err))
+	}
+
+	if err := a.adminServer.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("error shutting down admin server: %v", err))
+	}
+
+	a.db.Close()
+
+	if err := a.redisClient.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("error closing redis client: %v", err))
+	}
+
+	if err := a.tracer.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("error flushing traces: %v", err))
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	a.logger.Info(loggerTag, "Application stopped")
+
+	return nil
 }
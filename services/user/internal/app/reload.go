@@ -0,0 +1,58 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+)
+
+func (a *Application) Reload() {
+	a.reloaded(a.store.Reload())
+}
+
+// reloaded reports the outcome of a reload. A rejected configuration leaves
+// the running one untouched.
+func (a *Application) reloaded(ignored []string, err error) {
+	loggerTag := "application.reload"
+
+	if err != nil {
+		a.logger.Error(loggerTag, fmt.Sprintf("Config reload rejected: %v", err))
+
+		return
+	}
+
+	if len(ignored) > 0 {
+		a.logger.Warn(loggerTag, "Changed settings need a restart", logger.Field{
+			Key:   "keys",
+			Value: strings.Join(ignored, ","),
+		})
+	}
+
+	a.logger.Info(loggerTag, "Config reloaded")
+}
+
+// applyLogLevels only touches the levels when the configured ones changed,
+// so levels set through the admin endpoint survive unrelated reloads.
+func (a *Application) applyLogLevels(previous, current *configs.Config) {
+	loggerTag := "application.applyLogLevels"
+
+	if previous.LogLevel != current.LogLevel {
+		level, err := logger.ParseLevel(current.LogLevel)
+		if err != nil {
+			a.logger.Error(loggerTag, fmt.Sprintf("Invalid log level: %v", err))
+		} else {
+			a.logger.Levels().SetLevel(level)
+		}
+	}
+
+	if previous.LogTagLevels != current.LogTagLevels {
+		tagLevels, err := logger.ParseTagLevels(current.LogTagLevels)
+		if err != nil {
+			a.logger.Error(loggerTag, fmt.Sprintf("Invalid log tag levels: %v", err))
+		} else {
+			a.logger.Levels().SetTagLevels(tagLevels)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package domain
+
+import "errors"
+
+var (
+	ErrUserExists   = errors.New("user.exists")
+	ErrUserBlocked  = errors.New("user.blocked")
+	ErrUserNotFound = errors.New("user.not_found")
+
+	ErrProviderUnknown   = errors.New("oauth.provider_unknown")
+	ErrOAuthStateInvalid = errors.New("oauth.state_invalid")
+	ErrIdentityExists    = errors.New("identity.exists")
+	// ErrIdentityRejected is returned when an identity provider does not
+	// accept the authorization code or its proof of the user fails to verify.
+	ErrIdentityRejected = errors.New("identity.rejected")
+	ErrEmailNotVerified = errors.New("email.not_verified")
+
+	// ErrMagicLinkInvalid is returned for a sign-in link that is unknown,
+	// expired, used already or opened on another device.
+	ErrMagicLinkInvalid = errors.New("magic_link.invalid")
+
+	// ErrPasskeyInvalid is returned when a WebAuthn ceremony is unknown or
+	// expired, or the authenticator's response fails to verify.
+	ErrPasskeyInvalid  = errors.New("passkey.invalid")
+	ErrPasskeyExists   = errors.New("passkey.exists")
+	ErrPasskeyNotFound = errors.New("passkey.not_found")
+
+	// ErrAPIKeyInvalid is returned for an API key that is malformed, unknown,
+	// revoked or expired.
+	ErrAPIKeyInvalid       = errors.New("api_key.invalid")
+	ErrAPIKeyNotFound      = errors.New("api_key.not_found")
+	ErrAPIKeyExpiryInvalid = errors.New("api_key.expiry_invalid")
+
+	ErrRoleNotFound    = errors.New("role.not_found")
+	ErrRoleNotAssigned = errors.New("role.not_assigned")
+	// ErrPermissionDenied is returned when the caller's roles do not grant
+	// the permission a method requires.
+	ErrPermissionDenied = errors.New("permission.denied")
+	// ErrImpersonationRefused is returned by the methods a token issued by
+	// impersonation may not call, such as deleting the account.
+	ErrImpersonationRefused = errors.New("impersonation.refused")
+
+	ErrTokenInvalid = errors.New("token.invalid")
+	// ErrClientInvalid is returned when an OAuth client is unknown or its
+	// secret does not match.
+	ErrClientInvalid        = errors.New("oauth.client_invalid")
+	ErrClientUnauthorized   = errors.New("oauth.client_unauthorized")
+	ErrGrantInvalid         = errors.New("oauth.grant_invalid")
+	ErrGrantTypeUnsupported = errors.New("oauth.grant_type_unsupported")
+	ErrRedirectURIInvalid   = errors.New("oauth.redirect_uri_invalid")
+	ErrScopeInvalid         = errors.New("oauth.scope_invalid")
+	// ErrScopeInsufficient is returned when a token issued to an OAuth
+	// client, or an API key, is used for something its scopes do not cover.
+	ErrScopeInsufficient = errors.New("scope.insufficient")
+
+	ErrPhoneInvalid        = errors.New("phone.invalid")
+	ErrPhoneExists         = errors.New("phone.exists")
+	ErrOTPNotFound         = errors.New("otp.not_found")
+	ErrOTPInvalid          = errors.New("otp.invalid")
+	ErrOTPAttemptsExceeded = errors.New("otp.attempts_exceeded")
+	ErrOTPResendTooSoon    = errors.New("otp.resend_too_soon")
+
+	ErrAvatarTooLarge    = errors.New("avatar.too_large")
+	ErrAvatarContentType = errors.New("avatar.content_type_unsupported")
+	ErrAvatarInvalid     = errors.New("avatar.invalid")
+
+	ErrAccessDenied    = errors.New("access.denied")
+	ErrAddressNotFound = errors.New("address.not_found")
+
+	// ErrAddressDefaultConflict is returned when a concurrent request made
+	// another address the default first; retrying resolves it.
+	ErrAddressDefaultConflict = errors.New("address.default_conflict")
+)
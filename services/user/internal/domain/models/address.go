@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Address struct {
+	ID              uuid.UUID `json:"id"`
+	UserID          uuid.UUID `json:"user_id"`
+	Label           string    `json:"label"`
+	Recipient       string    `json:"recipient"`
+	Line1           string    `json:"line1"`
+	Line2           string    `json:"line2"`
+	City            string    `json:"city"`
+	Region          string    `json:"region"`
+	PostalCode      string    `json:"postal_code"`
+	CountryCode     string    `json:"country_code"`
+	Phone           string    `json:"phone"`
+	DefaultShipping bool      `json:"default_shipping"`
+	DefaultBilling  bool      `json:"default_billing"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKey lets a script or an integration call the API without signing in.
+// It belongs either to a user, whose profile it acts on, or to a service.
+type APIKey struct {
+	ID uuid.UUID `json:"id"`
+	// UserID is nil for keys of a service.
+	UserID  *uuid.UUID `json:"user_id"`
+	Service string     `json:"service"`
+	Name    string     `json:"name"`
+	// Prefix is the public part of the key, which finds it and tells keys
+	// apart in lists.
+	Prefix     string     `json:"prefix"`
+	SecretHash string     `json:"-"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	Revoked    bool       `json:"revoked"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// Usable reports whether the key can still authenticate at now.
+func (k *APIKey) Usable(now time.Time) bool {
+	return !k.Revoked && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// Subject is what requests made with the key act as: the owning user, or
+// the service prefixed with "service:" so it never reads as a user ID.
+func (k *APIKey) Subject() string {
+	if k.UserID != nil {
+		return k.UserID.String()
+	}
+
+	return "service:" + k.Service
+}
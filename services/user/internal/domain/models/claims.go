@@ -0,0 +1,90 @@
+package models
+
+import (
+	"context"
+	"slices"
+)
+
+// Claims are who a request was authenticated as, whether it carried an
+// access token or an API key.
+type Claims struct {
+	Subject     string
+	Roles       []string
+	Permissions []string
+	// ClientID is set for tokens issued to an OAuth client.
+	ClientID string
+	// Scopes is nil for first-party tokens, which are not limited to any.
+	Scopes []string
+	// APIKeyID is set when the request carried an API key.
+	APIKeyID string
+	// ActorID is set for tokens issued by impersonation: it is the admin
+	// acting as Subject.
+	ActorID string
+}
+
+// Scoped reports whether the request is limited to Scopes. API keys always
+// are, even when they were granted none.
+func (c *Claims) Scoped() bool {
+	return c.Scopes != nil || c.APIKeyID != ""
+}
+
+func (c *Claims) Impersonated() bool {
+	return c.ActorID != ""
+}
+
+func (c *Claims) HasPermission(permission string) bool {
+	return slices.Contains(c.Permissions, permission)
+}
+
+// UserClaims are the claims of the tokens issued to user. They carry the
+// permissions of its roles at the time, so a change of roles reaches access
+// tokens when they are next refreshed.
+func UserClaims(user *User) map[string]any {
+	return map[string]any{
+		"sub":         user.ID.String(),
+		"roles":       user.Roles,
+		"permissions": user.Permissions,
+	}
+}
+
+// ScopedClaims are the claims of the tokens issued to an OAuth client on
+// behalf of user. The client may only do what its scopes allow, so the
+// tokens carry none of the permissions of the user's roles.
+func ScopedClaims(user *User) map[string]any {
+	return map[string]any{
+		"sub":   user.ID.String(),
+		"roles": user.Roles,
+	}
+}
+
+// ImpersonationClaims are the claims of a token that lets actorID act as
+// user. The actor is carried in the act claim of RFC 8693.
+func ImpersonationClaims(user *User, actorID string) map[string]any {
+	claims := UserClaims(user)
+	claims["act"] = map[string]any{
+		"sub": actorID,
+	}
+
+	return claims
+}
+
+// ActorID returns the actor of an impersonation token, read from its
+// claims, or an empty string for any other token.
+func ActorID(claims map[string]any) string {
+	act, _ := claims["act"].(map[string]any)
+	actorID, _ := act["sub"].(string)
+
+	return actorID
+}
+
+type claimsKey struct{}
+
+func ContextWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+
+	return claims, ok
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+const (
+	GrantAuthorizationCode = "authorization_code"
+	GrantRefreshToken      = "refresh_token"
+	GrantClientCredentials = "client_credentials"
+)
+
+const (
+	ScopeProfileRead  = "profile:read"
+	ScopeProfileWrite = "profile:write"
+)
+
+// Scopes are all the scopes a client can be registered with.
+var Scopes = []string{ScopeProfileRead, ScopeProfileWrite}
+
+// GrantTypes are all the grant types a client can be registered with.
+var GrantTypes = []string{GrantAuthorizationCode, GrantRefreshToken, GrantClientCredentials}
+
+// OAuthClient is an application registered to get tokens from the OAuth
+// endpoints. Scopes are the most it may be granted.
+type OAuthClient struct {
+	ID string `json:"id"`
+	// SecretHash is empty for public clients.
+	SecretHash   string    `json:"secret_hash"`
+	Name         string    `json:"name"`
+	RedirectURIs []string  `json:"redirect_uris"`
+	GrantTypes   []string  `json:"grant_types"`
+	Scopes       []string  `json:"scopes"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (c *OAuthClient) Public() bool {
+	return c.SecretHash == ""
+}
+
+func (c *OAuthClient) AllowsGrant(grantType string) bool {
+	return slices.Contains(c.GrantTypes, grantType)
+}
+
+// AllowsRedirectURI only accepts an exact match of a registered URI.
+func (c *OAuthClient) AllowsRedirectURI(redirectURI string) bool {
+	return slices.Contains(c.RedirectURIs, redirectURI)
+}
+
+// OAuthToken is what the token endpoint returns. RefreshToken is empty for
+// grants that cannot be refreshed.
+type OAuthToken struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    time.Duration
+	Scope        string
+}
+
+// TokenIntrospection describes a token to the client that asked about it.
+// Only Active is set for tokens that are invalid, expired or revoked.
+type TokenIntrospection struct {
+	Active    bool
+	TokenType string
+	ClientID  string
+	Subject   string
+	Scope     string
+	ExpiresAt time.Time
+}
+
+// SessionKey is what the refresh token of a session is stored under: the
+// user ID for first-party logins, and the client and user ID for grants to
+// an OAuth client, so that every client has a session of its own.
+func SessionKey(clientID, userID string) string {
+	if clientID == "" {
+		return userID
+	}
+
+	return clientID + ":" + userID
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Passkey is a WebAuthn credential a user signs in with.
+type Passkey struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	// Name is chosen by the user to tell their passkeys apart.
+	Name            string   `json:"name"`
+	CredentialID    []byte   `json:"-"`
+	PublicKey       []byte   `json:"-"`
+	AttestationType string   `json:"-"`
+	Transports      []string `json:"transports"`
+	// AAGUID identifies the authenticator model; it is all zeros for
+	// authenticators that do not tell.
+	AAGUID    uuid.UUID `json:"aaguid"`
+	SignCount uint32    `json:"-"`
+	// BackupEligible is set for passkeys that sync between devices, and
+	// BackupState once they have been synced.
+	BackupEligible bool       `json:"backup_eligible"`
+	BackupState    bool       `json:"backup_state"`
+	CreatedAt      time.Time  `json:"created_at"`
+	LastUsedAt     *time.Time `json:"last_used_at"`
+}
@@ -0,0 +1,29 @@
+package models
+
+// The roles the code refers to. Every role and what it grants are seeded
+// by the migrations.
+const (
+	// RoleCustomer is given to every new user.
+	RoleCustomer = "customer"
+	RoleAdmin    = "admin"
+)
+
+// The permissions this service checks. First-party tokens carry every
+// permission of the user, so the other services of the store check theirs,
+// such as catalog:write, the same way. Tokens of OAuth clients and API keys
+// carry none: they are limited to their scopes.
+const (
+	// PermissionUsersRead lets support read the profile of any user.
+	PermissionUsersRead   = "users:read"
+	PermissionRolesRead   = "roles:read"
+	PermissionRolesAssign = "roles:assign"
+	// PermissionUsersImpersonate lets support act as a customer to see the
+	// store as they do.
+	PermissionUsersImpersonate = "users:impersonate"
+)
+
+type Role struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
@@ -6,20 +6,29 @@ import (
 	"github.com/google/uuid"
 )
 
-type Role string
-
-const (
-	UserRole  Role = "USER"
-	AdminRole Role = "ADMIN"
-)
-
 type User struct {
 	ID        uuid.UUID `json:"id"`
 	Email     string    `json:"email"`
 	Password  string    `json:"password"`
 	FirstName string    `json:"first_name"`
 	LastName  string    `json:"last_name"`
-	Role      Role      `json:"role"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	// Roles are the names of the user's roles, Permissions everything they
+	// grant together.
+	Roles       []string   `json:"roles"`
+	Permissions []string   `json:"permissions"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	BlockedAt   *time.Time `json:"blocked_at"`
+	// Phone is in E.164 format, or empty.
+	Phone         string `json:"phone"`
+	PhoneVerified bool   `json:"phone_verified"`
+	// AvatarKey is the storage prefix of the avatar files, AvatarURLs maps
+	// the edge length of every thumbnail, e.g. "128", to its URL. Both are
+	// empty until an avatar is uploaded.
+	AvatarKey  string            `json:"avatar_key"`
+	AvatarURLs map[string]string `json:"avatar_urls"`
+}
+
+func (u *User) Blocked() bool {
+	return u.BlockedAt != nil
 }
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// AuthCode is what an authorization code stands for until the client
+// exchanges it at the token endpoint.
+type AuthCode struct {
+	ClientID      string
+	UserID        string
+	RedirectURI   string
+	Scope         string
+	CodeChallenge string
+}
+
+type AuthCodeAdapter interface {
+	Set(ctx context.Context, code string, authCode *AuthCode, expiresIn time.Duration) error
+	// Take returns the authorization code and deletes it, so a code is used
+	// only once. It returns redis.Nil when the code is unknown or expired.
+	Take(ctx context.Context, code string) (*AuthCode, error)
+}
@@ -2,3 +2,8 @@ package domain
 
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate mockgen -source=token.go -destination=mocks/token_adapter_mock.go -package=mocks
+//go:generate mockgen -source=otp.go -destination=mocks/otp_adapter_mock.go -package=mocks
+//go:generate mockgen -source=oauth_state.go -destination=mocks/oauth_state_adapter_mock.go -package=mocks
+//go:generate mockgen -source=auth_code.go -destination=mocks/auth_code_adapter_mock.go -package=mocks
+//go:generate mockgen -source=magic_link.go -destination=mocks/magic_link_adapter_mock.go -package=mocks
+//go:generate mockgen -source=passkey_challenge.go -destination=mocks/passkey_challenge_adapter_mock.go -package=mocks
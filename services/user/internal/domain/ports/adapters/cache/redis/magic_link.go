@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// MagicLink is what a sign-in link stands for until it is opened. NonceHash
+// binds it to the device that asked for it.
+type MagicLink struct {
+	UserID    string
+	NonceHash string
+}
+
+type MagicLinkAdapter interface {
+	Set(ctx context.Context, token string, magicLink *MagicLink, expiresIn time.Duration) error
+	// Take returns the link and deletes it, so a link is used only once. It
+	// returns redis.Nil when the token is unknown or expired.
+	Take(ctx context.Context, token string) (*MagicLink, error)
+}
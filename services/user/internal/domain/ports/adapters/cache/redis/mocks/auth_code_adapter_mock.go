@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: auth_code.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuthCodeAdapter is a mock of AuthCodeAdapter interface.
+type MockAuthCodeAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthCodeAdapterMockRecorder
+}
+
+// MockAuthCodeAdapterMockRecorder is the mock recorder for MockAuthCodeAdapter.
+type MockAuthCodeAdapterMockRecorder struct {
+	mock *MockAuthCodeAdapter
+}
+
+// NewMockAuthCodeAdapter creates a new mock instance.
+func NewMockAuthCodeAdapter(ctrl *gomock.Controller) *MockAuthCodeAdapter {
+	mock := &MockAuthCodeAdapter{ctrl: ctrl}
+	mock.recorder = &MockAuthCodeAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthCodeAdapter) EXPECT() *MockAuthCodeAdapterMockRecorder {
+	return m.recorder
+}
+
+// Set mocks base method.
+func (m *MockAuthCodeAdapter) Set(ctx context.Context, code string, authCode *domain.AuthCode, expiresIn time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, code, authCode, expiresIn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockAuthCodeAdapterMockRecorder) Set(ctx, code, authCode, expiresIn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockAuthCodeAdapter)(nil).Set), ctx, code, authCode, expiresIn)
+}
+
+// Take mocks base method.
+func (m *MockAuthCodeAdapter) Take(ctx context.Context, code string) (*domain.AuthCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, code)
+	ret0, _ := ret[0].(*domain.AuthCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockAuthCodeAdapterMockRecorder) Take(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockAuthCodeAdapter)(nil).Take), ctx, code)
+}
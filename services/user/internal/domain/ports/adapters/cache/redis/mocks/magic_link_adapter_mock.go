@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: magic_link.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMagicLinkAdapter is a mock of MagicLinkAdapter interface.
+type MockMagicLinkAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockMagicLinkAdapterMockRecorder
+}
+
+// MockMagicLinkAdapterMockRecorder is the mock recorder for MockMagicLinkAdapter.
+type MockMagicLinkAdapterMockRecorder struct {
+	mock *MockMagicLinkAdapter
+}
+
+// NewMockMagicLinkAdapter creates a new mock instance.
+func NewMockMagicLinkAdapter(ctrl *gomock.Controller) *MockMagicLinkAdapter {
+	mock := &MockMagicLinkAdapter{ctrl: ctrl}
+	mock.recorder = &MockMagicLinkAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMagicLinkAdapter) EXPECT() *MockMagicLinkAdapterMockRecorder {
+	return m.recorder
+}
+
+// Set mocks base method.
+func (m *MockMagicLinkAdapter) Set(ctx context.Context, token string, magicLink *domain.MagicLink, expiresIn time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, token, magicLink, expiresIn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockMagicLinkAdapterMockRecorder) Set(ctx, token, magicLink, expiresIn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockMagicLinkAdapter)(nil).Set), ctx, token, magicLink, expiresIn)
+}
+
+// Take mocks base method.
+func (m *MockMagicLinkAdapter) Take(ctx context.Context, token string) (*domain.MagicLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, token)
+	ret0, _ := ret[0].(*domain.MagicLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockMagicLinkAdapterMockRecorder) Take(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockMagicLinkAdapter)(nil).Take), ctx, token)
+}
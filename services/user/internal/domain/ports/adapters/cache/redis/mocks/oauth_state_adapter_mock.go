@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oauth_state.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOAuthStateAdapter is a mock of OAuthStateAdapter interface.
+type MockOAuthStateAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockOAuthStateAdapterMockRecorder
+}
+
+// MockOAuthStateAdapterMockRecorder is the mock recorder for MockOAuthStateAdapter.
+type MockOAuthStateAdapterMockRecorder struct {
+	mock *MockOAuthStateAdapter
+}
+
+// NewMockOAuthStateAdapter creates a new mock instance.
+func NewMockOAuthStateAdapter(ctrl *gomock.Controller) *MockOAuthStateAdapter {
+	mock := &MockOAuthStateAdapter{ctrl: ctrl}
+	mock.recorder = &MockOAuthStateAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOAuthStateAdapter) EXPECT() *MockOAuthStateAdapterMockRecorder {
+	return m.recorder
+}
+
+// Set mocks base method.
+func (m *MockOAuthStateAdapter) Set(ctx context.Context, state string, oauthState *domain.OAuthState, expiresIn time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, state, oauthState, expiresIn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockOAuthStateAdapterMockRecorder) Set(ctx, state, oauthState, expiresIn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockOAuthStateAdapter)(nil).Set), ctx, state, oauthState, expiresIn)
+}
+
+// Take mocks base method.
+func (m *MockOAuthStateAdapter) Take(ctx context.Context, state string) (*domain.OAuthState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, state)
+	ret0, _ := ret[0].(*domain.OAuthState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockOAuthStateAdapterMockRecorder) Take(ctx, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockOAuthStateAdapter)(nil).Take), ctx, state)
+}
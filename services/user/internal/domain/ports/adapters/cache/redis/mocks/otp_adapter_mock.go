@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: otp.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOTPAdapter is a mock of OTPAdapter interface.
+type MockOTPAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockOTPAdapterMockRecorder
+}
+
+// MockOTPAdapterMockRecorder is the mock recorder for MockOTPAdapter.
+type MockOTPAdapterMockRecorder struct {
+	mock *MockOTPAdapter
+}
+
+// NewMockOTPAdapter creates a new mock instance.
+func NewMockOTPAdapter(ctrl *gomock.Controller) *MockOTPAdapter {
+	mock := &MockOTPAdapter{ctrl: ctrl}
+	mock.recorder = &MockOTPAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOTPAdapter) EXPECT() *MockOTPAdapterMockRecorder {
+	return m.recorder
+}
+
+// Del mocks base method.
+func (m *MockOTPAdapter) Del(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Del", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Del indicates an expected call of Del.
+func (mr *MockOTPAdapterMockRecorder) Del(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockOTPAdapter)(nil).Del), ctx, userID)
+}
+
+// Get mocks base method.
+func (m *MockOTPAdapter) Get(ctx context.Context, userID string) (*domain.PhoneOTP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID)
+	ret0, _ := ret[0].(*domain.PhoneOTP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockOTPAdapterMockRecorder) Get(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOTPAdapter)(nil).Get), ctx, userID)
+}
+
+// IncrAttempts mocks base method.
+func (m *MockOTPAdapter) IncrAttempts(ctx context.Context, userID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrAttempts", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrAttempts indicates an expected call of IncrAttempts.
+func (mr *MockOTPAdapterMockRecorder) IncrAttempts(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrAttempts", reflect.TypeOf((*MockOTPAdapter)(nil).IncrAttempts), ctx, userID)
+}
+
+// Set mocks base method.
+func (m *MockOTPAdapter) Set(ctx context.Context, userID string, otp *domain.PhoneOTP, expiresIn time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, userID, otp, expiresIn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockOTPAdapterMockRecorder) Set(ctx, userID, otp, expiresIn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockOTPAdapter)(nil).Set), ctx, userID, otp, expiresIn)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: passkey_challenge.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPasskeyChallengeAdapter is a mock of PasskeyChallengeAdapter interface.
+type MockPasskeyChallengeAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasskeyChallengeAdapterMockRecorder
+}
+
+// MockPasskeyChallengeAdapterMockRecorder is the mock recorder for MockPasskeyChallengeAdapter.
+type MockPasskeyChallengeAdapterMockRecorder struct {
+	mock *MockPasskeyChallengeAdapter
+}
+
+// NewMockPasskeyChallengeAdapter creates a new mock instance.
+func NewMockPasskeyChallengeAdapter(ctrl *gomock.Controller) *MockPasskeyChallengeAdapter {
+	mock := &MockPasskeyChallengeAdapter{ctrl: ctrl}
+	mock.recorder = &MockPasskeyChallengeAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasskeyChallengeAdapter) EXPECT() *MockPasskeyChallengeAdapterMockRecorder {
+	return m.recorder
+}
+
+// Set mocks base method.
+func (m *MockPasskeyChallengeAdapter) Set(ctx context.Context, ceremonyID string, challenge *domain.PasskeyChallenge, expiresIn time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, ceremonyID, challenge, expiresIn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockPasskeyChallengeAdapterMockRecorder) Set(ctx, ceremonyID, challenge, expiresIn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockPasskeyChallengeAdapter)(nil).Set), ctx, ceremonyID, challenge, expiresIn)
+}
+
+// Take mocks base method.
+func (m *MockPasskeyChallengeAdapter) Take(ctx context.Context, ceremonyID string) (*domain.PasskeyChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, ceremonyID)
+	ret0, _ := ret[0].(*domain.PasskeyChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockPasskeyChallengeAdapterMockRecorder) Take(ctx, ceremonyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockPasskeyChallengeAdapter)(nil).Take), ctx, ceremonyID)
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// OAuthState is what is remembered between sending the user to an identity
+// provider and the provider redirecting back with the same state. Only the
+// hash of the browser nonce is kept, as for magic links. LinkUserID is set
+// when a signed-in user links the identity to their account.
+type OAuthState struct {
+	Provider         string
+	Nonce            string
+	CodeVerifier     string
+	BrowserNonceHash string
+	LinkUserID       string
+}
+
+type OAuthStateAdapter interface {
+	Set(ctx context.Context, state string, oauthState *OAuthState, expiresIn time.Duration) error
+	// Take returns the state and deletes it, so a state is used only once.
+	// It returns redis.Nil when the state is unknown or expired.
+	Take(ctx context.Context, state string) (*OAuthState, error)
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// PhoneOTP is a pending phone verification. Only the hash of the code is
+// kept, so reading redis does not reveal it.
+type PhoneOTP struct {
+	Phone    string
+	CodeHash string
+	Attempts int
+	SentAt   time.Time
+}
+
+type OTPAdapter interface {
+	Set(ctx context.Context, userID string, otp *PhoneOTP, expiresIn time.Duration) error
+	// Get returns redis.Nil when no verification is pending.
+	Get(ctx context.Context, userID string) (*PhoneOTP, error)
+	// IncrAttempts counts an attempt at the code and returns the attempts so
+	// far. It returns redis.Nil when no verification is pending.
+	IncrAttempts(ctx context.Context, userID string) (int, error)
+	Del(ctx context.Context, userID string) error
+}
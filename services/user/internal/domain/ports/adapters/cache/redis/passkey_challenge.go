@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// PasskeyChallenge is a WebAuthn ceremony waiting for the authenticator to
+// answer. UserID is empty for a login, where the user is not known until the
+// answer comes. Session is the state the ceremony is finished with.
+type PasskeyChallenge struct {
+	UserID  string
+	Session []byte
+}
+
+type PasskeyChallengeAdapter interface {
+	Set(ctx context.Context, ceremonyID string, challenge *PasskeyChallenge, expiresIn time.Duration) error
+	// Take returns the challenge and deletes it, so a challenge is answered
+	// only once. It returns redis.Nil when the ceremony is unknown or
+	// expired.
+	Take(ctx context.Context, ceremonyID string) (*PasskeyChallenge, error)
+}
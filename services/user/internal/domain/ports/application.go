@@ -1,5 +1,11 @@
 package domain
 
+import "context"
+
 type Application interface {
 	Run() error
+	// Reload re-reads the configuration and applies the hot-reloadable
+	// values.
+	Reload()
+	Shutdown(ctx context.Context) error
 }
@@ -0,0 +1,8 @@
+package domain
+
+import "context"
+
+type EmailSender interface {
+	// Send delivers a plain-text email to the address to.
+	Send(ctx context.Context, to, subject, body string) error
+}
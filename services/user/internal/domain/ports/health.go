@@ -0,0 +1,8 @@
+package domain
+
+import "context"
+
+type HealthChecker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
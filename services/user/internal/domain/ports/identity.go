@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+)
+
+// IdentityProvider signs users in at an external service through the OAuth2
+// authorization code flow with PKCE.
+type IdentityProvider interface {
+	Name() string
+	// AuthCodeURL returns the provider page the user has to be sent to.
+	// codeVerifier is the PKCE secret; only its S256 challenge is sent.
+	AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error)
+	// Exchange redeems the code the provider redirected back with, and
+	// returns the identity once its proof has been verified.
+	Exchange(ctx context.Context, code, nonce, codeVerifier string) (*models.ExternalIdentity, error)
+}
@@ -0,0 +1,8 @@
+package domain
+
+type BusinessMetrics interface {
+	UserRegistered()
+	LoginFailed(reason string)
+	TokenRefreshed()
+	OAuthTokenIssued(grantType string)
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+
+// PasskeyCeremony starts a WebAuthn registration or login. Options is the
+// JSON to pass to navigator.credentials.create() or get(); Session stays on
+// the server until the authenticator answers.
+type PasskeyCeremony struct {
+	Options []byte
+	Session []byte
+}
+
+// Passkeys runs the WebAuthn ceremonies. A response is the JSON encoding of
+// the PublicKeyCredential the browser resolved with. A response that fails
+// to verify is reported as ErrPasskeyInvalid.
+type Passkeys interface {
+	// BeginRegistration asks for a new passkey of user. Authenticators that
+	// already hold one of existing are turned away.
+	BeginRegistration(user *models.User, existing []*models.Passkey) (*PasskeyCeremony, error)
+	// FinishRegistration returns the verified passkey, still without an ID
+	// and a name.
+	FinishRegistration(user *models.User, session, response []byte) (*models.Passkey, error)
+	// BeginLogin asks for any passkey registered here, so the user does not
+	// have to say who they are first.
+	BeginLogin() (*PasskeyCeremony, error)
+	// FinishLogin looks up the passkey that answered with find, and returns
+	// it with the sign count and backup state of this use. Errors of find are
+	// returned as they are.
+	FinishLogin(session, response []byte, find func(credentialID []byte) (*models.Passkey, error)) (*models.Passkey, error)
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+)
+
+// AddressRepository scopes every lookup by user, so an address ID alone
+// never reaches another user's address.
+type AddressRepository interface {
+	Create(ctx context.Context, address *models.Address) (*models.Address, error)
+	FindByUserID(ctx context.Context, userID string) ([]*models.Address, error)
+	FindByID(ctx context.Context, userID, addressID string) (*models.Address, error)
+	Update(ctx context.Context, address *models.Address) (*models.Address, error)
+	Delete(ctx context.Context, userID, addressID string) error
+	// ClearDefaults unsets the chosen default flags on every address of the
+	// user, before another address takes them over.
+	ClearDefaults(ctx context.Context, userID string, shipping, billing bool) error
+}
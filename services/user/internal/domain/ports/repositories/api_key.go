@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+)
+
+// APIKeyRepository lists keys newest first, revoked and expired ones
+// included.
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *models.APIKey) (*models.APIKey, error)
+	// FindByPrefix returns pgx.ErrNoRows when no key has prefix.
+	FindByPrefix(ctx context.Context, prefix string) (*models.APIKey, error)
+	FindByUserID(ctx context.Context, userID string) ([]*models.APIKey, error)
+	FindByService(ctx context.Context, service string) ([]*models.APIKey, error)
+	// Revoke and RevokeForUser return pgx.ErrNoRows when there is no such
+	// key; revoking a revoked key again is not an error.
+	Revoke(ctx context.Context, keyID string) (*models.APIKey, error)
+	RevokeForUser(ctx context.Context, userID, keyID string) (*models.APIKey, error)
+	// Touch sets the last use of the key to now. It writes at most once a
+	// minute per key, so a busy key does not turn every request into a
+	// write.
+	Touch(ctx context.Context, keyID string) error
+}
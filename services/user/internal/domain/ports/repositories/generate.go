@@ -2,3 +2,10 @@ package domain
 
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate mockgen -source=user.go -destination=mocks/user_repository_mock.go -package=mocks
+//go:generate mockgen -source=address.go -destination=mocks/address_repository_mock.go -package=mocks
+//go:generate mockgen -source=identity.go -destination=mocks/identity_repository_mock.go -package=mocks
+//go:generate mockgen -source=oauth_client.go -destination=mocks/oauth_client_repository_mock.go -package=mocks
+//go:generate mockgen -source=passkey.go -destination=mocks/passkey_repository_mock.go -package=mocks
+//go:generate mockgen -source=api_key.go -destination=mocks/api_key_repository_mock.go -package=mocks
+//go:generate mockgen -source=role.go -destination=mocks/role_repository_mock.go -package=mocks
+//go:generate mockgen -source=transaction.go -destination=mocks/tx_manager_mock.go -package=mocks
@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+)
+
+type IdentityRepository interface {
+	Create(ctx context.Context, identity *models.UserIdentity) (*models.UserIdentity, error)
+	// FindByProviderSubject returns pgx.ErrNoRows when the account is not
+	// linked to any user.
+	FindByProviderSubject(ctx context.Context, provider, subject string) (*models.UserIdentity, error)
+}
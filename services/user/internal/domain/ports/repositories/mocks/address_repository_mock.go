@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: address.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAddressRepository is a mock of AddressRepository interface.
+type MockAddressRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddressRepositoryMockRecorder
+}
+
+// MockAddressRepositoryMockRecorder is the mock recorder for MockAddressRepository.
+type MockAddressRepositoryMockRecorder struct {
+	mock *MockAddressRepository
+}
+
+// NewMockAddressRepository creates a new mock instance.
+func NewMockAddressRepository(ctrl *gomock.Controller) *MockAddressRepository {
+	mock := &MockAddressRepository{ctrl: ctrl}
+	mock.recorder = &MockAddressRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddressRepository) EXPECT() *MockAddressRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClearDefaults mocks base method.
+func (m *MockAddressRepository) ClearDefaults(ctx context.Context, userID string, shipping, billing bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearDefaults", ctx, userID, shipping, billing)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearDefaults indicates an expected call of ClearDefaults.
+func (mr *MockAddressRepositoryMockRecorder) ClearDefaults(ctx, userID, shipping, billing interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearDefaults", reflect.TypeOf((*MockAddressRepository)(nil).ClearDefaults), ctx, userID, shipping, billing)
+}
+
+// Create mocks base method.
+func (m *MockAddressRepository) Create(ctx context.Context, address *models.Address) (*models.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, address)
+	ret0, _ := ret[0].(*models.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAddressRepositoryMockRecorder) Create(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAddressRepository)(nil).Create), ctx, address)
+}
+
+// Delete mocks base method.
+func (m *MockAddressRepository) Delete(ctx context.Context, userID, addressID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, addressID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAddressRepositoryMockRecorder) Delete(ctx, userID, addressID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAddressRepository)(nil).Delete), ctx, userID, addressID)
+}
+
+// FindByID mocks base method.
+func (m *MockAddressRepository) FindByID(ctx context.Context, userID, addressID string) (*models.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, userID, addressID)
+	ret0, _ := ret[0].(*models.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockAddressRepositoryMockRecorder) FindByID(ctx, userID, addressID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAddressRepository)(nil).FindByID), ctx, userID, addressID)
+}
+
+// FindByUserID mocks base method.
+func (m *MockAddressRepository) FindByUserID(ctx context.Context, userID string) ([]*models.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*models.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockAddressRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockAddressRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockAddressRepository) Update(ctx context.Context, address *models.Address) (*models.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, address)
+	ret0, _ := ret[0].(*models.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAddressRepositoryMockRecorder) Update(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAddressRepository)(nil).Update), ctx, address)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oauth_client.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOAuthClientRepository is a mock of OAuthClientRepository interface.
+type MockOAuthClientRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOAuthClientRepositoryMockRecorder
+}
+
+// MockOAuthClientRepositoryMockRecorder is the mock recorder for MockOAuthClientRepository.
+type MockOAuthClientRepositoryMockRecorder struct {
+	mock *MockOAuthClientRepository
+}
+
+// NewMockOAuthClientRepository creates a new mock instance.
+func NewMockOAuthClientRepository(ctrl *gomock.Controller) *MockOAuthClientRepository {
+	mock := &MockOAuthClientRepository{ctrl: ctrl}
+	mock.recorder = &MockOAuthClientRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOAuthClientRepository) EXPECT() *MockOAuthClientRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOAuthClientRepository) Create(ctx context.Context, client *models.OAuthClient) (*models.OAuthClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, client)
+	ret0, _ := ret[0].(*models.OAuthClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOAuthClientRepositoryMockRecorder) Create(ctx, client interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOAuthClientRepository)(nil).Create), ctx, client)
+}
+
+// FindByID mocks base method.
+func (m *MockOAuthClientRepository) FindByID(ctx context.Context, id string) (*models.OAuthClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*models.OAuthClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockOAuthClientRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOAuthClientRepository)(nil).FindByID), ctx, id)
+}
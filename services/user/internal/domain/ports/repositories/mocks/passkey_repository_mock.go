@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: passkey.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPasskeyRepository is a mock of PasskeyRepository interface.
+type MockPasskeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasskeyRepositoryMockRecorder
+}
+
+// MockPasskeyRepositoryMockRecorder is the mock recorder for MockPasskeyRepository.
+type MockPasskeyRepositoryMockRecorder struct {
+	mock *MockPasskeyRepository
+}
+
+// NewMockPasskeyRepository creates a new mock instance.
+func NewMockPasskeyRepository(ctrl *gomock.Controller) *MockPasskeyRepository {
+	mock := &MockPasskeyRepository{ctrl: ctrl}
+	mock.recorder = &MockPasskeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasskeyRepository) EXPECT() *MockPasskeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPasskeyRepository) Create(ctx context.Context, passkey *models.Passkey) (*models.Passkey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, passkey)
+	ret0, _ := ret[0].(*models.Passkey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPasskeyRepositoryMockRecorder) Create(ctx, passkey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPasskeyRepository)(nil).Create), ctx, passkey)
+}
+
+// Delete mocks base method.
+func (m *MockPasskeyRepository) Delete(ctx context.Context, userID, passkeyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, passkeyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPasskeyRepositoryMockRecorder) Delete(ctx, userID, passkeyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPasskeyRepository)(nil).Delete), ctx, userID, passkeyID)
+}
+
+// FindByCredentialID mocks base method.
+func (m *MockPasskeyRepository) FindByCredentialID(ctx context.Context, credentialID []byte) (*models.Passkey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCredentialID", ctx, credentialID)
+	ret0, _ := ret[0].(*models.Passkey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCredentialID indicates an expected call of FindByCredentialID.
+func (mr *MockPasskeyRepositoryMockRecorder) FindByCredentialID(ctx, credentialID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCredentialID", reflect.TypeOf((*MockPasskeyRepository)(nil).FindByCredentialID), ctx, credentialID)
+}
+
+// FindByUserID mocks base method.
+func (m *MockPasskeyRepository) FindByUserID(ctx context.Context, userID string) ([]*models.Passkey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*models.Passkey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockPasskeyRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockPasskeyRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// Rename mocks base method.
+func (m *MockPasskeyRepository) Rename(ctx context.Context, userID, passkeyID, name string) (*models.Passkey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", ctx, userID, passkeyID, name)
+	ret0, _ := ret[0].(*models.Passkey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockPasskeyRepositoryMockRecorder) Rename(ctx, userID, passkeyID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockPasskeyRepository)(nil).Rename), ctx, userID, passkeyID, name)
+}
+
+// UpdateUsage mocks base method.
+func (m *MockPasskeyRepository) UpdateUsage(ctx context.Context, passkey *models.Passkey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUsage", ctx, passkey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUsage indicates an expected call of UpdateUsage.
+func (mr *MockPasskeyRepositoryMockRecorder) UpdateUsage(ctx, passkey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsage", reflect.TypeOf((*MockPasskeyRepository)(nil).UpdateUsage), ctx, passkey)
+}
@@ -94,6 +94,51 @@ func (mr *MockUserRepositoryMockRecorder) FindByID(ctx, userID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockUserRepository)(nil).FindByID), ctx, userID)
 }
 
+// SetAvatar mocks base method.
+func (m *MockUserRepository) SetAvatar(ctx context.Context, userID, key string, urls map[string]string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAvatar", ctx, userID, key, urls)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAvatar indicates an expected call of SetAvatar.
+func (mr *MockUserRepositoryMockRecorder) SetAvatar(ctx, userID, key, urls interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAvatar", reflect.TypeOf((*MockUserRepository)(nil).SetAvatar), ctx, userID, key, urls)
+}
+
+// SetBlocked mocks base method.
+func (m *MockUserRepository) SetBlocked(ctx context.Context, userID string, blocked bool) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBlocked", ctx, userID, blocked)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBlocked indicates an expected call of SetBlocked.
+func (mr *MockUserRepositoryMockRecorder) SetBlocked(ctx, userID, blocked interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlocked", reflect.TypeOf((*MockUserRepository)(nil).SetBlocked), ctx, userID, blocked)
+}
+
+// SetPhone mocks base method.
+func (m *MockUserRepository) SetPhone(ctx context.Context, userID, phone string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPhone", ctx, userID, phone)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPhone indicates an expected call of SetPhone.
+func (mr *MockUserRepositoryMockRecorder) SetPhone(ctx, userID, phone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPhone", reflect.TypeOf((*MockUserRepository)(nil).SetPhone), ctx, userID, phone)
+}
+
 // Update mocks base method.
 func (m *MockUserRepository) Update(ctx context.Context, userID string, newEmail, newPassword, newFirstName, newLastName *string) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -108,3 +153,18 @@ func (mr *MockUserRepositoryMockRecorder) Update(ctx, userID, newEmail, newPassw
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), ctx, userID, newEmail, newPassword, newFirstName, newLastName)
 }
+
+// VerifyPhone mocks base method.
+func (m *MockUserRepository) VerifyPhone(ctx context.Context, userID, phone string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyPhone", ctx, userID, phone)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyPhone indicates an expected call of VerifyPhone.
+func (mr *MockUserRepositoryMockRecorder) VerifyPhone(ctx, userID, phone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyPhone", reflect.TypeOf((*MockUserRepository)(nil).VerifyPhone), ctx, userID, phone)
+}
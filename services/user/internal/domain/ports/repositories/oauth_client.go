@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+)
+
+type OAuthClientRepository interface {
+	Create(ctx context.Context, client *models.OAuthClient) (*models.OAuthClient, error)
+	// FindByID returns pgx.ErrNoRows when no client is registered with id.
+	FindByID(ctx context.Context, id string) (*models.OAuthClient, error)
+}
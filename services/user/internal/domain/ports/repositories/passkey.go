@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+)
+
+// PasskeyRepository scopes every lookup by user except FindByCredentialID,
+// which a login needs before the user is known.
+type PasskeyRepository interface {
+	Create(ctx context.Context, passkey *models.Passkey) (*models.Passkey, error)
+	FindByUserID(ctx context.Context, userID string) ([]*models.Passkey, error)
+	FindByCredentialID(ctx context.Context, credentialID []byte) (*models.Passkey, error)
+	Rename(ctx context.Context, userID, passkeyID, name string) (*models.Passkey, error)
+	// UpdateUsage stores the sign count and backup state of a passkey that
+	// was just used to sign in, and sets its last use to now.
+	UpdateUsage(ctx context.Context, passkey *models.Passkey) error
+	Delete(ctx context.Context, userID, passkeyID string) error
+}
@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+)
+
+// RoleRepository reads the roles, which the migrations seed, and assigns
+// them to users.
+type RoleRepository interface {
+	// FindAll returns every role with the permissions it grants, by name.
+	FindAll(ctx context.Context) ([]*models.Role, error)
+	// Assign gives the user role; assigning a role the user has already is
+	// not an error. It returns ErrRoleNotFound for an unknown role.
+	Assign(ctx context.Context, userID, role string) error
+	// Revoke returns pgx.ErrNoRows when the user does not have role.
+	Revoke(ctx context.Context, userID, role string) error
+}
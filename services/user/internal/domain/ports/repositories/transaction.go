@@ -0,0 +1,7 @@
+package domain
+
+import "context"
+
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -11,5 +11,14 @@ type UserRepository interface {
 	FindByEmail(ctx context.Context, email string) (*models.User, error)
 	FindByID(ctx context.Context, userID string) (*models.User, error)
 	Update(ctx context.Context, userID string, newEmail, newPassword, newFirstName, newLastName *string) (*models.User, error)
+	// SetBlocked blocks or unblocks the user; blocking an already blocked
+	// user keeps the original time.
+	SetBlocked(ctx context.Context, userID string, blocked bool) (*models.User, error)
+	// SetPhone replaces the phone and marks it unverified.
+	SetPhone(ctx context.Context, userID, phone string) (*models.User, error)
+	// VerifyPhone marks the phone verified, provided it is still phone.
+	VerifyPhone(ctx context.Context, userID, phone string) (*models.User, error)
+	// SetAvatar replaces the avatar storage prefix and thumbnail URLs.
+	SetAvatar(ctx context.Context, userID, key string, urls map[string]string) (*models.User, error)
 	Delete(ctx context.Context, userID string) error
 }
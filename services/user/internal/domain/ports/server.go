@@ -1,5 +1,8 @@
 package domain
 
+import "context"
+
 type Server interface {
 	Run() error
+	Shutdown(ctx context.Context) error
 }
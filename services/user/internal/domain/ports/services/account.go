@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+)
+
+// CreateOAuthClientArgs registers an OAuth client. A public client, such as
+// a mobile app, gets no secret and cannot use the client credentials grant.
+type CreateOAuthClientArgs struct {
+	Name         string
+	RedirectURIs []string
+	GrantTypes   []string
+	Scopes       []string
+	Public       bool
+}
+
+// CreateServiceAPIKeyArgs creates an API key for a service, such as the
+// warehouse scripts. A zero ExpiresIn makes a key that does not expire.
+type CreateServiceAPIKeyArgs struct {
+	Service   string
+	Name      string
+	Scopes    []string
+	ExpiresIn time.Duration
+}
+
+// AccountService holds the operator actions run from the command line. The
+// user argument of Block and Unblock is either an ID or an email.
+type AccountService interface {
+	CreateAdmin(ctx context.Context, email, password, firstName, lastName string) (*models.User, error)
+	Block(ctx context.Context, user string) (*models.User, error)
+	Unblock(ctx context.Context, user string) (*models.User, error)
+	// CreateOAuthClient returns the client and its secret, which is stored
+	// only as a hash and cannot be shown again.
+	CreateOAuthClient(ctx context.Context, args *CreateOAuthClientArgs) (*models.OAuthClient, string, error)
+	// CreateServiceAPIKey returns the key of a service and the key itself,
+	// which, like a client secret, cannot be shown again.
+	CreateServiceAPIKey(ctx context.Context, args *CreateServiceAPIKeyArgs) (*models.APIKey, string, error)
+	ListServiceAPIKeys(ctx context.Context, service string) ([]*models.APIKey, error)
+	// RevokeAPIKey revokes any key, a user's included, for when one leaked.
+	RevokeAPIKey(ctx context.Context, keyID string) (*models.APIKey, error)
+}
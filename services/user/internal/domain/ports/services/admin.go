@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+)
+
+// AdminService manages the roles of users and lets admins act as them. The
+// permission each method requires is checked on the way in, by the rbac
+// interceptor; the methods check that the session of the access token is
+// still live and that it was not issued by impersonation.
+type AdminService interface {
+	ListRoles(ctx context.Context, accessToken string) ([]*models.Role, error)
+	// GetUser returns the user with its roles and the permissions they
+	// grant.
+	GetUser(ctx context.Context, userID, accessToken string) (*models.User, error)
+	AssignRole(ctx context.Context, userID, role, accessToken string) error
+	RevokeRole(ctx context.Context, userID, role, accessToken string) error
+	// Impersonate returns an access token acting as the user, with the
+	// caller as actor, and its lifetime. It cannot be refreshed.
+	Impersonate(ctx context.Context, userID, accessToken string) (string, time.Duration, error)
+}
@@ -11,4 +11,24 @@ type AuthService interface {
 	Register(ctx context.Context, email, password, firstName, lastName string) (*models.User, string, string, error)
 	RefreshToken(ctx context.Context, refreshToken string) (string, error)
 	Logout(ctx context.Context, accessToken string) error
+	// OAuthAuthorize returns the identity provider page the user signs in at
+	// and the nonce that binds the sign-in to the browser. With the access
+	// token of a signed-in user, the identity is linked to that user.
+	OAuthAuthorize(ctx context.Context, provider, accessToken string) (string, string, error)
+	// OAuthLogin completes the sign-in with the code and state the provider
+	// redirected back with and the nonce returned by OAuthAuthorize.
+	OAuthLogin(ctx context.Context, provider, code, state, browserNonce string) (*models.User, string, string, error)
+	// RequestMagicLink emails a sign-in link and returns the nonce that binds
+	// it to the requesting device.
+	RequestMagicLink(ctx context.Context, email string) (string, error)
+	// ConsumeMagicLink signs in with the link token and the nonce returned by
+	// RequestMagicLink.
+	ConsumeMagicLink(ctx context.Context, token, nonce string) (*models.User, string, string, error)
+	// BeginPasskeyLogin returns the ID of the ceremony and the options to
+	// ask the browser for a passkey with. FinishPasskeyLogin signs in with
+	// the authenticator's response.
+	BeginPasskeyLogin(ctx context.Context) (string, []byte, error)
+	FinishPasskeyLogin(ctx context.Context, ceremonyID string, response []byte) (*models.User, string, string, error)
+	// AuthenticateAPIKey returns who requests made with key act as.
+	AuthenticateAPIKey(ctx context.Context, key string) (*models.Claims, error)
 }
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+)
+
+// AuthorizeArgs is an authorization request of an OAuth client, made on
+// behalf of the user the access token was issued to. Scope is a
+// space-separated list; empty asks for every scope of the client.
+type AuthorizeArgs struct {
+	ClientID      string
+	RedirectURI   string
+	Scope         string
+	State         string
+	CodeChallenge string
+
+	AccessToken string
+}
+
+// TokenArgs is a token request; which fields are used depends on GrantType.
+// ClientSecret is empty for public clients.
+type TokenArgs struct {
+	GrantType    string
+	ClientID     string
+	ClientSecret string
+
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+
+	RefreshToken string
+	Scope        string
+}
+
+// OAuthService is the authorization server for registered OAuth clients.
+// Every method but Authorize authenticates the client first.
+type OAuthService interface {
+	// Authorize issues an authorization code bound to the PKCE challenge and
+	// returns the redirect URI with the code and state added.
+	Authorize(ctx context.Context, args *AuthorizeArgs) (string, error)
+	Token(ctx context.Context, args *TokenArgs) (*models.OAuthToken, error)
+	// Revoke ends the grant the access or refresh token belongs to. Tokens
+	// that are invalid already are not an error.
+	Revoke(ctx context.Context, clientID, clientSecret, token string) error
+	Introspect(ctx context.Context, clientID, clientSecret, token string) (*models.TokenIntrospection, error)
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
 )
@@ -18,8 +20,52 @@ type UpdateProfileArgs struct {
 	AccessToken string
 }
 
+// CreateAPIKeyArgs creates an API key acting on the user's profile. A nil
+// ExpiresAt makes a key that does not expire.
+type CreateAPIKeyArgs struct {
+	UserID    string
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time
+
+	AccessToken string
+}
+
 type ProfileService interface {
 	Get(ctx context.Context, userID, accessToken string) (*models.User, error)
 	Update(ctx context.Context, args *UpdateProfileArgs) (*models.User, error)
 	Delete(ctx context.Context, userID, password, accessToken string) error
+
+	// SetPhone stores the phone unverified and texts it a verification code,
+	// which VerifyPhone then checks.
+	SetPhone(ctx context.Context, userID, phone, accessToken string) (*models.User, error)
+	VerifyPhone(ctx context.Context, userID, code, accessToken string) (*models.User, error)
+
+	// UploadAvatar replaces the user's avatar with thumbnails made from the
+	// JPEG, PNG or WebP image of contentType read from file. file is only
+	// read once the access token has been checked.
+	UploadAvatar(ctx context.Context, userID, contentType string, file io.Reader, accessToken string) (*models.User, error)
+
+	ListAddresses(ctx context.Context, userID, accessToken string) ([]*models.Address, error)
+	GetAddress(ctx context.Context, userID, addressID, accessToken string) (*models.Address, error)
+	// CreateAddress and UpdateAddress move the default flags that are set on
+	// address away from the user's other addresses.
+	CreateAddress(ctx context.Context, address *models.Address, accessToken string) (*models.Address, error)
+	UpdateAddress(ctx context.Context, address *models.Address, accessToken string) (*models.Address, error)
+	DeleteAddress(ctx context.Context, userID, addressID, accessToken string) error
+
+	// BeginPasskeyRegistration returns the ID of the ceremony and the options
+	// to create the passkey with, which FinishPasskeyRegistration then takes
+	// the authenticator's response to. A ceremony can be finished once.
+	BeginPasskeyRegistration(ctx context.Context, userID, accessToken string) (string, []byte, error)
+	FinishPasskeyRegistration(ctx context.Context, userID, ceremonyID, name string, response []byte, accessToken string) (*models.Passkey, error)
+	ListPasskeys(ctx context.Context, userID, accessToken string) ([]*models.Passkey, error)
+	RenamePasskey(ctx context.Context, userID, passkeyID, name, accessToken string) (*models.Passkey, error)
+	DeletePasskey(ctx context.Context, userID, passkeyID, accessToken string) error
+
+	// CreateAPIKey returns the key and the key itself, which is stored only
+	// as a hash and cannot be shown again.
+	CreateAPIKey(ctx context.Context, args *CreateAPIKeyArgs) (*models.APIKey, string, error)
+	ListAPIKeys(ctx context.Context, userID, accessToken string) ([]*models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID, apiKeyID, accessToken string) (*models.APIKey, error)
 }
@@ -0,0 +1,8 @@
+package domain
+
+import "context"
+
+type SmsSender interface {
+	// Send delivers message to phone, which is in E.164 format.
+	Send(ctx context.Context, phone, message string) error
+}
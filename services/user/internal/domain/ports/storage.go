@@ -0,0 +1,13 @@
+package domain
+
+import "context"
+
+// BlobStorage keeps files under slash-separated keys, e.g.
+// avatars/<user id>/<version>/64.jpg.
+type BlobStorage interface {
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	// DeletePrefix removes every file whose key starts with prefix + "/".
+	DeletePrefix(ctx context.Context, prefix string) error
+	// URL returns the public address of the file stored under key.
+	URL(key string) string
+}
@@ -0,0 +1,89 @@
+package adapters
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	fieldClientID      = "client_id"
+	fieldUserID        = "user_id"
+	fieldRedirectURI   = "redirect_uri"
+	fieldScope         = "scope"
+	fieldCodeChallenge = "code_challenge"
+)
+
+type AuthCodeAdapter struct {
+	redisClient *redis.Client
+	logger      logger.Logger
+	cfg         *configs.Config
+}
+
+func NewAuthCodeAdapter(redisClient *redis.Client, log logger.Logger, cfg *configs.Config) (domain.AuthCodeAdapter, error) {
+	loggerTag := "adapters.cache.redis.authcode.newAuthCodeAdapter"
+
+	log.Info(loggerTag, "Auth code adapter initialized")
+
+	return &AuthCodeAdapter{
+		redisClient,
+		log,
+		cfg,
+	}, nil
+}
+
+// key hashes the code, so that reading redis is not enough to redeem one.
+func key(code string) string {
+	sum := sha256.Sum256([]byte(code))
+
+	return fmt.Sprintf("auth_code:%s", hex.EncodeToString(sum[:]))
+}
+
+func (ca *AuthCodeAdapter) Set(ctx context.Context, code string, authCode *domain.AuthCode, expiresIn time.Duration) error {
+	_, err := ca.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key(code),
+			fieldClientID, authCode.ClientID,
+			fieldUserID, authCode.UserID,
+			fieldRedirectURI, authCode.RedirectURI,
+			fieldScope, authCode.Scope,
+			fieldCodeChallenge, authCode.CodeChallenge,
+		)
+		pipe.Expire(ctx, key(code), expiresIn)
+
+		return nil
+	})
+
+	return err
+}
+
+func (ca *AuthCodeAdapter) Take(ctx context.Context, code string) (*domain.AuthCode, error) {
+	var values *redis.StringStringMapCmd
+
+	_, err := ca.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		values = pipe.HGetAll(ctx, key(code))
+		pipe.Del(ctx, key(code))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(values.Val()) == 0 {
+		return nil, redis.Nil
+	}
+
+	return &domain.AuthCode{
+		ClientID:      values.Val()[fieldClientID],
+		UserID:        values.Val()[fieldUserID],
+		RedirectURI:   values.Val()[fieldRedirectURI],
+		Scope:         values.Val()[fieldScope],
+		CodeChallenge: values.Val()[fieldCodeChallenge],
+	}, nil
+}
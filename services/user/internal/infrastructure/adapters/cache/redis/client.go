@@ -0,0 +1,59 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/retry"
+	"github.com/go-redis/redis/extra/redisotel/v8"
+	"github.com/go-redis/redis/v8"
+)
+
+func NewClient(log logger.Logger, cfg *configs.Config) (*redis.Client, error) {
+	loggerTag := "adapters.cache.redis.newClient"
+
+	log.Info(loggerTag, "Initializing redis client")
+	redisClient := redis.NewClient(
+		&redis.Options{
+			Addr:         cfg.RedisURI,
+			Password:     cfg.RedisPassword,
+			PoolSize:     cfg.RedisPoolSize,
+			DialTimeout:  cfg.RedisDialTimeout,
+			ReadTimeout:  cfg.RedisReadTimeout,
+			WriteTimeout: cfg.RedisWriteTimeout,
+		},
+	)
+
+	redisClient.AddHook(redisotel.NewTracingHook())
+
+	err := retry.Do(context.Background(), cfg.StartupPingAttempts, cfg.StartupPingBackoff, func(ctx context.Context, attempt int) error {
+		if err := redisClient.Ping(ctx).Err(); err != nil {
+			log.Warn(loggerTag, "Redis is not reachable yet", logger.Field{
+				Key:   "attempt",
+				Value: attempt,
+			}, logger.Field{
+				Key:   "error",
+				Value: err.Error(),
+			})
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		_ = redisClient.Close()
+
+		log.Error(loggerTag, ErrConnecting, logger.Field{
+			Key:   "error",
+			Value: err.Error(),
+		})
+
+		return nil, fmt.Errorf("%s: %v", ErrConnecting, err)
+	}
+	log.Info(loggerTag, "Connection to the redis has been completed")
+
+	return redisClient, nil
+}
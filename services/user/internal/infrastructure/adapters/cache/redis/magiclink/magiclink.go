@@ -0,0 +1,80 @@
+package adapters
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	fieldUserID    = "user_id"
+	fieldNonceHash = "nonce_hash"
+)
+
+type MagicLinkAdapter struct {
+	redisClient *redis.Client
+	logger      logger.Logger
+	cfg         *configs.Config
+}
+
+func NewMagicLinkAdapter(redisClient *redis.Client, log logger.Logger, cfg *configs.Config) (domain.MagicLinkAdapter, error) {
+	loggerTag := "adapters.cache.redis.magiclink.newMagicLinkAdapter"
+
+	log.Info(loggerTag, "Magic link adapter initialized")
+
+	return &MagicLinkAdapter{
+		redisClient,
+		log,
+		cfg,
+	}, nil
+}
+
+// key hashes the token, so that reading redis is not enough to sign in.
+func key(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return fmt.Sprintf("magic_link:%s", hex.EncodeToString(sum[:]))
+}
+
+func (ma *MagicLinkAdapter) Set(ctx context.Context, token string, magicLink *domain.MagicLink, expiresIn time.Duration) error {
+	_, err := ma.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key(token),
+			fieldUserID, magicLink.UserID,
+			fieldNonceHash, magicLink.NonceHash,
+		)
+		pipe.Expire(ctx, key(token), expiresIn)
+
+		return nil
+	})
+
+	return err
+}
+
+func (ma *MagicLinkAdapter) Take(ctx context.Context, token string) (*domain.MagicLink, error) {
+	var values *redis.StringStringMapCmd
+
+	_, err := ma.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		values = pipe.HGetAll(ctx, key(token))
+		pipe.Del(ctx, key(token))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(values.Val()) == 0 {
+		return nil, redis.Nil
+	}
+
+	return &domain.MagicLink{
+		UserID:    values.Val()[fieldUserID],
+		NonceHash: values.Val()[fieldNonceHash],
+	}, nil
+}
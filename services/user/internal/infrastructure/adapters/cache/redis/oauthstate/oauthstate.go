@@ -0,0 +1,84 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	fieldProvider         = "provider"
+	fieldNonce            = "nonce"
+	fieldCodeVerifier     = "code_verifier"
+	fieldBrowserNonceHash = "browser_nonce_hash"
+	fieldLinkUserID       = "link_user_id"
+)
+
+type OAuthStateAdapter struct {
+	redisClient *redis.Client
+	logger      logger.Logger
+	cfg         *configs.Config
+}
+
+func NewOAuthStateAdapter(redisClient *redis.Client, log logger.Logger, cfg *configs.Config) (domain.OAuthStateAdapter, error) {
+	loggerTag := "adapters.cache.redis.oauthstate.newOAuthStateAdapter"
+
+	log.Info(loggerTag, "OAuth state adapter initialized")
+
+	return &OAuthStateAdapter{
+		redisClient,
+		log,
+		cfg,
+	}, nil
+}
+
+func key(state string) string {
+	return fmt.Sprintf("oauth_state:%s", state)
+}
+
+func (sa *OAuthStateAdapter) Set(ctx context.Context, state string, oauthState *domain.OAuthState, expiresIn time.Duration) error {
+	_, err := sa.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key(state),
+			fieldProvider, oauthState.Provider,
+			fieldNonce, oauthState.Nonce,
+			fieldCodeVerifier, oauthState.CodeVerifier,
+			fieldBrowserNonceHash, oauthState.BrowserNonceHash,
+			fieldLinkUserID, oauthState.LinkUserID,
+		)
+		pipe.Expire(ctx, key(state), expiresIn)
+
+		return nil
+	})
+
+	return err
+}
+
+func (sa *OAuthStateAdapter) Take(ctx context.Context, state string) (*domain.OAuthState, error) {
+	var values *redis.StringStringMapCmd
+
+	_, err := sa.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		values = pipe.HGetAll(ctx, key(state))
+		pipe.Del(ctx, key(state))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(values.Val()) == 0 {
+		return nil, redis.Nil
+	}
+
+	return &domain.OAuthState{
+		Provider:         values.Val()[fieldProvider],
+		Nonce:            values.Val()[fieldNonce],
+		CodeVerifier:     values.Val()[fieldCodeVerifier],
+		BrowserNonceHash: values.Val()[fieldBrowserNonceHash],
+		LinkUserID:       values.Val()[fieldLinkUserID],
+	}, nil
+}
@@ -0,0 +1,103 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	fieldPhone    = "phone"
+	fieldCodeHash = "code_hash"
+	fieldAttempts = "attempts"
+	fieldSentAt   = "sent_at"
+)
+
+// incrAttempts does not recreate a key that expired since it was read,
+// which would leave it without a TTL.
+var incrAttempts = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return false
+end
+return redis.call("HINCRBY", KEYS[1], ARGV[1], 1)
+`)
+
+type OTPAdapter struct {
+	redisClient *redis.Client
+	logger      logger.Logger
+	cfg         *configs.Config
+}
+
+func NewOTPAdapter(redisClient *redis.Client, log logger.Logger, cfg *configs.Config) (domain.OTPAdapter, error) {
+	loggerTag := "adapters.cache.redis.otp.newOTPAdapter"
+
+	log.Info(loggerTag, "OTP adapter initialized")
+
+	return &OTPAdapter{
+		redisClient,
+		log,
+		cfg,
+	}, nil
+}
+
+func key(userID string) string {
+	return fmt.Sprintf("phone_otp:%s", userID)
+}
+
+func (oa *OTPAdapter) Set(ctx context.Context, userID string, otp *domain.PhoneOTP, expiresIn time.Duration) error {
+	_, err := oa.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key(userID))
+		pipe.HSet(ctx, key(userID),
+			fieldPhone, otp.Phone,
+			fieldCodeHash, otp.CodeHash,
+			fieldAttempts, otp.Attempts,
+			fieldSentAt, otp.SentAt.Unix(),
+		)
+		pipe.Expire(ctx, key(userID), expiresIn)
+
+		return nil
+	})
+
+	return err
+}
+
+func (oa *OTPAdapter) Get(ctx context.Context, userID string) (*domain.PhoneOTP, error) {
+	values, err := oa.redisClient.HGetAll(ctx, key(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, redis.Nil
+	}
+
+	attempts, err := strconv.Atoi(values[fieldAttempts])
+	if err != nil {
+		return nil, fmt.Errorf("invalid attempts: %v", err)
+	}
+
+	sentAt, err := strconv.ParseInt(values[fieldSentAt], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sent_at: %v", err)
+	}
+
+	return &domain.PhoneOTP{
+		Phone:    values[fieldPhone],
+		CodeHash: values[fieldCodeHash],
+		Attempts: attempts,
+		SentAt:   time.Unix(sentAt, 0),
+	}, nil
+}
+
+func (oa *OTPAdapter) IncrAttempts(ctx context.Context, userID string) (int, error) {
+	return incrAttempts.Run(ctx, oa.redisClient, []string{key(userID)}, fieldAttempts).Int()
+}
+
+func (oa *OTPAdapter) Del(ctx context.Context, userID string) error {
+	return oa.redisClient.Del(ctx, key(userID)).Err()
+}
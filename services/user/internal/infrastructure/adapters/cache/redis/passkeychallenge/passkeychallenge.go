@@ -0,0 +1,75 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	fieldUserID  = "user_id"
+	fieldSession = "session"
+)
+
+type PasskeyChallengeAdapter struct {
+	redisClient *redis.Client
+	logger      logger.Logger
+	cfg         *configs.Config
+}
+
+func NewPasskeyChallengeAdapter(redisClient *redis.Client, log logger.Logger, cfg *configs.Config) (domain.PasskeyChallengeAdapter, error) {
+	loggerTag := "adapters.cache.redis.passkeychallenge.newPasskeyChallengeAdapter"
+
+	log.Info(loggerTag, "Passkey challenge adapter initialized")
+
+	return &PasskeyChallengeAdapter{
+		redisClient,
+		log,
+		cfg,
+	}, nil
+}
+
+func key(ceremonyID string) string {
+	return fmt.Sprintf("passkey_challenge:%s", ceremonyID)
+}
+
+func (pa *PasskeyChallengeAdapter) Set(ctx context.Context, ceremonyID string, challenge *domain.PasskeyChallenge, expiresIn time.Duration) error {
+	_, err := pa.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key(ceremonyID),
+			fieldUserID, challenge.UserID,
+			fieldSession, challenge.Session,
+		)
+		pipe.Expire(ctx, key(ceremonyID), expiresIn)
+
+		return nil
+	})
+
+	return err
+}
+
+func (pa *PasskeyChallengeAdapter) Take(ctx context.Context, ceremonyID string) (*domain.PasskeyChallenge, error) {
+	var values *redis.StringStringMapCmd
+
+	_, err := pa.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		values = pipe.HGetAll(ctx, key(ceremonyID))
+		pipe.Del(ctx, key(ceremonyID))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(values.Val()) == 0 {
+		return nil, redis.Nil
+	}
+
+	return &domain.PasskeyChallenge{
+		UserID:  values.Val()[fieldUserID],
+		Session: []byte(values.Val()[fieldSession]),
+	}, nil
+}
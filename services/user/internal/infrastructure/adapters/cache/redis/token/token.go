@@ -17,28 +17,10 @@ type TokenAdapter struct {
 	cfg         *configs.Config
 }
 
-func NewTokenAdapter(log logger.Logger, cfg *configs.Config) (domain.TokenAdapter, error) {
+func NewTokenAdapter(redisClient *redis.Client, log logger.Logger, cfg *configs.Config) (domain.TokenAdapter, error) {
 	loggerTag := "adapters.cache.redis.token.newTokenAdapter"
 
-	log.Info(loggerTag, "Initializing the token adapter")
-
-	log.Info(loggerTag, "Initializing redis client")
-	redisClient := redis.NewClient(
-		&redis.Options{
-			Addr:     cfg.RedisURI,
-			Password: cfg.RedisPassword,
-		},
-	)
-
-	if err := redisClient.Ping(context.Background()).Err(); err != nil {
-		log.Error(loggerTag, ErrConnecting, logger.Field{
-			Key:   "error",
-			Value: err.Error(),
-		})
-
-		return nil, fmt.Errorf("%s: %v", ErrConnecting, err)
-	}
-	log.Info(loggerTag, "Connection to the redis has been completed")
+	log.Info(loggerTag, "Token adapter initialized")
 
 	return &TokenAdapter{
 		redisClient,
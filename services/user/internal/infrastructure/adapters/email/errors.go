@@ -0,0 +1,3 @@
+package adapters
+
+const ErrUnknownSender = "unknown email sender"
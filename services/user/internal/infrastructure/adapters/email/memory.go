@@ -0,0 +1,62 @@
+package adapters
+
+import (
+	"context"
+	"sync"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// MemorySender logs every email instead of delivering it and keeps it in
+// memory. It is meant for local work, where links are read from the log,
+// and for tests.
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []Message
+	logger   logger.Logger
+}
+
+func NewMemorySender(logger logger.Logger) *MemorySender {
+	loggerTag := "adapters.email.newMemorySender"
+
+	logger.Warn(loggerTag, "Emails are logged, not delivered")
+
+	return &MemorySender{
+		logger: logger,
+	}
+}
+
+var _ domain.EmailSender = (*MemorySender)(nil)
+
+func (s *MemorySender) Send(ctx context.Context, to, subject, body string) error {
+	loggerTag := "adapters.email.send"
+
+	s.mu.Lock()
+	s.messages = append(s.messages, Message{to, subject, body})
+	s.mu.Unlock()
+
+	s.logger.InfoCtx(ctx, loggerTag, body, logger.Field{
+		Key:   "to",
+		Value: to,
+	}, logger.Field{
+		Key:   "subject",
+		Value: subject,
+	})
+
+	return nil
+}
+
+// Messages returns the emails sent so far, oldest first.
+func (s *MemorySender) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Message(nil), s.messages...)
+}
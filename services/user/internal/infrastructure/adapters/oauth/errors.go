@@ -0,0 +1,8 @@
+package adapters
+
+const (
+	ErrDiscovery      = "failed discover identity provider"
+	ErrNoIDToken      = "token response has no id_token"
+	ErrNonceMismatch  = "id token nonce does not match"
+	ErrNoPrimaryEmail = "account has no primary email"
+)
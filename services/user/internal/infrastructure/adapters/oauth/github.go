@@ -0,0 +1,138 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+	"golang.org/x/oauth2"
+)
+
+// GitHubProvider signs users in with GitHub, which speaks plain OAuth2
+// rather than OpenID Connect: there is no ID token, so the user and their
+// emails are read from the API with the access token instead.
+type GitHubProvider struct {
+	oauth  oauth2.Config
+	apiURL string
+	client *http.Client
+}
+
+type githubUser struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+	Name  string `json:"name"`
+}
+
+type githubEmail struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+func NewGitHubProvider(baseURL, apiURL string, oauth oauth2.Config, client *http.Client) domain.IdentityProvider {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	oauth.Endpoint = oauth2.Endpoint{
+		AuthURL:   baseURL + "/login/oauth/authorize",
+		TokenURL:  baseURL + "/login/oauth/access_token",
+		AuthStyle: oauth2.AuthStyleInParams,
+	}
+	oauth.Scopes = []string{"read:user", "user:email"}
+
+	return &GitHubProvider{
+		oauth:  oauth,
+		apiURL: strings.TrimSuffix(apiURL, "/"),
+		client: client,
+	}
+}
+
+func (p *GitHubProvider) Name() string {
+	return ProviderGitHub
+}
+
+// AuthCodeURL ignores nonce: without an ID token there is nothing to bind it
+// to, and the state alone ties the redirect to the request.
+func (p *GitHubProvider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	return p.oauth.AuthCodeURL(state, oauth2.S256ChallengeOption(codeVerifier)), nil
+}
+
+func (p *GitHubProvider) Exchange(ctx context.Context, code, nonce, codeVerifier string) (*models.ExternalIdentity, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.client)
+
+	token, err := exchange(ctx, &p.oauth, code, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	client := p.oauth.Client(ctx, token)
+
+	var user githubUser
+	if err = p.get(ctx, client, "/user", &user); err != nil {
+		return nil, err
+	}
+
+	var emails []githubEmail
+	if err = p.get(ctx, client, "/user/emails", &emails); err != nil {
+		return nil, err
+	}
+
+	// Only the primary email is taken: it is the one GitHub itself sends
+	// mail to, the others may belong to a former employer.
+	var email *githubEmail
+	for i := range emails {
+		if emails[i].Primary {
+			email = &emails[i]
+
+			break
+		}
+	}
+	if email == nil {
+		return nil, rejected(errors.New(ErrNoPrimaryEmail))
+	}
+
+	name := user.Name
+	if name == "" {
+		name = user.Login
+	}
+	firstName, lastName, _ := strings.Cut(name, " ")
+
+	return &models.ExternalIdentity{
+		Provider:      ProviderGitHub,
+		Subject:       strconv.FormatInt(user.ID, 10),
+		Email:         email.Email,
+		EmailVerified: email.Verified,
+		FirstName:     firstName,
+		LastName:      lastName,
+	}, nil
+}
+
+func (p *GitHubProvider) get(ctx context.Context, client *http.Client, path string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.apiURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("github %s: %s", path, resp.Status)
+		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+			return rejected(err)
+		}
+
+		return err
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,174 @@
+// Package oauthtest runs a local OpenID Connect provider for tests. It serves
+// discovery, the signing keys and the token endpoint; the authorization page
+// is played by Authorize.
+package oauthtest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/coreos/go-oidc/v3/oidc/oidctest"
+)
+
+const keyID = "test"
+
+// Identity is the account the user signs in with.
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	GivenName     string
+	FamilyName    string
+}
+
+type grant struct {
+	identity      Identity
+	nonce         string
+	codeChallenge string
+	redirectURL   string
+}
+
+type Server struct {
+	*httptest.Server
+	ClientID     string
+	ClientSecret string
+
+	key    *rsa.PrivateKey
+	mu     sync.Mutex
+	grants map[string]grant
+}
+
+// NewServer starts a provider that only accepts the given client. Close it
+// when done.
+func NewServer(clientID, clientSecret string) *Server {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic("oauthtest: generating key: " + err.Error())
+	}
+
+	s := &Server{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		key:          key,
+		grants:       make(map[string]grant),
+	}
+
+	discovery := &oidctest.Server{
+		PublicKeys: []oidctest.PublicKey{{
+			PublicKey: key.Public(),
+			KeyID:     keyID,
+			Algorithm: oidc.RS256,
+		}},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", s.serveToken)
+	mux.Handle("/", discovery)
+
+	s.Server = httptest.NewServer(mux)
+	discovery.SetIssuer(s.URL)
+
+	return s
+}
+
+// Authorize plays the user signing in as identity on the page authURL points
+// at, and returns the code and state the provider redirects back with.
+func (s *Server) Authorize(authURL string, identity Identity) (string, string) {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		panic("oauthtest: invalid authorization URL: " + err.Error())
+	}
+	query := u.Query()
+
+	code := rand.Text()
+
+	s.mu.Lock()
+	s.grants[code] = grant{
+		identity:      identity,
+		nonce:         query.Get("nonce"),
+		codeChallenge: query.Get("code_challenge"),
+		redirectURL:   query.Get("redirect_uri"),
+	}
+	s.mu.Unlock()
+
+	return code, query.Get("state")
+}
+
+func (s *Server) sign(claims map[string]any) string {
+	raw, err := json.Marshal(claims)
+	if err != nil {
+		panic("oauthtest: encoding claims: " + err.Error())
+	}
+
+	return oidctest.SignIDToken(s.key, keyID, oidc.RS256, string(raw))
+}
+
+func (s *Server) serveToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, http.StatusBadRequest, "invalid_request")
+
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != s.ClientID || clientSecret != s.ClientSecret {
+		tokenError(w, http.StatusUnauthorized, "invalid_client")
+
+		return
+	}
+
+	s.mu.Lock()
+	g, ok := s.grants[r.PostForm.Get("code")]
+	delete(s.grants, r.PostForm.Get("code"))
+	s.mu.Unlock()
+
+	challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok ||
+		r.PostForm.Get("grant_type") != "authorization_code" ||
+		r.PostForm.Get("redirect_uri") != g.redirectURL ||
+		base64.RawURLEncoding.EncodeToString(challenge[:]) != g.codeChallenge {
+		tokenError(w, http.StatusBadRequest, "invalid_grant")
+
+		return
+	}
+
+	now := time.Now()
+	idToken := s.sign(map[string]any{
+		"iss":            s.URL,
+		"aud":            s.ClientID,
+		"sub":            g.identity.Subject,
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+		"nonce":          g.nonce,
+		"email":          g.identity.Email,
+		"email_verified": g.identity.EmailVerified,
+		"given_name":     g.identity.GivenName,
+		"family_name":    g.identity.FamilyName,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{
+		"access_token": rand.Text(),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func tokenError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
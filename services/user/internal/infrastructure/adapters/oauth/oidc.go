@@ -0,0 +1,119 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+// OIDCProvider signs users in at any OpenID Connect provider, such as Google.
+// The user is identified by the ID token, whose signature is checked against
+// the provider's published keys.
+type OIDCProvider struct {
+	name   string
+	issuer string
+	oauth  oauth2.Config
+	client *http.Client
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+}
+
+func NewOIDCProvider(name, issuer string, oauth oauth2.Config, client *http.Client) domain.IdentityProvider {
+	oauth.Scopes = []string{oidc.ScopeOpenID, "email", "profile"}
+
+	return &OIDCProvider{
+		name:   name,
+		issuer: issuer,
+		oauth:  oauth,
+		client: client,
+	}
+}
+
+func (p *OIDCProvider) Name() string {
+	return p.name
+}
+
+// discover fetches the provider metadata on first use and keeps it, so the
+// service starts even while the provider is unreachable.
+func (p *OIDCProvider) discover(ctx context.Context) (*oidc.Provider, oauth2.Config, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.provider == nil {
+		provider, err := oidc.NewProvider(oidc.ClientContext(ctx, p.client), p.issuer)
+		if err != nil {
+			return nil, oauth2.Config{}, fmt.Errorf("%s %s: %v", ErrDiscovery, p.name, err)
+		}
+
+		p.provider = provider
+	}
+
+	cfg := p.oauth
+	cfg.Endpoint = p.provider.Endpoint()
+
+	return p.provider, cfg, nil
+}
+
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	_, cfg, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return cfg.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(codeVerifier)), nil
+}
+
+func (p *OIDCProvider) Exchange(ctx context.Context, code, nonce, codeVerifier string) (*models.ExternalIdentity, error) {
+	provider, cfg, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = oidc.ClientContext(ctx, p.client)
+
+	token, err := exchange(ctx, &cfg, code, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, rejected(errors.New(ErrNoIDToken))
+	}
+
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: cfg.ClientID}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, rejected(err)
+	}
+
+	if idToken.Nonce != nonce {
+		return nil, rejected(errors.New(ErrNonceMismatch))
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		GivenName     string `json:"given_name"`
+		FamilyName    string `json:"family_name"`
+	}
+	if err = idToken.Claims(&claims); err != nil {
+		return nil, rejected(err)
+	}
+
+	return &models.ExternalIdentity{
+		Provider:      p.name,
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		FirstName:     claims.GivenName,
+		LastName:      claims.FamilyName,
+	}, nil
+}
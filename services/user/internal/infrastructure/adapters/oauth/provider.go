@@ -0,0 +1,75 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+	"golang.org/x/oauth2"
+)
+
+const (
+	ProviderGoogle = "google"
+	ProviderGitHub = "github"
+)
+
+// requestTimeout bounds every call to an identity provider, so a slow
+// provider cannot hold a sign-in RPC forever.
+const requestTimeout = 10 * time.Second
+
+// NewProviders returns the identity providers that have a client ID set.
+func NewProviders(logger logger.Logger, cfg *configs.Config) ([]domain.IdentityProvider, error) {
+	loggerTag := "adapters.oauth.newProviders"
+
+	client := &http.Client{Timeout: requestTimeout}
+
+	var providers []domain.IdentityProvider
+
+	if cfg.OAuthGoogleClientID != "" {
+		providers = append(providers, NewOIDCProvider(ProviderGoogle, cfg.OAuthGoogleIssuer, oauth2.Config{
+			ClientID:     cfg.OAuthGoogleClientID,
+			ClientSecret: cfg.OAuthGoogleClientSecret,
+			RedirectURL:  cfg.OAuthGoogleRedirectURL,
+		}, client))
+	}
+
+	if cfg.OAuthGitHubClientID != "" {
+		providers = append(providers, NewGitHubProvider(cfg.OAuthGitHubURL, cfg.OAuthGitHubAPIURL, oauth2.Config{
+			ClientID:     cfg.OAuthGitHubClientID,
+			ClientSecret: cfg.OAuthGitHubClientSecret,
+			RedirectURL:  cfg.OAuthGitHubRedirectURL,
+		}, client))
+	}
+
+	for _, provider := range providers {
+		logger.Info(loggerTag, fmt.Sprintf("Identity provider %s enabled", provider.Name()))
+	}
+
+	return providers, nil
+}
+
+// rejected marks err as the provider turning the sign-in down, as opposed to
+// the provider being unreachable.
+func rejected(err error) error {
+	return fmt.Errorf("%w: %v", domainErrors.ErrIdentityRejected, err)
+}
+
+func exchange(ctx context.Context, cfg *oauth2.Config, code, codeVerifier string) (*oauth2.Token, error) {
+	token, err := cfg.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		var retrieveErr *oauth2.RetrieveError
+		if errors.As(err, &retrieveErr) {
+			return nil, rejected(err)
+		}
+
+		return nil, err
+	}
+
+	return token, nil
+}
@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	adapters "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/oauth"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+const githubAccessToken = "gho_token"
+
+type githubEmail struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+// newGitHub serves the token endpoint and the parts of the API the provider
+// reads. It hands out an access token only for "code" and codeVerifier.
+func newGitHub(user map[string]any, emails []githubEmail) *httptest.Server {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("client_id") != clientID || r.FormValue("client_secret") != clientSecret ||
+			r.FormValue("code") != "code" || r.FormValue("code_verifier") != codeVerifier {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "bad_verification_code"})
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"access_token": githubAccessToken, "token_type": "bearer"})
+	})
+
+	api := func(v any) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer "+githubAccessToken {
+				w.WriteHeader(http.StatusUnauthorized)
+
+				return
+			}
+
+			json.NewEncoder(w).Encode(v)
+		}
+	}
+	mux.HandleFunc("GET /user", api(user))
+	mux.HandleFunc("GET /user/emails", api(emails))
+
+	return httptest.NewServer(mux)
+}
+
+func TestGitHubProvider_Exchange(t *testing.T) {
+	type args struct {
+		user   map[string]any
+		emails []githubEmail
+		code   string
+	}
+
+	type expect struct {
+		err      error
+		identity *models.ExternalIdentity
+	}
+
+	user := map[string]any{"id": 583231, "login": "octocat", "name": "Mona Lisa Octocat"}
+
+	tests := []struct {
+		name   string
+		args   args
+		expect expect
+	}{
+		{
+			name: "success case",
+			args: args{
+				user: user,
+				emails: []githubEmail{
+					{Email: "old@work.com", Verified: true},
+					{Email: "octocat@github.com", Primary: true, Verified: true},
+				},
+				code: "code",
+			},
+			expect: expect{
+				identity: &models.ExternalIdentity{
+					Provider:      adapters.ProviderGitHub,
+					Subject:       "583231",
+					Email:         "octocat@github.com",
+					EmailVerified: true,
+					FirstName:     "Mona",
+					LastName:      "Lisa Octocat",
+				},
+			},
+		},
+		{
+			name: "no name case",
+			args: args{
+				user:   map[string]any{"id": 583231, "login": "octocat"},
+				emails: []githubEmail{{Email: "octocat@github.com", Primary: true}},
+				code:   "code",
+			},
+			expect: expect{
+				identity: &models.ExternalIdentity{
+					Provider:  adapters.ProviderGitHub,
+					Subject:   "583231",
+					Email:     "octocat@github.com",
+					FirstName: "octocat",
+				},
+			},
+		},
+		{
+			name: "no primary email case",
+			args: args{
+				user:   user,
+				emails: []githubEmail{{Email: "old@work.com", Verified: true}},
+				code:   "code",
+			},
+			expect: expect{
+				err: domainErrors.ErrIdentityRejected,
+			},
+		},
+		{
+			name: "code wrong case",
+			args: args{
+				user:   user,
+				emails: []githubEmail{{Email: "octocat@github.com", Primary: true, Verified: true}},
+				code:   "wrong",
+			},
+			expect: expect{
+				err: domainErrors.ErrIdentityRejected,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newGitHub(tt.args.user, tt.args.emails)
+			defer server.Close()
+
+			provider := adapters.NewGitHubProvider(server.URL, server.URL, oauth2.Config{
+				ClientID:     clientID,
+				ClientSecret: clientSecret,
+				RedirectURL:  redirectURL,
+			}, http.DefaultClient)
+
+			external, err := provider.Exchange(context.Background(), tt.args.code, nonce, codeVerifier)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+				require.Nil(t, external)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expect.identity, external)
+			}
+		})
+	}
+}
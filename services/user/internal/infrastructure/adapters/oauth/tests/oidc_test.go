@@ -0,0 +1,202 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	adapters "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/oauth"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/oauth/oauthtest"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+const (
+	clientID     = "client-id"
+	clientSecret = "client-secret"
+	redirectURL  = "http://localhost/v1/auth/oauth/google/callback"
+	state        = "state"
+	nonce        = "nonce"
+	codeVerifier = "code-verifier-code-verifier-code-verifier-123"
+)
+
+func TestOIDCProvider_AuthCodeURL(t *testing.T) {
+	server := oauthtest.NewServer(clientID, clientSecret)
+	defer server.Close()
+
+	provider := adapters.NewOIDCProvider(adapters.ProviderGoogle, server.URL, oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURL,
+	}, http.DefaultClient)
+
+	authURL, err := provider.AuthCodeURL(context.Background(), state, nonce, codeVerifier)
+	require.NoError(t, err)
+
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/auth", u.Scheme+"://"+u.Host+u.Path)
+
+	query := u.Query()
+	require.Equal(t, clientID, query.Get("client_id"))
+	require.Equal(t, redirectURL, query.Get("redirect_uri"))
+	require.Equal(t, "code", query.Get("response_type"))
+	require.Equal(t, "openid email profile", query.Get("scope"))
+	require.Equal(t, state, query.Get("state"))
+	require.Equal(t, nonce, query.Get("nonce"))
+	require.Equal(t, "S256", query.Get("code_challenge_method"))
+	require.Equal(t, oauth2.S256ChallengeFromVerifier(codeVerifier), query.Get("code_challenge"))
+	require.NotContains(t, authURL, codeVerifier)
+}
+
+func TestOIDCProvider_Exchange(t *testing.T) {
+	type args struct {
+		nonce        string
+		codeVerifier string
+		code         func(code string) string
+		clientSecret string
+	}
+
+	type expect struct {
+		err      error
+		identity *models.ExternalIdentity
+	}
+
+	identity := oauthtest.Identity{
+		Subject:       "110169484474386276334",
+		Email:         "Test1@Gmail.com",
+		EmailVerified: true,
+		GivenName:     "Ivan",
+		FamilyName:    "Petrov",
+	}
+
+	sameCode := func(code string) string { return code }
+
+	tests := []struct {
+		name   string
+		args   args
+		expect expect
+	}{
+		{
+			name: "success case",
+			args: args{
+				nonce:        nonce,
+				codeVerifier: codeVerifier,
+				code:         sameCode,
+				clientSecret: clientSecret,
+			},
+			expect: expect{
+				identity: &models.ExternalIdentity{
+					Provider:      adapters.ProviderGoogle,
+					Subject:       identity.Subject,
+					Email:         identity.Email,
+					EmailVerified: true,
+					FirstName:     identity.GivenName,
+					LastName:      identity.FamilyName,
+				},
+			},
+		},
+		{
+			name: "nonce mismatch case",
+			args: args{
+				nonce:        "other nonce",
+				codeVerifier: codeVerifier,
+				code:         sameCode,
+				clientSecret: clientSecret,
+			},
+			expect: expect{
+				err: domainErrors.ErrIdentityRejected,
+			},
+		},
+		{
+			name: "code verifier mismatch case",
+			args: args{
+				nonce:        nonce,
+				codeVerifier: "other-verifier-other-verifier-other-verifier",
+				code:         sameCode,
+				clientSecret: clientSecret,
+			},
+			expect: expect{
+				err: domainErrors.ErrIdentityRejected,
+			},
+		},
+		{
+			name: "unknown code case",
+			args: args{
+				nonce:        nonce,
+				codeVerifier: codeVerifier,
+				code:         func(string) string { return "unknown" },
+				clientSecret: clientSecret,
+			},
+			expect: expect{
+				err: domainErrors.ErrIdentityRejected,
+			},
+		},
+		{
+			name: "client secret wrong case",
+			args: args{
+				nonce:        nonce,
+				codeVerifier: codeVerifier,
+				code:         sameCode,
+				clientSecret: "wrong",
+			},
+			expect: expect{
+				err: domainErrors.ErrIdentityRejected,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := oauthtest.NewServer(clientID, clientSecret)
+			defer server.Close()
+
+			provider := adapters.NewOIDCProvider(adapters.ProviderGoogle, server.URL, oauth2.Config{
+				ClientID:     clientID,
+				ClientSecret: tt.args.clientSecret,
+				RedirectURL:  redirectURL,
+			}, http.DefaultClient)
+
+			ctx := context.Background()
+
+			authURL, err := provider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+			require.NoError(t, err)
+
+			code, _ := server.Authorize(authURL, identity)
+
+			external, err := provider.Exchange(ctx, tt.args.code(code), tt.args.nonce, tt.args.codeVerifier)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+				require.Nil(t, external)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expect.identity, external)
+			}
+		})
+	}
+}
+
+func TestOIDCProvider_Unreachable(t *testing.T) {
+	server := oauthtest.NewServer(clientID, clientSecret)
+	issuer := server.URL
+	server.Close()
+
+	provider := adapters.NewOIDCProvider(adapters.ProviderGoogle, issuer, oauth2.Config{
+		ClientID: clientID,
+	}, http.DefaultClient)
+
+	_, err := provider.AuthCodeURL(context.Background(), state, nonce, codeVerifier)
+	require.ErrorContains(t, err, adapters.ErrDiscovery)
+	require.NotErrorIs(t, err, domainErrors.ErrIdentityRejected)
+
+	_, err = provider.Exchange(context.Background(), "code", nonce, codeVerifier)
+	require.ErrorContains(t, err, adapters.ErrDiscovery)
+	require.NotErrorIs(t, err, domainErrors.ErrIdentityRejected)
+}
@@ -0,0 +1,58 @@
+package adapters
+
+import (
+	"context"
+	"sync"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+)
+
+type Message struct {
+	Phone string
+	Text  string
+}
+
+// MemorySender logs every message instead of delivering it and keeps it in
+// memory. It is meant for local work, where the code is read from the log,
+// and for tests.
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []Message
+	logger   logger.Logger
+}
+
+func NewMemorySender(logger logger.Logger) *MemorySender {
+	loggerTag := "adapters.sms.newMemorySender"
+
+	logger.Warn(loggerTag, "SMS messages are logged, not delivered")
+
+	return &MemorySender{
+		logger: logger,
+	}
+}
+
+var _ domain.SmsSender = (*MemorySender)(nil)
+
+func (s *MemorySender) Send(ctx context.Context, phone, message string) error {
+	loggerTag := "adapters.sms.send"
+
+	s.mu.Lock()
+	s.messages = append(s.messages, Message{phone, message})
+	s.mu.Unlock()
+
+	s.logger.InfoCtx(ctx, loggerTag, message, logger.Field{
+		Key:   "phone",
+		Value: phone,
+	})
+
+	return nil
+}
+
+// Messages returns the messages sent so far, oldest first.
+func (s *MemorySender) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Message(nil), s.messages...)
+}
@@ -0,0 +1,21 @@
+package adapters
+
+import (
+	"fmt"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+)
+
+const SenderLog = "log"
+
+// NewSender returns the sender selected by the sms_sender setting.
+func NewSender(logger logger.Logger, cfg *configs.Config) (domain.SmsSender, error) {
+	switch cfg.SmsSender {
+	case SenderLog:
+		return NewMemorySender(logger), nil
+	default:
+		return nil, fmt.Errorf("%s: %q", ErrUnknownSender, cfg.SmsSender)
+	}
+}
@@ -0,0 +1,6 @@
+package adapters
+
+const (
+	ErrUnknownStorage = "unknown blob storage"
+	ErrInvalidKey     = "invalid blob key"
+)
@@ -0,0 +1,92 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+)
+
+// LocalStorage keeps files in a directory, under paths that mirror their
+// keys.
+type LocalStorage struct {
+	dir     string
+	baseURL string
+	logger  logger.Logger
+}
+
+func NewLocalStorage(logger logger.Logger, cfg *configs.Config) (domain.BlobStorage, error) {
+	loggerTag := "adapters.storage.newLocalStorage"
+
+	if err := os.MkdirAll(cfg.BlobLocalDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed create blob directory: %v", err)
+	}
+
+	logger.Info(loggerTag, "Local blob storage initialized")
+
+	return &LocalStorage{
+		cfg.BlobLocalDir,
+		cfg.BlobPublicURL,
+		logger,
+	}, nil
+}
+
+func (s *LocalStorage) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("%s: %q", ErrInvalidKey, key)
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+// Put writes the file next to its final path first, so readers never see a
+// partly written file.
+func (s *LocalStorage) Put(ctx context.Context, key, contentType string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+
+		return err
+	}
+
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	if err = os.Chmod(tmp.Name(), 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *LocalStorage) DeletePrefix(ctx context.Context, prefix string) error {
+	path, err := s.path(prefix)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(path)
+}
+
+func (s *LocalStorage) URL(key string) string {
+	return publicURL(s.baseURL, key)
+}
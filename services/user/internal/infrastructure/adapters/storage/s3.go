@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// immutableCacheControl is set on every object: a key is never reused for
+// different content, so clients and CDNs may cache files forever.
+const immutableCacheControl = "public, max-age=31536000, immutable"
+
+// S3Storage keeps files in a bucket of any S3-compatible service, such as
+// AWS S3, MinIO or Cloudflare R2.
+type S3Storage struct {
+	client  *minio.Client
+	bucket  string
+	baseURL string
+	logger  logger.Logger
+}
+
+func NewS3Storage(logger logger.Logger, cfg *configs.Config) (domain.BlobStorage, error) {
+	loggerTag := "adapters.storage.newS3Storage"
+
+	if cfg.BlobS3Endpoint == "" || cfg.BlobS3Bucket == "" {
+		return nil, errors.New("blob_s3_endpoint and blob_s3_bucket are required for the s3 blob storage")
+	}
+
+	client, err := minio.New(cfg.BlobS3Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.BlobS3AccessKeyID, cfg.BlobS3SecretAccessKey, ""),
+		Secure: cfg.BlobS3UseSSL,
+		Region: cfg.BlobS3Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed create s3 client: %v", err)
+	}
+
+	logger.Info(loggerTag, "S3 blob storage initialized")
+
+	return &S3Storage{
+		client,
+		cfg.BlobS3Bucket,
+		cfg.BlobPublicURL,
+		logger,
+	}, nil
+}
+
+func (s *S3Storage) Put(ctx context.Context, key, contentType string, data []byte) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType:  contentType,
+		CacheControl: immutableCacheControl,
+	})
+
+	return err
+}
+
+func (s *S3Storage) DeletePrefix(ctx context.Context, prefix string) error {
+	if prefix == "" {
+		return fmt.Errorf("%s: %q", ErrInvalidKey, prefix)
+	}
+
+	objects := make(chan minio.ObjectInfo)
+	listed := make(chan error, 1)
+
+	listCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		defer close(objects)
+
+		for object := range s.client.ListObjects(listCtx, s.bucket, minio.ListObjectsOptions{
+			Prefix:    prefix + "/",
+			Recursive: true,
+		}) {
+			if object.Err != nil {
+				listed <- object.Err
+
+				return
+			}
+
+			select {
+			case objects <- object:
+			case <-listCtx.Done():
+				listed <- listCtx.Err()
+
+				return
+			}
+		}
+
+		listed <- nil
+	}()
+
+	var errs []error
+	for result := range s.client.RemoveObjects(ctx, s.bucket, objects, minio.RemoveObjectsOptions{}) {
+		errs = append(errs, fmt.Errorf("%s: %v", result.ObjectName, result.Err))
+	}
+
+	cancel()
+	if err := <-listed; err != nil && !errors.Is(err, context.Canceled) {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *S3Storage) URL(key string) string {
+	return publicURL(s.baseURL, key)
+}
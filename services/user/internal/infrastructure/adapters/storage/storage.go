@@ -0,0 +1,31 @@
+package adapters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+)
+
+const (
+	StorageLocal = "local"
+	StorageS3    = "s3"
+)
+
+// NewStorage returns the storage selected by the blob_storage setting.
+func NewStorage(logger logger.Logger, cfg *configs.Config) (domain.BlobStorage, error) {
+	switch cfg.BlobStorage {
+	case StorageLocal:
+		return NewLocalStorage(logger, cfg)
+	case StorageS3:
+		return NewS3Storage(logger, cfg)
+	default:
+		return nil, fmt.Errorf("%s: %q", ErrUnknownStorage, cfg.BlobStorage)
+	}
+}
+
+func publicURL(baseURL, key string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/" + key
+}
@@ -0,0 +1,267 @@
+package adapters
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+)
+
+// Passkeys require user verification: a passkey is the only factor of the
+// login, so the authenticator has to check it is its owner using it.
+type Passkeys struct {
+	webAuthn *webauthn.WebAuthn
+	logger   logger.Logger
+}
+
+func NewPasskeys(logger logger.Logger, cfg *configs.Config) (domain.Passkeys, error) {
+	loggerTag := "adapters.webauthn.newPasskeys"
+
+	timeout := webauthn.TimeoutConfig{
+		Enforce:    true,
+		Timeout:    cfg.PasskeyChallengeExpiresIn,
+		TimeoutUVD: cfg.PasskeyChallengeExpiresIn,
+	}
+
+	webAuthn, err := webauthn.New(&webauthn.Config{
+		RPID:                  cfg.WebAuthnRPID,
+		RPDisplayName:         cfg.WebAuthnRPName,
+		RPOrigins:             cfg.WebAuthnRPOrigins,
+		AttestationPreference: protocol.PreferNoAttestation,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			RequireResidentKey: protocol.ResidentKeyRequired(),
+			ResidentKey:        protocol.ResidentKeyRequirementRequired,
+			UserVerification:   protocol.VerificationRequired,
+		},
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        timeout,
+			Registration: timeout,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(loggerTag, fmt.Sprintf("Passkeys enabled for %s", cfg.WebAuthnRPID))
+
+	return &Passkeys{
+		webAuthn,
+		logger,
+	}, nil
+}
+
+// rejected marks err as the response failing to verify, as opposed to the
+// ceremony not being possible to run at all.
+func rejected(err error) error {
+	var protocolErr *protocol.Error
+	if errors.As(err, &protocolErr) && protocolErr.DevInfo != "" {
+		return fmt.Errorf("%w: %s: %s", domainErrors.ErrPasskeyInvalid, protocolErr.Details, protocolErr.DevInfo)
+	}
+
+	return fmt.Errorf("%w: %v", domainErrors.ErrPasskeyInvalid, err)
+}
+
+func ceremony(options any, session *webauthn.SessionData) (*domain.PasskeyCeremony, error) {
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionJSON, err := json.Marshal(session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PasskeyCeremony{
+		Options: optionsJSON,
+		Session: sessionJSON,
+	}, nil
+}
+
+func parseSession(session []byte) (webauthn.SessionData, error) {
+	var data webauthn.SessionData
+	if err := json.Unmarshal(session, &data); err != nil {
+		return data, rejected(err)
+	}
+
+	return data, nil
+}
+
+func (p *Passkeys) BeginRegistration(user *models.User, existing []*models.Passkey) (*domain.PasskeyCeremony, error) {
+	owner := newUser(user.ID, user, existing)
+
+	options, session, err := p.webAuthn.BeginRegistration(owner,
+		webauthn.WithExclusions(webauthn.Credentials(owner.credentials).CredentialDescriptors()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return ceremony(options, session)
+}
+
+func (p *Passkeys) FinishRegistration(user *models.User, session, response []byte) (*models.Passkey, error) {
+	data, err := parseSession(session)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return nil, rejected(err)
+	}
+
+	credential, err := p.webAuthn.CreateCredential(newUser(user.ID, user, nil), data, parsed)
+	if err != nil {
+		return nil, rejected(err)
+	}
+
+	transports := make([]string, 0, len(credential.Transport))
+	for _, transport := range credential.Transport {
+		transports = append(transports, string(transport))
+	}
+
+	// Authenticators that do not tell their model send an all-zero AAGUID,
+	// and some send none at all.
+	aaguid, err := uuid.FromBytes(credential.Authenticator.AAGUID)
+	if err != nil {
+		aaguid = uuid.Nil
+	}
+
+	return &models.Passkey{
+		UserID:          user.ID,
+		CredentialID:    credential.ID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		Transports:      transports,
+		AAGUID:          aaguid,
+		SignCount:       credential.Authenticator.SignCount,
+		BackupEligible:  credential.Flags.BackupEligible,
+		BackupState:     credential.Flags.BackupState,
+	}, nil
+}
+
+func (p *Passkeys) BeginLogin() (*domain.PasskeyCeremony, error) {
+	options, session, err := p.webAuthn.BeginDiscoverableLogin()
+	if err != nil {
+		return nil, err
+	}
+
+	return ceremony(options, session)
+}
+
+func (p *Passkeys) FinishLogin(session, response []byte, find func(credentialID []byte) (*models.Passkey, error)) (*models.Passkey, error) {
+	data, err := parseSession(session)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return nil, rejected(err)
+	}
+
+	var (
+		passkey *models.Passkey
+		findErr error
+	)
+
+	_, credential, err := p.webAuthn.ValidatePasskeyLogin(func(rawID, _ []byte) (webauthn.User, error) {
+		passkey, findErr = find(rawID)
+		if findErr != nil {
+			return nil, findErr
+		}
+
+		// The library matches the user handle of the response against the
+		// owner of the passkey.
+		return newUser(passkey.UserID, nil, []*models.Passkey{passkey}), nil
+	}, data, parsed)
+	if findErr != nil {
+		return nil, findErr
+	}
+	if err != nil {
+		return nil, rejected(err)
+	}
+
+	// A sign count that did not go up means the key was copied off its
+	// authenticator. Synced passkeys always send zero, which is not a sign
+	// of anything.
+	if credential.Authenticator.CloneWarning {
+		return nil, rejected(errors.New("sign count did not increase"))
+	}
+
+	used := *passkey
+	used.SignCount = credential.Authenticator.SignCount
+	used.BackupState = credential.Flags.BackupState
+
+	return &used, nil
+}
+
+// user presents a user and their passkeys to the library. The user handle
+// of a passkey is the user ID. model is only needed to register a passkey,
+// where its names are shown by the authenticator.
+type user struct {
+	id          uuid.UUID
+	model       *models.User
+	credentials []webauthn.Credential
+}
+
+func newUser(id uuid.UUID, model *models.User, passkeys []*models.Passkey) *user {
+	credentials := make([]webauthn.Credential, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		transports := make([]protocol.AuthenticatorTransport, 0, len(passkey.Transports))
+		for _, transport := range passkey.Transports {
+			transports = append(transports, protocol.AuthenticatorTransport(transport))
+		}
+
+		credentials = append(credentials, webauthn.Credential{
+			ID:              passkey.CredentialID,
+			PublicKey:       passkey.PublicKey,
+			AttestationType: passkey.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: passkey.BackupEligible,
+				BackupState:    passkey.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    passkey.AAGUID[:],
+				SignCount: passkey.SignCount,
+			},
+		})
+	}
+
+	return &user{id, model, credentials}
+}
+
+func (u *user) WebAuthnID() []byte {
+	return u.id[:]
+}
+
+func (u *user) WebAuthnName() string {
+	if u.model == nil {
+		return ""
+	}
+
+	return u.model.Email
+}
+
+func (u *user) WebAuthnDisplayName() string {
+	if u.model == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(u.model.FirstName + " " + u.model.LastName)
+}
+
+func (u *user) WebAuthnCredentials() []webauthn.Credential {
+	return u.credentials
+}
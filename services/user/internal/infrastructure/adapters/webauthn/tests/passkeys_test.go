@@ -0,0 +1,319 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/logger/observer"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+	adapters "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/webauthn"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/webauthn/webauthntest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+const origin = "https://store.example"
+
+func newPasskeys(t *testing.T) domain.Passkeys {
+	t.Helper()
+
+	passkeys, err := adapters.NewPasskeys(observer.New(logger.LevelError), &configs.Config{
+		WebAuthnRPID:              "store.example",
+		WebAuthnRPName:            "Online Store",
+		WebAuthnRPOrigins:         []string{origin},
+		PasskeyChallengeExpiresIn: 5 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	return passkeys
+}
+
+func register(t *testing.T, passkeys domain.Passkeys, authenticator *webauthntest.Authenticator, user *models.User, existing []*models.Passkey) *models.Passkey {
+	t.Helper()
+
+	ceremony, err := passkeys.BeginRegistration(user, existing)
+	require.NoError(t, err)
+
+	response, err := authenticator.Register(ceremony.Options)
+	require.NoError(t, err)
+
+	passkey, err := passkeys.FinishRegistration(user, ceremony.Session, response)
+	require.NoError(t, err)
+
+	return passkey
+}
+
+func TestPasskeys_Registration(t *testing.T) {
+	t.Parallel()
+
+	passkeys := newPasskeys(t)
+	authenticator := webauthntest.NewAuthenticator(origin)
+	user := &models.User{
+		ID:        uuid.New(),
+		Email:     "test1@test.ru",
+		FirstName: "Ivan",
+		LastName:  "Ivanov",
+	}
+
+	passkey := register(t, passkeys, authenticator, user, nil)
+
+	require.Equal(t, user.ID, passkey.UserID)
+	require.NotEmpty(t, passkey.CredentialID)
+	require.NotEmpty(t, passkey.PublicKey)
+	require.Equal(t, "none", passkey.AttestationType)
+	require.Equal(t, []string{"internal"}, passkey.Transports)
+	require.Equal(t, webauthntest.AAGUID, passkey.AAGUID)
+	require.Zero(t, passkey.SignCount)
+	require.False(t, passkey.BackupEligible)
+
+	// The authenticator refuses to make a second passkey for the same
+	// account.
+	ceremony, err := passkeys.BeginRegistration(user, []*models.Passkey{passkey})
+	require.NoError(t, err)
+
+	_, err = authenticator.Register(ceremony.Options)
+	require.Error(t, err)
+}
+
+func TestPasskeys_RegistrationRejected(t *testing.T) {
+	type args struct {
+		authenticator func() *webauthntest.Authenticator
+		response      func(response []byte) []byte
+		session       func(session []byte) []byte
+	}
+
+	keep := func(b []byte) []byte { return b }
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "wrong origin case",
+			args: args{
+				authenticator: func() *webauthntest.Authenticator {
+					return webauthntest.NewAuthenticator("https://evil.example")
+				},
+				response: keep,
+				session:  keep,
+			},
+		},
+		{
+			name: "user not verified case",
+			args: args{
+				authenticator: func() *webauthntest.Authenticator {
+					authenticator := webauthntest.NewAuthenticator(origin)
+					authenticator.SkipUserVerification = true
+
+					return authenticator
+				},
+				response: keep,
+				session:  keep,
+			},
+		},
+		{
+			name: "malformed response case",
+			args: args{
+				authenticator: func() *webauthntest.Authenticator {
+					return webauthntest.NewAuthenticator(origin)
+				},
+				response: func([]byte) []byte { return []byte("{}") },
+				session:  keep,
+			},
+		},
+		{
+			name: "malformed session case",
+			args: args{
+				authenticator: func() *webauthntest.Authenticator {
+					return webauthntest.NewAuthenticator(origin)
+				},
+				response: keep,
+				session:  func([]byte) []byte { return []byte("session") },
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			passkeys := newPasskeys(t)
+			user := &models.User{ID: uuid.New(), Email: "test1@test.ru"}
+
+			ceremony, err := passkeys.BeginRegistration(user, nil)
+			require.NoError(t, err)
+
+			response, err := tt.args.authenticator().Register(ceremony.Options)
+			require.NoError(t, err)
+
+			passkey, err := passkeys.FinishRegistration(user, tt.args.session(ceremony.Session), tt.args.response(response))
+			require.ErrorIs(t, err, domainErrors.ErrPasskeyInvalid)
+			require.Nil(t, passkey)
+		})
+	}
+}
+
+func TestPasskeys_Login(t *testing.T) {
+	type args struct {
+		synced bool
+		// stored changes the passkey as the database has it.
+		stored func(t *testing.T, passkey *models.Passkey)
+		find   func(passkey *models.Passkey) (*models.Passkey, error)
+	}
+
+	type expect struct {
+		err       error
+		signCount uint32
+	}
+
+	errDatabase := errors.New("connection refused")
+
+	tests := []struct {
+		name   string
+		args   args
+		expect expect
+	}{
+		{
+			name: "success case",
+			args: args{
+				stored: func(*testing.T, *models.Passkey) {},
+			},
+			expect: expect{
+				signCount: 1,
+			},
+		},
+		{
+			name: "synced passkey case",
+			args: args{
+				synced: true,
+				stored: func(*testing.T, *models.Passkey) {},
+			},
+			expect: expect{
+				signCount: 0,
+			},
+		},
+		{
+			name: "cloned passkey case",
+			args: args{
+				stored: func(_ *testing.T, passkey *models.Passkey) {
+					passkey.SignCount = 5
+				},
+			},
+			expect: expect{
+				err: domainErrors.ErrPasskeyInvalid,
+			},
+		},
+		{
+			name: "other user case",
+			args: args{
+				stored: func(_ *testing.T, passkey *models.Passkey) {
+					passkey.UserID = uuid.New()
+				},
+			},
+			expect: expect{
+				err: domainErrors.ErrPasskeyInvalid,
+			},
+		},
+		{
+			name: "wrong public key case",
+			args: args{
+				stored: func(t *testing.T, passkey *models.Passkey) {
+					other := register(t, newPasskeys(t), webauthntest.NewAuthenticator(origin), &models.User{ID: passkey.UserID}, nil)
+					passkey.PublicKey = other.PublicKey
+				},
+			},
+			expect: expect{
+				err: domainErrors.ErrPasskeyInvalid,
+			},
+		},
+		{
+			name: "find failed case",
+			args: args{
+				stored: func(*testing.T, *models.Passkey) {},
+				find: func(*models.Passkey) (*models.Passkey, error) {
+					return nil, errDatabase
+				},
+			},
+			expect: expect{
+				err: errDatabase,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			passkeys := newPasskeys(t)
+			authenticator := webauthntest.NewAuthenticator(origin)
+			authenticator.Synced = tt.args.synced
+			user := &models.User{ID: uuid.New(), Email: "test1@test.ru"}
+
+			stored := register(t, passkeys, authenticator, user, nil)
+			stored.ID = uuid.New()
+			tt.args.stored(t, stored)
+
+			ceremony, err := passkeys.BeginLogin()
+			require.NoError(t, err)
+
+			response, err := authenticator.Login(ceremony.Options)
+			require.NoError(t, err)
+
+			find := func(credentialID []byte) (*models.Passkey, error) {
+				require.True(t, bytes.Equal(stored.CredentialID, credentialID))
+
+				if tt.args.find != nil {
+					return tt.args.find(stored)
+				}
+
+				return stored, nil
+			}
+
+			passkey, err := passkeys.FinishLogin(ceremony.Session, response, find)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+				require.Nil(t, passkey)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, stored.ID, passkey.ID)
+			require.Equal(t, tt.expect.signCount, passkey.SignCount)
+			require.Equal(t, tt.args.synced, passkey.BackupState)
+		})
+	}
+}
+
+func TestPasskeys_LoginReplayed(t *testing.T) {
+	t.Parallel()
+
+	passkeys := newPasskeys(t)
+	authenticator := webauthntest.NewAuthenticator(origin)
+	user := &models.User{ID: uuid.New(), Email: "test1@test.ru"}
+	stored := register(t, passkeys, authenticator, user, nil)
+
+	first, err := passkeys.BeginLogin()
+	require.NoError(t, err)
+
+	second, err := passkeys.BeginLogin()
+	require.NoError(t, err)
+
+	response, err := authenticator.Login(first.Options)
+	require.NoError(t, err)
+
+	// The response signs the challenge of its own ceremony only.
+	_, err = passkeys.FinishLogin(second.Session, response, func([]byte) (*models.Passkey, error) {
+		return stored, nil
+	})
+	require.ErrorIs(t, err, domainErrors.ErrPasskeyInvalid)
+}
@@ -0,0 +1,276 @@
+// Package webauthntest is a software authenticator for tests. It answers the
+// options of navigator.credentials.create() and get() the way a browser and
+// a platform authenticator would, with "none" attestation and ES256 keys.
+package webauthntest
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/google/uuid"
+)
+
+// AAGUID identifies the model of this authenticator.
+var AAGUID = uuid.MustParse("6e7f4a1c-2b3d-4c5e-8f90-a1b2c3d4e5f6")
+
+var ErrNoCredential = errors.New("webauthntest: no credential for the relying party")
+
+type credential struct {
+	id         []byte
+	rpID       string
+	userHandle []byte
+	key        *ecdsa.PrivateKey
+	signCount  uint32
+}
+
+type Authenticator struct {
+	// Origin is what the browser reports the page runs on.
+	Origin string
+	// Synced makes new passkeys backup eligible and backed up, like the
+	// passkeys of a password manager. Their sign count stays zero.
+	Synced bool
+	// SkipUserVerification answers as an authenticator without a PIN or
+	// biometrics would.
+	SkipUserVerification bool
+
+	mu          sync.Mutex
+	credentials []*credential
+}
+
+func NewAuthenticator(origin string) *Authenticator {
+	return &Authenticator{
+		Origin: origin,
+	}
+}
+
+// Register answers the options of navigator.credentials.create() with a new
+// passkey, and returns the response as the browser would encode it.
+func (a *Authenticator) Register(options []byte) ([]byte, error) {
+	var creation protocol.CredentialCreation
+	if err := json.Unmarshal(options, &creation); err != nil {
+		return nil, err
+	}
+
+	opts := creation.Response
+
+	for _, excluded := range opts.CredentialExcludeList {
+		if a.find(opts.RelyingParty.ID, excluded.CredentialID) != nil {
+			return nil, errors.New("webauthntest: credential excluded")
+		}
+	}
+
+	userHandle, err := userID(opts.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	cred := &credential{
+		id:         randomBytes(32),
+		rpID:       opts.RelyingParty.ID,
+		userHandle: userHandle,
+		key:        key,
+	}
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  1, // P-256
+		XCoord: key.X.FillBytes(make([]byte, 32)),
+		YCoord: key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var attested bytes.Buffer
+	attested.Write(AAGUID[:])
+	_ = binary.Write(&attested, binary.BigEndian, uint16(len(cred.id)))
+	attested.Write(cred.id)
+	attested.Write(publicKey)
+
+	authData := a.authData(cred, protocol.FlagAttestedCredentialData, attested.Bytes())
+
+	attestationObject, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clientData, err := a.clientData(protocol.CreateCeremony, opts.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	a.credentials = append(a.credentials, cred)
+	a.mu.Unlock()
+
+	return json.Marshal(protocol.CredentialCreationResponse{
+		PublicKeyCredential: publicKeyCredential(cred),
+		AttestationResponse: protocol.AuthenticatorAttestationResponse{
+			AuthenticatorResponse: protocol.AuthenticatorResponse{
+				ClientDataJSON: clientData,
+			},
+			Transports:        []string{string(protocol.Internal)},
+			AttestationObject: attestationObject,
+		},
+	})
+}
+
+// Login answers the options of navigator.credentials.get() with the newest
+// passkey of the relying party, and returns the response as the browser
+// would encode it.
+func (a *Authenticator) Login(options []byte) ([]byte, error) {
+	var assertion protocol.CredentialAssertion
+	if err := json.Unmarshal(options, &assertion); err != nil {
+		return nil, err
+	}
+
+	opts := assertion.Response
+
+	cred := a.newest(opts.RelyingPartyID)
+	if cred == nil {
+		return nil, ErrNoCredential
+	}
+
+	a.mu.Lock()
+	if !a.Synced {
+		cred.signCount++
+	}
+	a.mu.Unlock()
+
+	authData := a.authData(cred, 0, nil)
+
+	clientData, err := a.clientData(protocol.AssertCeremony, opts.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+
+	signature, err := ecdsa.SignASN1(rand.Reader, cred.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(protocol.CredentialAssertionResponse{
+		PublicKeyCredential: publicKeyCredential(cred),
+		AssertionResponse: protocol.AuthenticatorAssertionResponse{
+			AuthenticatorResponse: protocol.AuthenticatorResponse{
+				ClientDataJSON: clientData,
+			},
+			AuthenticatorData: authData,
+			Signature:         signature,
+			UserHandle:        cred.userHandle,
+		},
+	})
+}
+
+func (a *Authenticator) authData(cred *credential, flags protocol.AuthenticatorFlags, attested []byte) []byte {
+	flags |= protocol.FlagUserPresent
+	if !a.SkipUserVerification {
+		flags |= protocol.FlagUserVerified
+	}
+	if a.Synced {
+		flags |= protocol.FlagBackupEligible | protocol.FlagBackupState
+	}
+
+	rpIDHash := sha256.Sum256([]byte(cred.rpID))
+
+	var data bytes.Buffer
+	data.Write(rpIDHash[:])
+	data.WriteByte(byte(flags))
+	_ = binary.Write(&data, binary.BigEndian, cred.signCount)
+	data.Write(attested)
+
+	return data.Bytes()
+}
+
+func (a *Authenticator) clientData(ceremony protocol.CeremonyType, challenge protocol.URLEncodedBase64) ([]byte, error) {
+	return json.Marshal(protocol.CollectedClientData{
+		Type:      ceremony,
+		Challenge: challenge.String(),
+		Origin:    a.Origin,
+	})
+}
+
+func (a *Authenticator) find(rpID string, id []byte) *credential {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, cred := range a.credentials {
+		if cred.rpID == rpID && bytes.Equal(cred.id, id) {
+			return cred
+		}
+	}
+
+	return nil
+}
+
+func (a *Authenticator) newest(rpID string) *credential {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for i := len(a.credentials) - 1; i >= 0; i-- {
+		if a.credentials[i].rpID == rpID {
+			return a.credentials[i]
+		}
+	}
+
+	return nil
+}
+
+func publicKeyCredential(cred *credential) protocol.PublicKeyCredential {
+	return protocol.PublicKeyCredential{
+		Credential: protocol.Credential{
+			ID:   protocol.URLEncodedBase64(cred.id).String(),
+			Type: string(protocol.PublicKeyCredentialType),
+		},
+		RawID:                   cred.id,
+		AuthenticatorAttachment: string(protocol.Platform),
+	}
+}
+
+// userID decodes the user handle, which the options carry base64url
+// encoded.
+func userID(id any) ([]byte, error) {
+	encoded, ok := id.(string)
+	if !ok {
+		return nil, errors.New("webauthntest: user id is not a string")
+	}
+
+	var handle protocol.URLEncodedBase64
+	if err := handle.UnmarshalJSON([]byte(`"` + encoded + `"`)); err != nil {
+		return nil, err
+	}
+
+	return handle, nil
+}
+
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	rand.Read(b)
+
+	return b
+}
@@ -0,0 +1,97 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+)
+
+type logLevels struct {
+	Level     string            `json:"level"`
+	TagLevels map[string]string `json:"tag_levels"`
+}
+
+func currentLogLevels(levels logger.LevelController) logLevels {
+	tagLevels := make(map[string]string)
+	for pattern, level := range levels.TagLevels() {
+		tagLevels[pattern] = level.String()
+	}
+
+	return logLevels{
+		Level:     levels.Level().String(),
+		TagLevels: tagLevels,
+	}
+}
+
+func getLogLevel(levels logger.LevelController) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, currentLogLevels(levels))
+	}
+}
+
+// putLogLevel changes the base level and, when tag_levels is present,
+// replaces all per-tag overrides. The request is rejected as a whole if any
+// level is unknown.
+func putLogLevel(levels logger.LevelController, log logger.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		loggerTag := "admin.server.putLogLevel"
+
+		var req logLevels
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		var level *logger.Level
+		if req.Level != "" {
+			parsed, err := logger.ParseLevel(req.Level)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+
+				return
+			}
+			level = &parsed
+		}
+
+		var tagLevels map[string]logger.Level
+		if req.TagLevels != nil {
+			tagLevels = make(map[string]logger.Level, len(req.TagLevels))
+			for pattern, rawLevel := range req.TagLevels {
+				parsed, err := logger.ParseLevel(rawLevel)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+
+					return
+				}
+				tagLevels[pattern] = parsed
+			}
+		}
+
+		if level != nil {
+			levels.SetLevel(*level)
+		}
+		if tagLevels != nil {
+			levels.SetTagLevels(tagLevels)
+		}
+
+		current := currentLogLevels(levels)
+
+		log.Info(loggerTag, "Log levels changed", logger.Field{
+			Key:   "level",
+			Value: current.Level,
+		}, logger.Field{
+			Key:   "tag_levels",
+			Value: current.TagLevels,
+		})
+
+		writeJSON(w, http.StatusOK, current)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
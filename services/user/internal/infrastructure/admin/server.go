@@ -0,0 +1,82 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/health"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/metrics"
+)
+
+type Server struct {
+	httpServer *http.Server
+	logger     logger.Logger
+	cfg        *configs.Config
+}
+
+func NewServer(monitor *health.Monitor, metrics *metrics.Metrics, logger logger.Logger, cfg *configs.Config) (domain.Server, error) {
+	loggerTag := "admin.server.newServer"
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	mux.HandleFunc("GET /readyz", func(w http.ResponseWriter, _ *http.Request) {
+		if !monitor.Ready() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("not ready"))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	mux.Handle("GET "+cfg.MetricsPath, metrics.Handler())
+
+	mux.HandleFunc("GET /config", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, cfg.Redacted())
+	})
+
+	mux.HandleFunc("GET /loglevel", getLogLevel(logger.Levels()))
+	mux.HandleFunc("PUT /loglevel", putLogLevel(logger.Levels(), logger))
+
+	logger.Info(loggerTag, "Admin server initialized")
+
+	return &Server{
+		&http.Server{
+			Addr:    fmt.Sprintf(":%d", cfg.AdminPort),
+			Handler: mux,
+		},
+		logger,
+		cfg,
+	}, nil
+}
+
+func (s *Server) Run() error {
+	loggerTag := "admin.server.run"
+
+	s.logger.Info(loggerTag, "The admin server is running", logger.Field{
+		Key:   "port",
+		Value: s.cfg.AdminPort,
+	})
+
+	if err := s.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed serve admin: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
+}
@@ -0,0 +1,163 @@
+// Package authn resolves the credentials of a request, an access token or
+// an API key, to the claims later interceptors and the services read.
+package authn
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	tokenPrefix  = "Bearer "
+	apiKeyPrefix = "ApiKey "
+)
+
+// APIKeyAuthenticator is the part of the auth service the interceptors
+// check API keys with.
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*models.Claims, error)
+}
+
+// UnaryServerInterceptor stores the claims of the request in its context.
+// Missing or invalid access tokens are left to the handlers, which reject
+// them as before; an API key that does not authenticate is rejected here,
+// since no handler looks at it again.
+func UnaryServerInterceptor(authenticator APIKeyAuthenticator, cfg *configs.Config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, authenticator, cfg.AccessTokenPublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming RPCs, such
+// as the avatar upload.
+func StreamServerInterceptor(authenticator APIKeyAuthenticator, cfg *configs.Config) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), authenticator, cfg.AccessTokenPublicKey)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{ss, ctx})
+	}
+}
+
+// serverStream hands the context with the claims to the handler.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func authenticate(ctx context.Context, authenticator APIKeyAuthenticator, publicKey string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	authHeader := md.Get("authorization")
+	if len(authHeader) == 0 {
+		return ctx, nil
+	}
+
+	switch {
+	case strings.HasPrefix(authHeader[0], tokenPrefix):
+		claims, err := jwt.Verify(strings.TrimPrefix(authHeader[0], tokenPrefix), publicKey)
+		if err != nil {
+			return ctx, nil
+		}
+
+		return withClaims(ctx, tokenClaims(claims)), nil
+	case strings.HasPrefix(authHeader[0], apiKeyPrefix):
+		claims, err := authenticator.AuthenticateAPIKey(ctx, strings.TrimPrefix(authHeader[0], apiKeyPrefix))
+		if err != nil {
+			switch {
+			case errors.Is(err, domainErrors.ErrAPIKeyInvalid):
+				return nil, status.Error(codes.Unauthenticated, err.Error())
+			case errors.Is(err, domainErrors.ErrUserBlocked):
+				return nil, status.Error(codes.PermissionDenied, err.Error())
+			default:
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+
+		return withClaims(ctx, claims), nil
+	default:
+		return ctx, nil
+	}
+}
+
+// withClaims stores the claims in ctx together with the log fields naming
+// who makes the request, so every later log line of the call carries them.
+func withClaims(ctx context.Context, claims *models.Claims) context.Context {
+	fields := []logger.Field{
+		{
+			Key:   "user_id",
+			Value: claims.Subject,
+		},
+	}
+
+	if claims.Impersonated() {
+		fields = append(fields, logger.Field{
+			Key:   "actor_id",
+			Value: claims.ActorID,
+		})
+	}
+
+	if claims.APIKeyID != "" {
+		fields = append(fields, logger.Field{
+			Key:   "api_key_id",
+			Value: claims.APIKeyID,
+		})
+	}
+
+	return models.ContextWithClaims(logger.ContextWithFields(ctx, fields...), claims)
+}
+
+func tokenClaims(claims map[string]any) *models.Claims {
+	result := &models.Claims{}
+	result.Subject, _ = claims["sub"].(string)
+	result.Roles = stringsClaim(claims["roles"])
+	result.ClientID, _ = claims["client_id"].(string)
+	result.ActorID = models.ActorID(claims)
+
+	// A token of an OAuth client is limited to its scopes, even if it was
+	// issued with permissions.
+	if scope, ok := claims["scope"].(string); ok {
+		result.Scopes = strings.Fields(scope)
+	} else {
+		result.Permissions = stringsClaim(claims["permissions"])
+	}
+
+	return result
+}
+
+// stringsClaim reads a claim holding a list of strings, which decodes as
+// []any.
+func stringsClaim(claim any) []string {
+	values, _ := claim.([]any)
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,196 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/authn"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	profileDesc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeys authenticates the keys it holds and answers every other key like
+// the auth service does.
+type apiKeys map[string]*models.Claims
+
+func (k apiKeys) AuthenticateAPIKey(_ context.Context, key string) (*models.Claims, error) {
+	if key == "blocked" {
+		return nil, domainErrors.ErrUserBlocked
+	}
+
+	claims, ok := k[key]
+	if !ok {
+		return nil, domainErrors.ErrAPIKeyInvalid
+	}
+
+	return claims, nil
+}
+
+func TestAuthn_UnaryServerInterceptor(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		otherPrivateKey, _, _                          = keys.Generate(keys.DefaultBits)
+
+		user = &models.User{
+			Roles:       []string{"support"},
+			Permissions: []string{models.PermissionUsersRead},
+		}
+
+		accessToken, _        = jwt.CreateWithClaims(15*time.Minute, models.UserClaims(user), accessTokenPrivateKey)
+		impersonationToken, _ = jwt.CreateWithClaims(15*time.Minute, models.ImpersonationClaims(user, "admin"), accessTokenPrivateKey)
+		forgedToken, _        = jwt.CreateWithClaims(15*time.Minute, models.UserClaims(user), otherPrivateKey)
+
+		// A client token issued with permissions before they were left out
+		// of scoped tokens.
+		clientClaims = func() map[string]any {
+			claims := models.UserClaims(user)
+			claims["client_id"] = "client"
+			claims["scope"] = models.ScopeProfileRead
+
+			return claims
+		}()
+		clientToken, _ = jwt.CreateWithClaims(15*time.Minute, clientClaims, accessTokenPrivateKey)
+
+		keyClaims = &models.Claims{
+			Subject:  user.ID.String(),
+			Scopes:   []string{models.ScopeProfileRead},
+			APIKeyID: "key",
+		}
+	)
+
+	tests := []struct {
+		name          string
+		authorization string
+		code          codes.Code
+		claims        *models.Claims
+		fields        []logger.Field
+	}{
+		{
+			name:          "access token case",
+			authorization: "Bearer " + accessToken,
+			claims: &models.Claims{
+				Subject:     user.ID.String(),
+				Roles:       user.Roles,
+				Permissions: user.Permissions,
+			},
+			fields: []logger.Field{
+				{Key: "user_id", Value: user.ID.String()},
+			},
+		},
+		{
+			name:          "impersonation token case",
+			authorization: "Bearer " + impersonationToken,
+			claims: &models.Claims{
+				Subject:     user.ID.String(),
+				Roles:       user.Roles,
+				Permissions: user.Permissions,
+				ActorID:     "admin",
+			},
+			fields: []logger.Field{
+				{Key: "user_id", Value: user.ID.String()},
+				{Key: "actor_id", Value: "admin"},
+			},
+		},
+		{
+			name:          "client token case",
+			authorization: "Bearer " + clientToken,
+			claims: &models.Claims{
+				Subject:  user.ID.String(),
+				Roles:    user.Roles,
+				ClientID: "client",
+				Scopes:   []string{models.ScopeProfileRead},
+			},
+			fields: []logger.Field{
+				{Key: "user_id", Value: user.ID.String()},
+			},
+		},
+		{
+			name:          "access token invalid case",
+			authorization: "Bearer " + forgedToken,
+		},
+		{
+			name:          "api key case",
+			authorization: "ApiKey valid",
+			claims:        keyClaims,
+			fields: []logger.Field{
+				{Key: "user_id", Value: user.ID.String()},
+				{Key: "api_key_id", Value: "key"},
+			},
+		},
+		{
+			name:          "api key invalid case",
+			authorization: "ApiKey unknown",
+			code:          codes.Unauthenticated,
+		},
+		{
+			name:          "api key of blocked user case",
+			authorization: "ApiKey blocked",
+			code:          codes.PermissionDenied,
+		},
+		{
+			name:          "unknown scheme case",
+			authorization: "Basic dXNlcjpwYXNz",
+		},
+		{
+			name: "no credentials case",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqCtx := ctx
+			if tt.authorization != "" {
+				reqCtx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+
+			var handled context.Context
+			handler := func(ctx context.Context, _ any) (any, error) {
+				handled = ctx
+
+				return "resp", nil
+			}
+
+			cfg := &configs.Config{
+				AccessTokenPublicKey: accessTokenPublicKey,
+			}
+
+			interceptor := authn.UnaryServerInterceptor(apiKeys{"valid": keyClaims}, cfg)
+
+			_, err := interceptor(reqCtx, "req", &grpc.UnaryServerInfo{FullMethod: profileDesc.ProfileV1_Get_FullMethodName}, handler)
+
+			require.Equal(t, tt.code, status.Code(err))
+			if tt.code != codes.OK {
+				require.Nil(t, handled)
+
+				return
+			}
+
+			claims, ok := models.ClaimsFromContext(handled)
+			if tt.claims == nil {
+				require.False(t, ok)
+
+				return
+			}
+
+			require.True(t, ok)
+			require.Equal(t, tt.claims, claims)
+			require.Equal(t, tt.fields, logger.FieldsFromContext(handled))
+		})
+	}
+}
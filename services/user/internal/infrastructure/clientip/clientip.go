@@ -0,0 +1,87 @@
+// Package clientip tells which address a request came from, for the
+// interceptors that log it or limit by it.
+package clientip
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// forwardedForHeader is set by the gateway, which appends the address of
+// the HTTP client to the one it was sent.
+const forwardedForHeader = "x-forwarded-for"
+
+// FromContext returns the IP of the client that made the request. When the
+// peer is one of trustedProxies, the address is read from x-forwarded-for
+// instead: the rightmost entry that is not itself a trusted proxy, since
+// anything to its left was written by the client and can be forged. Any
+// other peer is the client itself.
+func FromContext(ctx context.Context, trustedProxies []string) string {
+	ip := Peer(ctx)
+	if !trusted(ip, trustedProxies) {
+		return ip
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var forwarded []string
+	for _, header := range md.Get(forwardedForHeader) {
+		forwarded = append(forwarded, strings.Split(header, ",")...)
+	}
+
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		ip = strings.TrimSpace(forwarded[i])
+		if !trusted(ip, trustedProxies) {
+			break
+		}
+	}
+
+	return ip
+}
+
+// Peer returns the IP of the connection the request came over, without the
+// port.
+func Peer(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+// trusted reports whether ip is one of proxies, which hold addresses and
+// CIDR prefixes.
+func trusted(ip string, proxies []string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, proxy := range proxies {
+		if prefix, err := netip.ParsePrefix(proxy); err == nil {
+			if prefix.Contains(addr) {
+				return true
+			}
+
+			continue
+		}
+
+		if proxyAddr, err := netip.ParseAddr(proxy); err == nil && proxyAddr.Unmap() == addr {
+			return true
+		}
+	}
+
+	return false
+}
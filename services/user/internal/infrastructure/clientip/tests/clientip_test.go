@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/clientip"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestFromContext(t *testing.T) {
+	trustedProxies := []string{"10.0.0.0/8", "192.168.1.10"}
+
+	tests := []struct {
+		name         string
+		peer         net.Addr
+		forwardedFor []string
+		ip           string
+	}{
+		{
+			name: "direct client case",
+			peer: &net.TCPAddr{IP: net.ParseIP("203.0.113.1"), Port: 50000},
+			ip:   "203.0.113.1",
+		},
+		{
+			name:         "untrusted peer case",
+			peer:         &net.TCPAddr{IP: net.ParseIP("203.0.113.1"), Port: 50000},
+			forwardedFor: []string{"198.51.100.1"},
+			ip:           "203.0.113.1",
+		},
+		{
+			name:         "trusted proxy case",
+			peer:         &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50000},
+			forwardedFor: []string{"198.51.100.1"},
+			ip:           "198.51.100.1",
+		},
+		{
+			name:         "trusted proxy address case",
+			peer:         &net.TCPAddr{IP: net.ParseIP("192.168.1.10"), Port: 50000},
+			forwardedFor: []string{"198.51.100.1"},
+			ip:           "198.51.100.1",
+		},
+		{
+			name:         "forged entry case",
+			peer:         &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50000},
+			forwardedFor: []string{"1.2.3.4, 198.51.100.1"},
+			ip:           "198.51.100.1",
+		},
+		{
+			name:         "chain of proxies case",
+			peer:         &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50000},
+			forwardedFor: []string{"1.2.3.4, 198.51.100.1", "10.0.0.2"},
+			ip:           "198.51.100.1",
+		},
+		{
+			name: "trusted proxy without header case",
+			peer: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50000},
+			ip:   "10.0.0.1",
+		},
+		{
+			name: "no peer case",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.peer != nil {
+				ctx = peer.NewContext(ctx, &peer.Peer{Addr: tt.peer})
+			}
+			if len(tt.forwardedFor) > 0 {
+				md := metadata.MD{}
+				md.Append("x-forwarded-for", tt.forwardedFor...)
+				ctx = metadata.NewIncomingContext(ctx, md)
+			}
+
+			require.Equal(t, tt.ip, clientip.FromContext(ctx, trustedProxies))
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package database
+
+const (
+	ErrParseConfig = "error parsing the database config"
+	ErrConnecting  = "error connecting to the database"
+	ErrBeginTx     = "error starting the transaction"
+	ErrCommitTx    = "error committing the transaction"
+)
+
+// uniqueViolationCode is the SQLSTATE postgres reports for unique constraint violations.
+const uniqueViolationCode = "23505"
+
+// foreignKeyViolationCode is the SQLSTATE postgres reports for references to
+// missing rows.
+const foreignKeyViolationCode = "23503"
@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/retry"
+	"github.com/exaring/otelpgx"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func NewPool(log logger.Logger, cfg *configs.Config) (*pgxpool.Pool, error) {
+	loggerTag := "database.postgres.newPool"
+
+	poolCfg, err := pgxpool.ParseConfig(cfg.PostgresDSN)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", ErrParseConfig, err)
+	}
+
+	if cfg.PostgresMaxConns > 0 {
+		poolCfg.MaxConns = cfg.PostgresMaxConns
+	}
+	if cfg.PostgresMinConns > 0 {
+		poolCfg.MinConns = cfg.PostgresMinConns
+	}
+	if cfg.PostgresMaxConnLifetime > 0 {
+		poolCfg.MaxConnLifetime = cfg.PostgresMaxConnLifetime
+	}
+	if cfg.PostgresMaxConnIdleTime > 0 {
+		poolCfg.MaxConnIdleTime = cfg.PostgresMaxConnIdleTime
+	}
+	if cfg.PostgresHealthCheckPeriod > 0 {
+		poolCfg.HealthCheckPeriod = cfg.PostgresHealthCheckPeriod
+	}
+	if cfg.PostgresConnectTimeout > 0 {
+		poolCfg.ConnConfig.ConnectTimeout = cfg.PostgresConnectTimeout
+	}
+
+	poolCfg.ConnConfig.Tracer = otelpgx.NewTracer()
+
+	log.Info(loggerTag, "Connecting to the database via DSN", logger.Field{
+		Key:   "max_conns",
+		Value: int(poolCfg.MaxConns),
+	})
+	db, err := pgxpool.NewWithConfig(context.Background(), poolCfg)
+	if err != nil {
+		log.Error(loggerTag, ErrConnecting, logger.Field{
+			Key:   "error",
+			Value: err.Error(),
+		})
+
+		return nil, fmt.Errorf("%s: %v", ErrConnecting, err)
+	}
+
+	err = retry.Do(context.Background(), cfg.StartupPingAttempts, cfg.StartupPingBackoff, func(ctx context.Context, attempt int) error {
+		if err := db.Ping(ctx); err != nil {
+			log.Warn(loggerTag, "Database is not reachable yet", logger.Field{
+				Key:   "attempt",
+				Value: attempt,
+			}, logger.Field{
+				Key:   "error",
+				Value: err.Error(),
+			})
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		db.Close()
+
+		log.Error(loggerTag, ErrConnecting, logger.Field{
+			Key:   "error",
+			Value: err.Error(),
+		})
+
+		return nil, fmt.Errorf("%s: %v", ErrConnecting, err)
+	}
+	log.Info(loggerTag, "Connection to the database has been completed")
+
+	return db, nil
+}
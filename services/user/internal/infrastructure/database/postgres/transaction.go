@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type txKey struct{}
+
+// Querier is the subset of pgx shared by the pool and a transaction, so
+// repositories can run the same statements inside and outside WithinTx.
+type Querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type TxManager struct {
+	db *pgxpool.Pool
+}
+
+func NewTxManager(db *pgxpool.Pool) domain.TxManager {
+	return &TxManager{
+		db,
+	}
+}
+
+// WithinTx runs fn in a transaction carried by ctx. Nested calls join the
+// outer transaction instead of opening a new one.
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %v", ErrBeginTx, err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.WithoutCancel(ctx))
+	}()
+
+	if err = fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %v", ErrCommitTx, err)
+	}
+
+	return nil
+}
+
+// Conn returns the transaction stored in ctx by WithinTx, or the pool itself.
+func Conn(ctx context.Context, db *pgxpool.Pool) Querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+
+	return db
+}
+
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
+
+func IsForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode
+}
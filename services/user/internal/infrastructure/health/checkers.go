@@ -0,0 +1,45 @@
+package health
+
+import (
+	"context"
+
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresChecker struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresChecker(db *pgxpool.Pool) domain.HealthChecker {
+	return &PostgresChecker{
+		db,
+	}
+}
+
+func (c *PostgresChecker) Name() string {
+	return "postgres"
+}
+
+func (c *PostgresChecker) Check(ctx context.Context) error {
+	return c.db.Ping(ctx)
+}
+
+type RedisChecker struct {
+	redisClient *redis.Client
+}
+
+func NewRedisChecker(redisClient *redis.Client) domain.HealthChecker {
+	return &RedisChecker{
+		redisClient,
+	}
+}
+
+func (c *RedisChecker) Name() string {
+	return "redis"
+}
+
+func (c *RedisChecker) Check(ctx context.Context) error {
+	return c.redisClient.Ping(ctx).Err()
+}
@@ -0,0 +1,152 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+	grpcHealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Monitor runs the dependency checkers on a ticker and mirrors the result
+// into the grpc.health.v1 server. Each checker is exposed under its own name,
+// while the overall ("") status and the watched RPC services are SERVING
+// only while every checker passes.
+type Monitor struct {
+	checkers     []domain.HealthChecker
+	healthServer *grpcHealth.Server
+	services     []string
+	healthy      map[string]bool
+	ready        atomic.Bool
+	draining     atomic.Bool
+	stop         chan struct{}
+	stopOnce     sync.Once
+	done         chan struct{}
+	logger       logger.Logger
+	cfg          *configs.Config
+}
+
+func NewMonitor(checkers []domain.HealthChecker, logger logger.Logger, cfg *configs.Config) *Monitor {
+	healthServer := grpcHealth.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return &Monitor{
+		checkers:     checkers,
+		healthServer: healthServer,
+		healthy:      make(map[string]bool, len(checkers)),
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+		logger:       logger,
+		cfg:          cfg,
+	}
+}
+
+func (m *Monitor) HealthServer() healthpb.HealthServer {
+	return m.healthServer
+}
+
+// Watch makes the RPC services follow the overall status. It must be called
+// before Start.
+func (m *Monitor) Watch(services ...string) {
+	m.services = append(m.services, services...)
+}
+
+// Ready reports whether every dependency passed its last check and the
+// service is not draining.
+func (m *Monitor) Ready() bool {
+	return m.ready.Load() && !m.draining.Load()
+}
+
+// Start runs the first round of checks synchronously, so the status is
+// accurate before the server accepts traffic, and then keeps checking on
+// HealthCheckInterval until Shutdown.
+func (m *Monitor) Start() {
+	m.checkAll()
+
+	go func() {
+		defer close(m.done)
+
+		ticker := time.NewTicker(m.cfg.HealthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-m.stop:
+				return
+			case <-ticker.C:
+				m.checkAll()
+			}
+		}
+	}()
+}
+
+// Shutdown flips every status to NOT_SERVING and stops the checks, so load
+// balancers stop routing new requests while the server drains.
+func (m *Monitor) Shutdown() {
+	loggerTag := "health.monitor.shutdown"
+
+	m.stopOnce.Do(func() {
+		m.draining.Store(true)
+		m.healthServer.Shutdown()
+
+		close(m.stop)
+		<-m.done
+
+		m.logger.Info(loggerTag, "Readiness switched off for draining")
+	})
+}
+
+func (m *Monitor) checkAll() {
+	loggerTag := "health.monitor.check"
+
+	allHealthy := true
+
+	for _, checker := range m.checkers {
+		ctx, cancel := context.WithTimeout(context.Background(), m.cfg.HealthCheckTimeout)
+		err := checker.Check(ctx)
+		cancel()
+
+		healthy := err == nil
+		if previous, seen := m.healthy[checker.Name()]; !seen || previous != healthy {
+			if healthy {
+				m.logger.Info(loggerTag, "Dependency is healthy", logger.Field{
+					Key:   "dependency",
+					Value: checker.Name(),
+				})
+			} else {
+				m.logger.Warn(loggerTag, "Dependency is unhealthy", logger.Field{
+					Key:   "dependency",
+					Value: checker.Name(),
+				}, logger.Field{
+					Key:   "error",
+					Value: err.Error(),
+				})
+			}
+		}
+		m.healthy[checker.Name()] = healthy
+
+		m.healthServer.SetServingStatus(checker.Name(), toServingStatus(healthy))
+
+		allHealthy = allHealthy && healthy
+	}
+
+	m.healthServer.SetServingStatus("", toServingStatus(allHealthy))
+	for _, service := range m.services {
+		m.healthServer.SetServingStatus(service, toServingStatus(allHealthy))
+	}
+
+	m.ready.Store(allHealthy)
+}
+
+func toServingStatus(healthy bool) healthpb.HealthCheckResponse_ServingStatus {
+	if healthy {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,11 @@
+package imaging
+
+import "errors"
+
+var (
+	ErrUnsupportedType = errors.New("unsupported image type")
+	// ErrTypeMismatch is returned when the data is not of the declared type.
+	ErrTypeMismatch   = errors.New("image content does not match its type")
+	ErrTooManyPixels  = errors.New("image has too many pixels")
+	ErrCorruptedImage = errors.New("image cannot be decoded")
+)
@@ -0,0 +1,98 @@
+// Package imaging decodes uploaded pictures and turns them into thumbnails.
+// Thumbnails are encoded from the decoded pixels, so nothing of the original
+// file, EXIF metadata included, ends up in them.
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"slices"
+
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+)
+
+const (
+	TypeJPEG = "image/jpeg"
+	TypePNG  = "image/png"
+	TypeWebP = "image/webp"
+
+	// MaxPixels bounds the size of a decoded image, so a small file that
+	// expands into a huge bitmap is rejected before it is decoded.
+	MaxPixels = 40_000_000
+
+	jpegQuality = 85
+)
+
+var Types = []string{TypeJPEG, TypePNG, TypeWebP}
+
+// Decode decodes data, which must be of contentType. JPEG images are turned
+// upright according to their EXIF orientation.
+func Decode(data []byte, contentType string) (image.Image, error) {
+	if !slices.Contains(Types, contentType) {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedType, contentType)
+	}
+
+	if detected := http.DetectContentType(data); detected != contentType {
+		return nil, fmt.Errorf("%w: declared %s, detected %s", ErrTypeMismatch, contentType, detected)
+	}
+
+	decodeConfig, decode := jpeg.DecodeConfig, jpeg.Decode
+	switch contentType {
+	case TypePNG:
+		decodeConfig, decode = png.DecodeConfig, png.Decode
+	case TypeWebP:
+		decodeConfig, decode = webp.DecodeConfig, webp.Decode
+	}
+
+	cfg, err := decodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorruptedImage, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > MaxPixels {
+		return nil, fmt.Errorf("%w: %dx%d", ErrTooManyPixels, cfg.Width, cfg.Height)
+	}
+
+	img, err := decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorruptedImage, err)
+	}
+
+	if contentType == TypeJPEG {
+		img = orient(img, jpegOrientation(data))
+	}
+
+	return img, nil
+}
+
+// Thumbnail crops the centre square of img and scales it to size x size.
+// Transparent areas are filled with white, as JPEG has no alpha channel.
+func Thumbnail(img image.Image, size int) image.Image {
+	bounds := img.Bounds()
+
+	side := min(bounds.Dx(), bounds.Dy())
+	crop := image.Rect(0, 0, side, side).Add(image.Pt(
+		bounds.Min.X+(bounds.Dx()-side)/2,
+		bounds.Min.Y+(bounds.Dy()-side)/2,
+	))
+
+	thumb := image.NewRGBA(image.Rect(0, 0, size, size))
+	xdraw.Draw(thumb, thumb.Bounds(), image.NewUniform(color.White), image.Point{}, xdraw.Src)
+	xdraw.CatmullRom.Scale(thumb, thumb.Bounds(), img, crop, xdraw.Over, nil)
+
+	return thumb
+}
+
+func EncodeJPEG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,135 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+)
+
+const (
+	orientationTag    = 0x0112
+	orientationNormal = 1
+)
+
+// jpegOrientation returns the EXIF orientation of a JPEG file, from 1 to 8,
+// or 1 when there is none.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return orientationNormal
+	}
+
+	for pos := 2; pos+4 <= len(data); {
+		if data[pos] != 0xFF {
+			return orientationNormal
+		}
+
+		marker := data[pos+1]
+		// Start of scan: the metadata segments are over.
+		if marker == 0xDA {
+			return orientationNormal
+		}
+
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return orientationNormal
+		}
+
+		payload := data[pos+4 : end]
+		if marker == 0xE1 && bytes.HasPrefix(payload, []byte("Exif\x00\x00")) {
+			return exifOrientation(payload[6:])
+		}
+
+		pos = end
+	}
+
+	return orientationNormal
+}
+
+// exifOrientation reads the orientation tag from the first IFD of a TIFF
+// structure.
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return orientationNormal
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return orientationNormal
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return orientationNormal
+	}
+
+	count := int(order.Uint16(tiff[offset:]))
+	for i := range count {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+
+		if order.Uint16(tiff[entry:]) != orientationTag {
+			continue
+		}
+
+		value := int(order.Uint16(tiff[entry+8:]))
+		if value < 1 || value > 8 {
+			return orientationNormal
+		}
+
+		return value
+	}
+
+	return orientationNormal
+}
+
+// orient applies an EXIF orientation to img, so that it is displayed the
+// way the camera was held.
+func orient(img image.Image, orientation int) image.Image {
+	if orientation == orientationNormal {
+		return img
+	}
+
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	// Orientations 5 to 8 swap the axes.
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := range h {
+		for x := range w {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			}
+
+			dst.Set(dx, dy, img.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,223 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/imaging"
+	"github.com/stretchr/testify/require"
+)
+
+// halves returns a w x h image, red on the left and blue on the right.
+func halves(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	return buf.Bytes()
+}
+
+// encodeJPEG encodes img with an EXIF segment that carries orientation.
+func encodeJPEG(t *testing.T, img image.Image, orientation uint16) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}))
+	data := buf.Bytes()
+
+	var tiff bytes.Buffer
+	tiff.WriteString("II*\x00")
+	binary.Write(&tiff, binary.LittleEndian, uint32(8))
+	binary.Write(&tiff, binary.LittleEndian, uint16(1))
+	binary.Write(&tiff, binary.LittleEndian, []uint16{0x0112, 3})
+	binary.Write(&tiff, binary.LittleEndian, uint32(1))
+	binary.Write(&tiff, binary.LittleEndian, []uint16{orientation, 0})
+	binary.Write(&tiff, binary.LittleEndian, uint32(0))
+
+	payload := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	return append(append([]byte{0xFF, 0xD8}, segment...), data[2:]...)
+}
+
+func isRed(c color.Color) bool {
+	r, g, b, _ := c.RGBA()
+
+	return r > 0xC000 && g < 0x4000 && b < 0x4000
+}
+
+func isBlue(c color.Color) bool {
+	r, g, b, _ := c.RGBA()
+
+	return b > 0xC000 && r < 0x4000 && g < 0x4000
+}
+
+func TestDecode(t *testing.T) {
+	type args struct {
+		data        func(t *testing.T) []byte
+		contentType string
+	}
+
+	type expect struct {
+		err   error
+		check func(t *testing.T, img image.Image)
+	}
+
+	tests := []struct {
+		name   string
+		args   args
+		expect expect
+	}{
+		{
+			name: "png case",
+			args: args{
+				func(t *testing.T) []byte { return encodePNG(t, halves(8, 4)) },
+				imaging.TypePNG,
+			},
+			expect: expect{
+				check: func(t *testing.T, img image.Image) {
+					require.Equal(t, image.Rect(0, 0, 8, 4), img.Bounds())
+				},
+			},
+		},
+		{
+			name: "jpeg rotated case",
+			args: args{
+				// Orientation 6: the picture has to be turned clockwise.
+				func(t *testing.T) []byte { return encodeJPEG(t, halves(32, 16), 6) },
+				imaging.TypeJPEG,
+			},
+			expect: expect{
+				check: func(t *testing.T, img image.Image) {
+					require.Equal(t, 16, img.Bounds().Dx())
+					require.Equal(t, 32, img.Bounds().Dy())
+					require.True(t, isRed(img.At(8, 4)))
+					require.True(t, isBlue(img.At(8, 28)))
+				},
+			},
+		},
+		{
+			name: "jpeg mirrored case",
+			args: args{
+				func(t *testing.T) []byte { return encodeJPEG(t, halves(32, 16), 2) },
+				imaging.TypeJPEG,
+			},
+			expect: expect{
+				check: func(t *testing.T, img image.Image) {
+					require.Equal(t, image.Rect(0, 0, 32, 16), img.Bounds())
+					require.True(t, isBlue(img.At(4, 8)))
+					require.True(t, isRed(img.At(28, 8)))
+				},
+			},
+		},
+		{
+			name: "type mismatch case",
+			args: args{
+				func(t *testing.T) []byte { return encodePNG(t, halves(8, 4)) },
+				imaging.TypeJPEG,
+			},
+			expect: expect{
+				err: imaging.ErrTypeMismatch,
+			},
+		},
+		{
+			name: "unsupported type case",
+			args: args{
+				func(t *testing.T) []byte { return []byte("GIF89a") },
+				"image/gif",
+			},
+			expect: expect{
+				err: imaging.ErrUnsupportedType,
+			},
+		},
+		{
+			name: "corrupted case",
+			args: args{
+				func(t *testing.T) []byte { return encodePNG(t, halves(8, 4))[:40] },
+				imaging.TypePNG,
+			},
+			expect: expect{
+				err: imaging.ErrCorruptedImage,
+			},
+		},
+		{
+			name: "too many pixels case",
+			args: args{
+				func(t *testing.T) []byte {
+					data := encodePNG(t, halves(8, 4))
+					// Claim a 100000 x 100000 image in the IHDR chunk.
+					binary.BigEndian.PutUint32(data[16:], 100_000)
+					binary.BigEndian.PutUint32(data[20:], 100_000)
+					binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+
+					return data
+				},
+				imaging.TypePNG,
+			},
+			expect: expect{
+				err: imaging.ErrTooManyPixels,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			img, err := imaging.Decode(tt.args.data(t), tt.args.contentType)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+				require.Nil(t, img)
+
+				return
+			}
+
+			require.NoError(t, err)
+			tt.expect.check(t, img)
+		})
+	}
+}
+
+func TestThumbnail(t *testing.T) {
+	// A wide image keeps its centre: the red and blue halves meet in the
+	// middle of the thumbnail.
+	thumb := imaging.Thumbnail(halves(300, 100), 64)
+
+	require.Equal(t, image.Rect(0, 0, 64, 64), thumb.Bounds())
+	require.True(t, isRed(thumb.At(8, 32)))
+	require.True(t, isBlue(thumb.At(56, 32)))
+
+	data, err := imaging.EncodeJPEG(thumb)
+	require.NoError(t, err)
+
+	decoded, err := imaging.Decode(data, imaging.TypeJPEG)
+	require.NoError(t, err)
+	require.Equal(t, image.Rect(0, 0, 64, 64), decoded.Bounds())
+}
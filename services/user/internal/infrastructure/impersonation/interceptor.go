@@ -0,0 +1,87 @@
+// Package impersonation audits the requests made with tokens an admin was
+// issued to act as a user, and refuses them the methods that could take over
+// the account or outlive the token.
+package impersonation
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	authDesc "github.com/BlazeCoder04/online_store/services/user/pkg/auth/v1"
+	oauthDesc "github.com/BlazeCoder04/online_store/services/user/pkg/oauth/v1"
+	profileDesc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// refusedMethods change the credentials or contact details of the user,
+// delete the account, or end or extend a session: Logout would end the
+// user's own session, linking an identity would add a way to sign in, and
+// authorizing an OAuth client would grant access beyond the lifetime of the
+// token. The admin service refuses these tokens on its own.
+var refusedMethods = map[string]bool{
+	authDesc.AuthV1_Logout_FullMethodName:                          true,
+	authDesc.AuthV1_OAuthAuthorize_FullMethodName:                  true,
+	oauthDesc.OAuthV1_Authorize_FullMethodName:                     true,
+	profileDesc.ProfileV1_Update_FullMethodName:                    true,
+	profileDesc.ProfileV1_Delete_FullMethodName:                    true,
+	profileDesc.ProfileV1_SetPhone_FullMethodName:                  true,
+	profileDesc.ProfileV1_VerifyPhone_FullMethodName:               true,
+	profileDesc.ProfileV1_BeginPasskeyRegistration_FullMethodName:  true,
+	profileDesc.ProfileV1_FinishPasskeyRegistration_FullMethodName: true,
+	profileDesc.ProfileV1_RenamePasskey_FullMethodName:             true,
+	profileDesc.ProfileV1_DeletePasskey_FullMethodName:             true,
+	profileDesc.ProfileV1_CreateApiKey_FullMethodName:              true,
+	profileDesc.ProfileV1_RevokeApiKey_FullMethodName:              true,
+}
+
+// UnaryServerInterceptor writes an audit entry for every request made with
+// an impersonation token, refused or not. The logging and authn interceptors
+// have put the method, the user and the actor on the context already, so the
+// entry and every other line of the request carry both IDs.
+func UnaryServerInterceptor(log logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := audit(ctx, log, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming RPCs, such
+// as the avatar upload.
+func StreamServerInterceptor(log logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := audit(ss.Context(), log, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func audit(ctx context.Context, log logger.Logger, fullMethod string) error {
+	loggerTag := "impersonation.audit"
+
+	claims, ok := models.ClaimsFromContext(ctx)
+	if !ok || !claims.Impersonated() {
+		return nil
+	}
+
+	refused := refusedMethods[fullMethod]
+
+	log.InfoCtx(ctx, loggerTag, "Impersonated request", logger.Field{
+		Key:   "refused",
+		Value: refused,
+	})
+
+	if refused {
+		return status.Error(codes.PermissionDenied, domainErrors.ErrImpersonationRefused.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/logger/observer"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/authn"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/impersonation"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	authDesc "github.com/BlazeCoder04/online_store/services/user/pkg/auth/v1"
+	profileDesc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestImpersonation_UnaryServerInterceptor(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+
+		actorID = uuid.NewString()
+		user    = &models.User{
+			ID:    uuid.New(),
+			Roles: []string{models.RoleCustomer},
+		}
+
+		accessToken, _        = jwt.CreateWithClaims(15*time.Minute, models.UserClaims(user), accessTokenPrivateKey)
+		impersonationToken, _ = jwt.CreateWithClaims(15*time.Minute, models.ImpersonationClaims(user, actorID), accessTokenPrivateKey)
+	)
+
+	tests := []struct {
+		name        string
+		accessToken string
+		fullMethod  string
+		code        codes.Code
+		audited     bool
+	}{
+		{
+			name:        "impersonated get case",
+			accessToken: impersonationToken,
+			fullMethod:  profileDesc.ProfileV1_Get_FullMethodName,
+			audited:     true,
+		},
+		{
+			name:        "impersonated update case",
+			accessToken: impersonationToken,
+			fullMethod:  profileDesc.ProfileV1_Update_FullMethodName,
+			code:        codes.PermissionDenied,
+			audited:     true,
+		},
+		{
+			name:        "impersonated delete case",
+			accessToken: impersonationToken,
+			fullMethod:  profileDesc.ProfileV1_Delete_FullMethodName,
+			code:        codes.PermissionDenied,
+			audited:     true,
+		},
+		{
+			name:        "impersonated create api key case",
+			accessToken: impersonationToken,
+			fullMethod:  profileDesc.ProfileV1_CreateApiKey_FullMethodName,
+			code:        codes.PermissionDenied,
+			audited:     true,
+		},
+		{
+			name:        "impersonated logout case",
+			accessToken: impersonationToken,
+			fullMethod:  authDesc.AuthV1_Logout_FullMethodName,
+			code:        codes.PermissionDenied,
+			audited:     true,
+		},
+		{
+			name:        "own update case",
+			accessToken: accessToken,
+			fullMethod:  profileDesc.ProfileV1_Update_FullMethodName,
+		},
+		{
+			name:        "own logout case",
+			accessToken: accessToken,
+			fullMethod:  authDesc.AuthV1_Logout_FullMethodName,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			log := observer.New(logger.LevelInfo)
+
+			cfg := &configs.Config{
+				AccessTokenPublicKey: accessTokenPublicKey,
+			}
+
+			authenticate := authn.UnaryServerInterceptor(nil, cfg)
+			audit := impersonation.UnaryServerInterceptor(log)
+
+			handled := false
+			handler := func(context.Context, any) (any, error) {
+				handled = true
+
+				return "resp", nil
+			}
+
+			reqCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tt.accessToken))
+			info := &grpc.UnaryServerInfo{FullMethod: tt.fullMethod}
+
+			_, err := authenticate(reqCtx, "req", info, func(ctx context.Context, req any) (any, error) {
+				return audit(ctx, req, info, handler)
+			})
+
+			require.Equal(t, tt.code, status.Code(err))
+			require.Equal(t, tt.code == codes.OK, handled)
+
+			if !tt.audited {
+				observer.RequireNotLogged(t, log, logger.LevelInfo)
+
+				return
+			}
+
+			entry := observer.RequireLogged(t, log, logger.LevelInfo, "impersonation.audit", "Impersonated request")
+
+			refused, _ := entry.Field("refused")
+			require.Equal(t, tt.code == codes.PermissionDenied, refused)
+
+			subject, _ := entry.Field("user_id")
+			require.Equal(t, user.ID.String(), subject)
+
+			actor, _ := entry.Field("actor_id")
+			require.Equal(t, actorID, actor)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package keys
+
+const (
+	ErrGenerateKey = "error generating key"
+	ErrDecodeKey   = "error decoding key"
+	ErrParseKey    = "error parsing key"
+	ErrKeyMismatch = "private and public keys do not match"
+)
@@ -0,0 +1,91 @@
+package keys
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// DefaultBits is the RSA key size used for the token keys.
+const DefaultBits = 2048
+
+// Generate returns an RSA key pair in the format the token keys are
+// configured in: a base64-encoded PEM block, PKCS #1 for the private key and
+// PKIX for the public one.
+func Generate(bits int) (string, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return "", "", fmt.Errorf("%s: %v", ErrGenerateKey, err)
+	}
+
+	publicKey, err := encodePublicKey(&key.PublicKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return encode("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key)), publicKey, nil
+}
+
+// Check reports whether privateKey and publicKey are valid and belong to the
+// same key pair.
+func Check(privateKey, publicKey string) error {
+	private, err := decode(privateKey, "RSA PRIVATE KEY")
+	if err != nil {
+		return fmt.Errorf("private key: %v", err)
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(private)
+	if err != nil {
+		return fmt.Errorf("private key: %s: %v", ErrParseKey, err)
+	}
+
+	public, err := decode(publicKey, "PUBLIC KEY")
+	if err != nil {
+		return fmt.Errorf("public key: %v", err)
+	}
+
+	parsed, err := x509.ParsePKIXPublicKey(public)
+	if err != nil {
+		return fmt.Errorf("public key: %s: %v", ErrParseKey, err)
+	}
+
+	if !key.PublicKey.Equal(parsed) {
+		return errors.New(ErrKeyMismatch)
+	}
+
+	return nil
+}
+
+func encodePublicKey(key *rsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", fmt.Errorf("%s: %v", ErrGenerateKey, err)
+	}
+
+	return encode("PUBLIC KEY", der), nil
+}
+
+func encode(blockType string, der []byte) string {
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{
+		Type:  blockType,
+		Bytes: der,
+	}))
+}
+
+func decode(key, blockType string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", ErrDecodeKey, err)
+	}
+
+	block, _ := pem.Decode(decoded)
+	if block == nil || block.Type != blockType {
+		return nil, fmt.Errorf("%s: expected a %s PEM block", ErrDecodeKey, blockType)
+	}
+
+	return block.Bytes, nil
+}
@@ -0,0 +1,115 @@
+package logging
+
+import (
+	"context"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/clientip"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const requestIDHeader = "x-request-id"
+
+// UnaryServerInterceptor seeds the request context with the fields every log
+// line of the call should carry: request ID, method and peer IP. The user
+// and the admin acting as them are added by authn, which verifies the
+// credentials. The request ID is taken from the x-request-id header or
+// generated, and echoed back to the client.
+func UnaryServerInterceptor(log logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		loggerTag := "logging.interceptor"
+
+		ctx, requestID := withRequestFields(ctx, info.FullMethod)
+
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID))
+
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		logHandled(ctx, log, loggerTag, err, start)
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming RPCs, such
+// as the avatar upload.
+func StreamServerInterceptor(log logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		loggerTag := "logging.interceptor"
+
+		ctx, requestID := withRequestFields(ss.Context(), info.FullMethod)
+
+		_ = ss.SetHeader(metadata.Pairs(requestIDHeader, requestID))
+
+		start := time.Now()
+
+		err := handler(srv, &serverStream{ss, ctx})
+
+		logHandled(ctx, log, loggerTag, err, start)
+
+		return err
+	}
+}
+
+// serverStream hands the context with the fields to the handler.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func withRequestFields(ctx context.Context, fullMethod string) (context.Context, string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	requestID := first(md, requestIDHeader)
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+
+	fields := []logger.Field{
+		{
+			Key:   "request_id",
+			Value: requestID,
+		},
+		{
+			Key:   "method",
+			Value: fullMethod,
+		},
+	}
+
+	if ip := clientip.Peer(ctx); ip != "" {
+		fields = append(fields, logger.Field{
+			Key:   "peer_ip",
+			Value: ip,
+		})
+	}
+
+	return logger.ContextWithFields(ctx, fields...), requestID
+}
+
+func logHandled(ctx context.Context, log logger.Logger, loggerTag string, err error, start time.Time) {
+	log.InfoCtx(ctx, loggerTag, "Request handled", logger.Field{
+		Key:   "code",
+		Value: status.Code(err).String(),
+	}, logger.Field{
+		Key:   "duration",
+		Value: time.Since(start).String(),
+	})
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err).String()
+		m.rpcRequests.WithLabelValues(info.FullMethod, code).Inc()
+		m.rpcDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(start).Seconds())
+
+		return resp, err
+	}
+}
+
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		code := status.Code(err).String()
+		m.rpcRequests.WithLabelValues(info.FullMethod, code).Inc()
+		m.rpcDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
@@ -0,0 +1,119 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "user_service"
+
+type Metrics struct {
+	registry *prometheus.Registry
+
+	rpcRequests *prometheus.CounterVec
+	rpcDuration *prometheus.HistogramVec
+
+	redisDuration *prometheus.HistogramVec
+
+	registrations  prometheus.Counter
+	failedLogins   *prometheus.CounterVec
+	tokenRefreshes prometheus.Counter
+	oauthTokens    *prometheus.CounterVec
+}
+
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+
+		rpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "requests_total",
+			Help:      "Number of handled RPCs by method and status code.",
+		}, []string{"method", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "RPC latency by method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+
+		redisDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "redis",
+			Name:      "command_duration_seconds",
+			Help:      "Redis command latency by command and outcome.",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		}, []string{"command", "status"}),
+
+		registrations: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "auth",
+			Name:      "registrations_total",
+			Help:      "Number of successful registrations.",
+		}),
+		failedLogins: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "auth",
+			Name:      "failed_logins_total",
+			Help:      "Number of rejected logins by reason.",
+		}, []string{"reason"}),
+		tokenRefreshes: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "auth",
+			Name:      "token_refreshes_total",
+			Help:      "Number of access tokens issued from a refresh token.",
+		}),
+		oauthTokens: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "oauth",
+			Name:      "tokens_issued_total",
+			Help:      "Number of access tokens issued to OAuth clients by grant type.",
+		}, []string{"grant_type"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.rpcRequests,
+		m.rpcDuration,
+		m.redisDuration,
+		m.registrations,
+		m.failedLogins,
+		m.tokenRefreshes,
+		m.oauthTokens,
+	)
+
+	return m
+}
+
+func (m *Metrics) RegisterPool(db *pgxpool.Pool) error {
+	return m.registry.Register(newPoolCollector(db))
+}
+
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{
+		Registry: m.registry,
+	})
+}
+
+func (m *Metrics) UserRegistered() {
+	m.registrations.Inc()
+}
+
+func (m *Metrics) LoginFailed(reason string) {
+	m.failedLogins.WithLabelValues(reason).Inc()
+}
+
+func (m *Metrics) TokenRefreshed() {
+	m.tokenRefreshes.Inc()
+}
+
+func (m *Metrics) OAuthTokenIssued(grantType string) {
+	m.oauthTokens.WithLabelValues(grantType).Inc()
+}
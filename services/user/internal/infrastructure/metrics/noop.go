@@ -0,0 +1,19 @@
+package metrics
+
+import domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+
+// Noop discards business events, for wiring services where metrics are not
+// collected, such as tests.
+type Noop struct{}
+
+func NewNoop() domain.BusinessMetrics {
+	return Noop{}
+}
+
+func (Noop) UserRegistered() {}
+
+func (Noop) LoginFailed(string) {}
+
+func (Noop) TokenRefreshed() {}
+
+func (Noop) OAuthTokenIssued(string) {}
@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// poolCollector reads pgxpool statistics at scrape time.
+type poolCollector struct {
+	db *pgxpool.Pool
+
+	acquiredConns    *prometheus.Desc
+	idleConns        *prometheus.Desc
+	totalConns       *prometheus.Desc
+	maxConns         *prometheus.Desc
+	acquireCount     *prometheus.Desc
+	acquireDuration  *prometheus.Desc
+	emptyAcquire     *prometheus.Desc
+	emptyAcquireWait *prometheus.Desc
+	canceledAcquire  *prometheus.Desc
+}
+
+func newPoolCollector(db *pgxpool.Pool) prometheus.Collector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+
+	return &poolCollector{
+		db: db,
+
+		acquiredConns:    desc("acquired_connections", "Connections currently checked out of the pool."),
+		idleConns:        desc("idle_connections", "Idle connections in the pool."),
+		totalConns:       desc("total_connections", "Connections currently open, including those being constructed."),
+		maxConns:         desc("max_connections", "Maximum size of the pool."),
+		acquireCount:     desc("acquires_total", "Successful acquires from the pool."),
+		acquireDuration:  desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		emptyAcquire:     desc("empty_acquires_total", "Acquires that had to wait for a connection."),
+		emptyAcquireWait: desc("empty_acquire_wait_seconds_total", "Total time spent waiting because the pool was empty."),
+		canceledAcquire:  desc("canceled_acquires_total", "Acquires cancelled by their context."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquireCount
+	ch <- c.acquireDuration
+	ch <- c.emptyAcquire
+	ch <- c.emptyAcquireWait
+	ch <- c.canceledAcquire
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.db.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquire, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquireWait, prometheus.CounterValue, stat.EmptyAcquireWaitTime().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquire, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+}
@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+type redisStartKey struct{}
+
+// RedisHook observes the latency of every command issued through the client,
+// which covers the token adapter and anything else sharing it. A missing key
+// is not a failure, so redis.Nil is reported as "ok".
+type RedisHook struct {
+	metrics *Metrics
+}
+
+func (m *Metrics) RedisHook() redis.Hook {
+	return &RedisHook{
+		m,
+	}
+}
+
+func (h *RedisHook) BeforeProcess(ctx context.Context, _ redis.Cmder) (context.Context, error) {
+	return context.WithValue(ctx, redisStartKey{}, time.Now()), nil
+}
+
+func (h *RedisHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	h.observe(ctx, cmd.Name(), cmd.Err())
+
+	return nil
+}
+
+func (h *RedisHook) BeforeProcessPipeline(ctx context.Context, _ []redis.Cmder) (context.Context, error) {
+	return context.WithValue(ctx, redisStartKey{}, time.Now()), nil
+}
+
+func (h *RedisHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	var err error
+	for _, cmd := range cmds {
+		if cmdErr := cmd.Err(); cmdErr != nil && !errors.Is(cmdErr, redis.Nil) {
+			err = cmdErr
+
+			break
+		}
+	}
+
+	h.observe(ctx, "pipeline", err)
+
+	return nil
+}
+
+func (h *RedisHook) observe(ctx context.Context, command string, err error) {
+	start, ok := ctx.Value(redisStartKey{}).(time.Time)
+	if !ok {
+		return
+	}
+
+	status := "ok"
+	if err != nil && !errors.Is(err, redis.Nil) {
+		status = "error"
+	}
+
+	h.metrics.redisDuration.WithLabelValues(command, status).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,8 @@
+package migrator
+
+const (
+	ErrInitMigration = "error initializing migration"
+	ErrMigration     = "migration error"
+	ErrAcquireLock   = "error acquiring migration lock"
+	ErrReleaseLock   = "error releasing migration lock"
+)
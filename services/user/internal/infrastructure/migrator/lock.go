@@ -0,0 +1,43 @@
+package migrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// lockKey identifies the migration lock among the advisory locks of the
+// database. golang-migrate takes its own lock per operation, but gives up
+// after a timeout, so a replica would fail to start while another one runs
+// a long migration; this lock is held for the whole run instead.
+const lockKey int64 = 0x75736572 // "user"
+
+// lock waits for the session advisory lock until ctx is done. The lock is
+// released by the returned function, or by Postgres if the process dies.
+func (m *Migrator) lock(ctx context.Context) (func(), error) {
+	loggerTag := "migrator.lock"
+
+	conn, err := pgx.Connect(ctx, m.cfg.PostgresMigrationDSN)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", ErrAcquireLock, err)
+	}
+
+	m.logger.Debug(loggerTag, "Waiting for the migration lock")
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		_ = conn.Close(context.Background())
+
+		return nil, fmt.Errorf("%s: %v", ErrAcquireLock, err)
+	}
+
+	return func() {
+		ctx := context.Background()
+
+		if _, err := conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", lockKey); err != nil {
+			m.logger.Error(loggerTag, fmt.Sprintf("%s: %v", ErrReleaseLock, err))
+		}
+
+		_ = conn.Close(ctx)
+	}, nil
+}
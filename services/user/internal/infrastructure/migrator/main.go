@@ -1,20 +1,19 @@
 package migrator
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/BlazeCoder04/online_store/libs/logger"
 	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/migrations"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
 )
 
-const (
-	loggerTag      = "migrator"
-	migrationsPath = "migrations"
-)
+const loggerTag = "migrator"
 
 type Migrator struct {
 	cfg    *configs.Config
@@ -28,24 +27,106 @@ func NewMigrator(cfg *configs.Config, logger logger.Logger) *Migrator {
 	}
 }
 
+func (m *Migrator) open() (*migrate.Migrate, error) {
+	source, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", ErrInitMigration, err)
+	}
+
+	migration, err := migrate.NewWithSourceInstance("iofs", source, m.cfg.PostgresMigrationDSN)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", ErrInitMigration, err)
+	}
+
+	migration.LockTimeout = m.cfg.MigrationLockTimeout
+
+	return migration, nil
+}
+
+// Up applies every pending migration.
 func (m *Migrator) Up() error {
-	migration, err := migrate.New(
-		fmt.Sprintf("file://%s", migrationsPath),
-		m.cfg.PostgresMigrationDSN,
-	)
+	return m.run(func(migration *migrate.Migrate) error {
+		return migration.Up()
+	})
+}
+
+// Down reverts every applied migration.
+func (m *Migrator) Down() error {
+	return m.run(func(migration *migrate.Migrate) error {
+		return migration.Down()
+	})
+}
+
+// Steps applies n migrations, or reverts -n of them when n is negative.
+func (m *Migrator) Steps(n int) error {
+	return m.run(func(migration *migrate.Migrate) error {
+		return migration.Steps(n)
+	})
+}
+
+// To migrates up or down to version.
+func (m *Migrator) To(version uint) error {
+	return m.run(func(migration *migrate.Migrate) error {
+		return migration.Migrate(version)
+	})
+}
+
+// Force sets the version without running any migration and clears the
+// dirty flag. It is the way out after a migration failed halfway and the
+// database was repaired by hand.
+func (m *Migrator) Force(version int) error {
+	return m.run(func(migration *migrate.Migrate) error {
+		return migration.Force(version)
+	})
+}
+
+// Version returns the current version, which is zero when no migration has
+// been applied, and whether the last migration failed halfway.
+func (m *Migrator) Version() (uint, bool, error) {
+	migration, err := m.open()
+	if err != nil {
+		return 0, false, err
+	}
+	defer migration.Close()
+
+	version, dirty, err := migration.Version()
+	if err != nil {
+		if errors.Is(err, migrate.ErrNilVersion) {
+			return 0, false, nil
+		}
+
+		return 0, false, fmt.Errorf("%s: %v", ErrMigration, err)
+	}
+
+	return version, dirty, nil
+}
+
+// run holds the migration lock around fn, so replicas starting together
+// migrate one after the other.
+func (m *Migrator) run(fn func(migration *migrate.Migrate) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.cfg.MigrationLockTimeout)
+	defer cancel()
+
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	migration, err := m.open()
 	if err != nil {
-		return fmt.Errorf("Error during initialization of migration: %v", err)
+		return err
 	}
 	defer migration.Close()
 
-	if err := migration.Up(); err != nil {
+	if err := fn(migration); err != nil {
 		if errors.Is(err, migrate.ErrNoChange) {
 			m.logger.Warn(loggerTag, "No migrations to apply")
 
 			return nil
 		}
 
-		return fmt.Errorf("Migration error: %v", err)
+		return fmt.Errorf("%s: %v", ErrMigration, err)
 	}
 
 	m.logger.Info(loggerTag, "Migrations completed successfully")
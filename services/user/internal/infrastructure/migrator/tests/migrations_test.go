@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+
+	"github.com/BlazeCoder04/online_store/services/user/migrations"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrations_Embedded(t *testing.T) {
+	source, err := iofs.New(migrations.FS, ".")
+	require.NoError(t, err)
+	defer source.Close()
+
+	version, err := source.First()
+	require.NoError(t, err)
+	require.Equal(t, uint(1), version)
+
+	for {
+		up, _, err := source.ReadUp(version)
+		require.NoError(t, err, "version %d has no up migration", version)
+		require.NoError(t, up.Close())
+
+		down, _, err := source.ReadDown(version)
+		require.NoError(t, err, "version %d has no down migration", version)
+		require.NoError(t, down.Close())
+
+		next, err := source.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			break
+		}
+		require.NoError(t, err)
+		require.Equal(t, version+1, next, "versions must have no gaps")
+
+		version = next
+	}
+}
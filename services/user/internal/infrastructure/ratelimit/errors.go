@@ -0,0 +1,3 @@
+package ratelimit
+
+const ErrRateLimited = "rate.limited"
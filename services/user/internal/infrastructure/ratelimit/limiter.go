@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/clientip"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// idleTTL is how long a client's bucket is kept after its last request.
+	idleTTL       = 10 * time.Minute
+	sweepInterval = time.Minute
+)
+
+type client struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter keeps a token bucket per client IP, read through the trusted
+// proxies. The rate and burst are read from the config on every request, so
+// a reload applies to existing clients too.
+type Limiter struct {
+	mu        sync.Mutex
+	clients   map[string]*client
+	lastSweep time.Time
+	cfg       configs.Provider
+	logger    logger.Logger
+}
+
+func NewLimiter(logger logger.Logger, cfg configs.Provider) *Limiter {
+	return &Limiter{
+		clients:   make(map[string]*client),
+		lastSweep: time.Now(),
+		cfg:       cfg,
+		logger:    logger,
+	}
+}
+
+func (l *Limiter) Allow(ip string) bool {
+	return l.AllowAt(ip, time.Now())
+}
+
+// AllowAt is Allow for a request made at now.
+func (l *Limiter) AllowAt(ip string, now time.Time) bool {
+	cfg := l.cfg.Current()
+	if cfg.RateLimitAuthRPS <= 0 {
+		return true
+	}
+
+	limit, burst := rate.Limit(cfg.RateLimitAuthRPS), cfg.RateLimitAuthBurst
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) >= sweepInterval {
+		for key, c := range l.clients {
+			if now.Sub(c.lastSeen) >= idleTTL {
+				delete(l.clients, key)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	c, ok := l.clients[ip]
+	if !ok {
+		c = &client{limiter: rate.NewLimiter(limit, burst)}
+		l.clients[ip] = c
+	}
+	if c.limiter.Limit() != limit {
+		c.limiter.SetLimitAt(now, limit)
+	}
+	if c.limiter.Burst() != burst {
+		c.limiter.SetBurstAt(now, burst)
+	}
+	c.lastSeen = now
+
+	return c.limiter.AllowN(now, 1)
+}
+
+// UnaryServerInterceptor limits the RPCs of the given services, e.g. the
+// auth service to slow down credential stuffing. Other RPCs pass through.
+func (l *Limiter) UnaryServerInterceptor(services ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		loggerTag := "ratelimit.interceptor"
+
+		if !limited(info.FullMethod, services) {
+			return handler(ctx, req)
+		}
+
+		if !l.Allow(clientip.FromContext(ctx, l.cfg.Current().TrustedProxies)) {
+			l.logger.WarnCtx(ctx, loggerTag, "Rate limit exceeded")
+
+			return nil, status.Error(codes.ResourceExhausted, ErrRateLimited)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func limited(fullMethod string, services []string) bool {
+	for _, service := range services {
+		if strings.HasPrefix(fullMethod, "/"+service+"/") {
+			return true
+		}
+	}
+
+	return false
+}
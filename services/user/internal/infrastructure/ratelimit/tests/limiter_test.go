@@ -0,0 +1,203 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/logger/observer"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/ratelimit"
+	authDesc "github.com/BlazeCoder04/online_store/services/user/pkg/auth/v1"
+	profileDesc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestLimiter_AllowAt(t *testing.T) {
+	// step is a request made at the given offset, after reload, if set, has
+	// changed the config.
+	type step struct {
+		at      time.Duration
+		ip      string
+		reload  func(cfg *configs.Config)
+		allowed bool
+	}
+
+	tests := []struct {
+		name  string
+		rps   float64
+		burst int
+		steps []step
+	}{
+		{
+			name:  "burst exceeded case",
+			rps:   1,
+			burst: 2,
+			steps: []step{
+				{ip: "10.0.0.1", allowed: true},
+				{ip: "10.0.0.1", allowed: true},
+				{ip: "10.0.0.1", allowed: false},
+				{ip: "10.0.0.2", allowed: true},
+				{at: time.Second, ip: "10.0.0.1", allowed: true},
+			},
+		},
+		{
+			name:  "limit off case",
+			burst: 1,
+			steps: []step{
+				{ip: "10.0.0.1", allowed: true},
+				{ip: "10.0.0.1", allowed: true},
+			},
+		},
+		{
+			// The client has two requests saved up, but the lowered burst
+			// caps them at one.
+			name:  "reloaded burst case",
+			rps:   0.001,
+			burst: 3,
+			steps: []step{
+				{ip: "10.0.0.1", allowed: true},
+				{ip: "10.0.0.1", reload: func(cfg *configs.Config) { cfg.RateLimitAuthBurst = 1 }, allowed: true},
+				{ip: "10.0.0.1", allowed: false},
+			},
+		},
+		{
+			name:  "reloaded limit off case",
+			rps:   0.001,
+			burst: 1,
+			steps: []step{
+				{ip: "10.0.0.1", allowed: true},
+				{ip: "10.0.0.1", allowed: false},
+				{ip: "10.0.0.1", reload: func(cfg *configs.Config) { cfg.RateLimitAuthRPS = 0 }, allowed: true},
+			},
+		},
+		{
+			// A token takes longer than the idle TTL to come back, so only a
+			// new bucket lets the client in again.
+			name:  "idle client evicted case",
+			rps:   0.001,
+			burst: 1,
+			steps: []step{
+				{ip: "10.0.0.1", allowed: true},
+				{at: 11 * time.Minute, ip: "10.0.0.1", allowed: true},
+			},
+		},
+		{
+			name:  "recent client kept case",
+			rps:   0.001,
+			burst: 1,
+			steps: []step{
+				{ip: "10.0.0.1", allowed: true},
+				{at: 9 * time.Minute, ip: "10.0.0.1", allowed: false},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &configs.Config{
+				RateLimitAuthRPS:   tt.rps,
+				RateLimitAuthBurst: tt.burst,
+			}
+
+			limiter := ratelimit.NewLimiter(observer.New(logger.LevelDebug), cfg)
+			start := time.Now()
+
+			for i, step := range tt.steps {
+				if step.reload != nil {
+					step.reload(cfg)
+				}
+
+				require.Equal(t, step.allowed, limiter.AllowAt(step.ip, start.Add(step.at)), "step %d", i)
+			}
+		})
+	}
+}
+
+func TestLimiter_UnaryServerInterceptor(t *testing.T) {
+	const gateway = "10.0.0.1"
+
+	type request struct {
+		peer         string
+		forwardedFor string
+		fullMethod   string
+		code         codes.Code
+	}
+
+	tests := []struct {
+		name     string
+		requests []request
+	}{
+		{
+			name: "clients behind the gateway case",
+			requests: []request{
+				{peer: gateway, forwardedFor: "203.0.113.1", fullMethod: authDesc.AuthV1_Login_FullMethodName},
+				{peer: gateway, forwardedFor: "203.0.113.2", fullMethod: authDesc.AuthV1_Login_FullMethodName},
+				{peer: gateway, forwardedFor: "203.0.113.1", fullMethod: authDesc.AuthV1_Login_FullMethodName, code: codes.ResourceExhausted},
+			},
+		},
+		{
+			name: "forged address case",
+			requests: []request{
+				{peer: "203.0.113.1", forwardedFor: "198.51.100.1", fullMethod: authDesc.AuthV1_Login_FullMethodName},
+				{peer: "203.0.113.1", forwardedFor: "198.51.100.2", fullMethod: authDesc.AuthV1_Login_FullMethodName, code: codes.ResourceExhausted},
+			},
+		},
+		{
+			name: "gateway without address case",
+			requests: []request{
+				{peer: gateway, fullMethod: authDesc.AuthV1_Login_FullMethodName},
+				{peer: gateway, fullMethod: authDesc.AuthV1_Login_FullMethodName, code: codes.ResourceExhausted},
+			},
+		},
+		{
+			name: "unlimited service case",
+			requests: []request{
+				{peer: "203.0.113.1", fullMethod: profileDesc.ProfileV1_Get_FullMethodName},
+				{peer: "203.0.113.1", fullMethod: profileDesc.ProfileV1_Get_FullMethodName},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &configs.Config{
+				RateLimitAuthRPS:   0.001,
+				RateLimitAuthBurst: 1,
+				TrustedProxies:     []string{gateway + "/32"},
+			}
+
+			log := observer.New(logger.LevelDebug)
+			interceptor := ratelimit.NewLimiter(log, cfg).UnaryServerInterceptor(authDesc.AuthV1_ServiceDesc.ServiceName)
+
+			handler := func(context.Context, any) (any, error) {
+				return "resp", nil
+			}
+
+			for i, req := range tt.requests {
+				ctx := peer.NewContext(context.Background(), &peer.Peer{
+					Addr: &net.TCPAddr{IP: net.ParseIP(req.peer), Port: 50000},
+				})
+				if req.forwardedFor != "" {
+					ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", req.forwardedFor))
+				}
+
+				_, err := interceptor(ctx, "req", &grpc.UnaryServerInfo{FullMethod: req.fullMethod}, handler)
+				require.Equal(t, req.code, status.Code(err), "request %d", i)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Package rbac checks the permission each method requires against the
+// permissions the caller's roles grant.
+package rbac
+
+import (
+	"context"
+
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	adminDesc "github.com/BlazeCoder04/online_store/services/user/pkg/admin/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// methodPermissions is the permission each method requires. Methods not
+// listed are open to every authenticated user, and check ownership
+// themselves.
+var methodPermissions = map[string]string{
+	adminDesc.AdminV1_ListRoles_FullMethodName:     models.PermissionRolesRead,
+	adminDesc.AdminV1_ListUserRoles_FullMethodName: models.PermissionRolesRead,
+	adminDesc.AdminV1_AssignRole_FullMethodName:    models.PermissionRolesAssign,
+	adminDesc.AdminV1_RevokeRole_FullMethodName:    models.PermissionRolesAssign,
+	adminDesc.AdminV1_Impersonate_FullMethodName:   models.PermissionUsersImpersonate,
+}
+
+// UnaryServerInterceptor enforces the permissions of the claims the authn
+// interceptor stored. The permissions are those of the token when it was
+// issued, so a change of roles takes effect once the token is refreshed.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming RPCs.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func authorize(ctx context.Context, fullMethod string) error {
+	required, ok := methodPermissions[fullMethod]
+	if !ok {
+		return nil
+	}
+
+	claims, ok := models.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, domainErrors.ErrTokenInvalid.Error())
+	}
+
+	if !claims.HasPermission(required) {
+		return status.Error(codes.PermissionDenied, domainErrors.ErrPermissionDenied.Error())
+	}
+
+	return nil
+}
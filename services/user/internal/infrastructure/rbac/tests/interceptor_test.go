@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/rbac"
+	adminDesc "github.com/BlazeCoder04/online_store/services/user/pkg/admin/v1"
+	profileDesc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRBAC_UnaryServerInterceptor(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		customer = &models.Claims{
+			Subject: "customer",
+			Roles:   []string{models.RoleCustomer},
+		}
+
+		support = &models.Claims{
+			Subject:     "support",
+			Roles:       []string{"support"},
+			Permissions: []string{models.PermissionUsersRead, models.PermissionUsersImpersonate},
+		}
+	)
+
+	tests := []struct {
+		name   string
+		method string
+		claims *models.Claims
+		code   codes.Code
+	}{
+		{
+			name:   "permission granted case",
+			method: adminDesc.AdminV1_Impersonate_FullMethodName,
+			claims: support,
+			code:   codes.OK,
+		},
+		{
+			name:   "permission missing case",
+			method: adminDesc.AdminV1_AssignRole_FullMethodName,
+			claims: support,
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "customer case",
+			method: adminDesc.AdminV1_ListRoles_FullMethodName,
+			claims: customer,
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "no claims case",
+			method: adminDesc.AdminV1_ListRoles_FullMethodName,
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "unlisted method case",
+			method: profileDesc.ProfileV1_Get_FullMethodName,
+			claims: customer,
+			code:   codes.OK,
+		},
+		{
+			name:   "unlisted method without claims case",
+			method: profileDesc.ProfileV1_Get_FullMethodName,
+			code:   codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqCtx := ctx
+			if tt.claims != nil {
+				reqCtx = models.ContextWithClaims(ctx, tt.claims)
+			}
+
+			called := false
+			handler := func(context.Context, any) (any, error) {
+				called = true
+
+				return "resp", nil
+			}
+
+			resp, err := rbac.UnaryServerInterceptor()(reqCtx, "req", &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			require.Equal(t, tt.code, status.Code(err))
+			require.Equal(t, tt.code == codes.OK, called)
+			if tt.code == codes.OK {
+				require.Equal(t, "resp", resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories"
+	database "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/database/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const columns = `id, user_id, label, recipient, line1, line2, city, region, postal_code, country_code, phone, default_shipping, default_billing, created_at, updated_at`
+
+type AddressRepository struct {
+	db     *pgxpool.Pool
+	logger logger.Logger
+	cfg    *configs.Config
+}
+
+func NewAddressRepository(db *pgxpool.Pool, logger logger.Logger, cfg *configs.Config) (domain.AddressRepository, error) {
+	loggerTag := "address.repository.newAddressRepository"
+
+	logger.Info(loggerTag, "Address repository initialized")
+
+	return &AddressRepository{
+		db,
+		logger,
+		cfg,
+	}, nil
+}
+
+func (r *AddressRepository) conn(ctx context.Context) database.Querier {
+	return database.Conn(ctx, r.db)
+}
+
+func scan(row pgx.Row) (*models.Address, error) {
+	var address models.Address
+
+	err := row.Scan(
+		&address.ID, &address.UserID, &address.Label, &address.Recipient, &address.Line1, &address.Line2,
+		&address.City, &address.Region, &address.PostalCode, &address.CountryCode, &address.Phone,
+		&address.DefaultShipping, &address.DefaultBilling, &address.CreatedAt, &address.UpdatedAt,
+	)
+	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return nil, domainErrors.ErrAddressDefaultConflict
+		}
+
+		return nil, err
+	}
+
+	return &address, nil
+}
+
+func (r *AddressRepository) Create(ctx context.Context, address *models.Address) (*models.Address, error) {
+	query := `
+		INSERT INTO addresses (user_id, label, recipient, line1, line2, city, region, postal_code, country_code, phone, default_shipping, default_billing, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW())
+		RETURNING ` + columns
+
+	return scan(r.conn(ctx).QueryRow(ctx, query,
+		address.UserID, address.Label, address.Recipient, address.Line1, address.Line2, address.City,
+		address.Region, address.PostalCode, address.CountryCode, address.Phone, address.DefaultShipping, address.DefaultBilling,
+	))
+}
+
+func (r *AddressRepository) FindByUserID(ctx context.Context, userID string) ([]*models.Address, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM addresses
+		WHERE user_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := r.conn(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	addresses := make([]*models.Address, 0)
+	for rows.Next() {
+		address, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses, rows.Err()
+}
+
+func (r *AddressRepository) FindByID(ctx context.Context, userID, addressID string) (*models.Address, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM addresses
+		WHERE id = $1 AND user_id = $2
+	`
+
+	return scan(r.conn(ctx).QueryRow(ctx, query, addressID, userID))
+}
+
+func (r *AddressRepository) Update(ctx context.Context, address *models.Address) (*models.Address, error) {
+	query := `
+		UPDATE addresses
+		SET
+			label = $3,
+			recipient = $4,
+			line1 = $5,
+			line2 = $6,
+			city = $7,
+			region = $8,
+			postal_code = $9,
+			country_code = $10,
+			phone = $11,
+			default_shipping = $12,
+			default_billing = $13,
+			updated_at = NOW()
+		WHERE id = $1 AND user_id = $2
+		RETURNING ` + columns
+
+	return scan(r.conn(ctx).QueryRow(ctx, query,
+		address.ID, address.UserID, address.Label, address.Recipient, address.Line1, address.Line2, address.City,
+		address.Region, address.PostalCode, address.CountryCode, address.Phone, address.DefaultShipping, address.DefaultBilling,
+	))
+}
+
+func (r *AddressRepository) Delete(ctx context.Context, userID, addressID string) error {
+	query := `
+		DELETE FROM addresses
+		WHERE id = $1 AND user_id = $2
+	`
+
+	tag, err := r.conn(ctx).Exec(ctx, query, addressID, userID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *AddressRepository) ClearDefaults(ctx context.Context, userID string, shipping, billing bool) error {
+	query := `
+		UPDATE addresses
+		SET
+			default_shipping = default_shipping AND NOT $2,
+			default_billing = default_billing AND NOT $3,
+			updated_at = NOW()
+		WHERE user_id = $1 AND ((default_shipping AND $2) OR (default_billing AND $3))
+	`
+
+	if _, err := r.conn(ctx).Exec(ctx, query, userID, shipping, billing); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories"
+	database "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/database/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const columns = `id, user_id, COALESCE(service, ''), name, prefix, secret_hash, scopes, expires_at, last_used_at, revoked, created_at`
+
+type APIKeyRepository struct {
+	db     *pgxpool.Pool
+	logger logger.Logger
+	cfg    *configs.Config
+}
+
+func NewAPIKeyRepository(db *pgxpool.Pool, logger logger.Logger, cfg *configs.Config) (domain.APIKeyRepository, error) {
+	loggerTag := "apikey.repository.newAPIKeyRepository"
+
+	logger.Info(loggerTag, "API key repository initialized")
+
+	return &APIKeyRepository{
+		db,
+		logger,
+		cfg,
+	}, nil
+}
+
+func (r *APIKeyRepository) conn(ctx context.Context) database.Querier {
+	return database.Conn(ctx, r.db)
+}
+
+func scan(row pgx.Row) (*models.APIKey, error) {
+	var key models.APIKey
+
+	err := row.Scan(
+		&key.ID, &key.UserID, &key.Service, &key.Name, &key.Prefix, &key.SecretHash,
+		&key.Scopes, &key.ExpiresAt, &key.LastUsedAt, &key.Revoked, &key.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func (r *APIKeyRepository) findAll(ctx context.Context, query string, args ...any) ([]*models.APIKey, error) {
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]*models.APIKey, 0)
+	for rows.Next() {
+		key, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, key *models.APIKey) (*models.APIKey, error) {
+	query := `
+		INSERT INTO api_keys (user_id, service, name, prefix, secret_hash, scopes, expires_at, created_at)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6, $7, NOW())
+		RETURNING ` + columns
+
+	return scan(r.conn(ctx).QueryRow(ctx, query,
+		key.UserID, key.Service, key.Name, key.Prefix, key.SecretHash, key.Scopes, key.ExpiresAt,
+	))
+}
+
+func (r *APIKeyRepository) FindByPrefix(ctx context.Context, prefix string) (*models.APIKey, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM api_keys
+		WHERE prefix = $1
+	`
+
+	return scan(r.conn(ctx).QueryRow(ctx, query, prefix))
+}
+
+func (r *APIKeyRepository) FindByUserID(ctx context.Context, userID string) ([]*models.APIKey, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM api_keys
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+	`
+
+	return r.findAll(ctx, query, userID)
+}
+
+func (r *APIKeyRepository) FindByService(ctx context.Context, service string) ([]*models.APIKey, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM api_keys
+		WHERE service = $1
+		ORDER BY created_at DESC
+	`
+
+	return r.findAll(ctx, query, service)
+}
+
+func (r *APIKeyRepository) Revoke(ctx context.Context, keyID string) (*models.APIKey, error) {
+	query := `
+		UPDATE api_keys
+		SET revoked = TRUE
+		WHERE id = $1
+		RETURNING ` + columns
+
+	return scan(r.conn(ctx).QueryRow(ctx, query, keyID))
+}
+
+func (r *APIKeyRepository) RevokeForUser(ctx context.Context, userID, keyID string) (*models.APIKey, error) {
+	query := `
+		UPDATE api_keys
+		SET revoked = TRUE
+		WHERE id = $1 AND user_id = $2
+		RETURNING ` + columns
+
+	return scan(r.conn(ctx).QueryRow(ctx, query, keyID, userID))
+}
+
+func (r *APIKeyRepository) Touch(ctx context.Context, keyID string) error {
+	query := `
+		UPDATE api_keys
+		SET last_used_at = NOW()
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')
+	`
+
+	_, err := r.conn(ctx).Exec(ctx, query, keyID)
+
+	return err
+}
@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories"
+	database "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/database/postgres"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type IdentityRepository struct {
+	db     *pgxpool.Pool
+	logger logger.Logger
+	cfg    *configs.Config
+}
+
+func NewIdentityRepository(db *pgxpool.Pool, logger logger.Logger, cfg *configs.Config) (domain.IdentityRepository, error) {
+	loggerTag := "identity.repository.newIdentityRepository"
+
+	logger.Info(loggerTag, "Identity repository initialized")
+
+	return &IdentityRepository{
+		db,
+		logger,
+		cfg,
+	}, nil
+}
+
+func (r *IdentityRepository) conn(ctx context.Context) database.Querier {
+	return database.Conn(ctx, r.db)
+}
+
+func (r *IdentityRepository) Create(ctx context.Context, identity *models.UserIdentity) (*models.UserIdentity, error) {
+	created := *identity
+
+	query := `
+		INSERT INTO user_identities (user_id, provider, subject, email, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING id, created_at
+	`
+
+	err := r.conn(ctx).
+		QueryRow(ctx, query, identity.UserID, identity.Provider, identity.Subject, identity.Email).
+		Scan(&created.ID, &created.CreatedAt)
+	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return nil, domainErrors.ErrIdentityExists
+		}
+
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (r *IdentityRepository) FindByProviderSubject(ctx context.Context, provider, subject string) (*models.UserIdentity, error) {
+	var identity models.UserIdentity
+
+	query := `
+		SELECT id, user_id, provider, subject, email, created_at
+		FROM user_identities
+		WHERE provider = $1 AND subject = $2
+	`
+
+	err := r.conn(ctx).
+		QueryRow(ctx, query, provider, subject).
+		Scan(&identity.ID, &identity.UserID, &identity.Provider, &identity.Subject, &identity.Email, &identity.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity, nil
+}
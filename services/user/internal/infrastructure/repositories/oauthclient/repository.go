@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories"
+	database "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/database/postgres"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OAuthClientRepository struct {
+	db     *pgxpool.Pool
+	logger logger.Logger
+	cfg    *configs.Config
+}
+
+func NewOAuthClientRepository(db *pgxpool.Pool, logger logger.Logger, cfg *configs.Config) (domain.OAuthClientRepository, error) {
+	loggerTag := "oauthclient.repository.newOAuthClientRepository"
+
+	logger.Info(loggerTag, "OAuth client repository initialized")
+
+	return &OAuthClientRepository{
+		db,
+		logger,
+		cfg,
+	}, nil
+}
+
+func (r *OAuthClientRepository) conn(ctx context.Context) database.Querier {
+	return database.Conn(ctx, r.db)
+}
+
+func (r *OAuthClientRepository) Create(ctx context.Context, client *models.OAuthClient) (*models.OAuthClient, error) {
+	created := *client
+
+	query := `
+		INSERT INTO oauth_clients (id, secret_hash, name, redirect_uris, grant_types, scopes, created_at)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6, NOW())
+		RETURNING created_at
+	`
+
+	err := r.conn(ctx).
+		QueryRow(ctx, query, client.ID, client.SecretHash, client.Name, client.RedirectURIs, client.GrantTypes, client.Scopes).
+		Scan(&created.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (r *OAuthClientRepository) FindByID(ctx context.Context, id string) (*models.OAuthClient, error) {
+	var client models.OAuthClient
+
+	query := `
+		SELECT id, COALESCE(secret_hash, ''), name, redirect_uris, grant_types, scopes, created_at
+		FROM oauth_clients
+		WHERE id = $1
+	`
+
+	err := r.conn(ctx).
+		QueryRow(ctx, query, id).
+		Scan(&client.ID, &client.SecretHash, &client.Name, &client.RedirectURIs, &client.GrantTypes, &client.Scopes, &client.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client, nil
+}
@@ -0,0 +1,156 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories"
+	database "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/database/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const columns = `id, user_id, name, credential_id, public_key, attestation_type, transports, aaguid, sign_count, backup_eligible, backup_state, created_at, last_used_at`
+
+type PasskeyRepository struct {
+	db     *pgxpool.Pool
+	logger logger.Logger
+	cfg    *configs.Config
+}
+
+func NewPasskeyRepository(db *pgxpool.Pool, logger logger.Logger, cfg *configs.Config) (domain.PasskeyRepository, error) {
+	loggerTag := "passkey.repository.newPasskeyRepository"
+
+	logger.Info(loggerTag, "Passkey repository initialized")
+
+	return &PasskeyRepository{
+		db,
+		logger,
+		cfg,
+	}, nil
+}
+
+func (r *PasskeyRepository) conn(ctx context.Context) database.Querier {
+	return database.Conn(ctx, r.db)
+}
+
+func scan(row pgx.Row) (*models.Passkey, error) {
+	var passkey models.Passkey
+
+	err := row.Scan(
+		&passkey.ID, &passkey.UserID, &passkey.Name, &passkey.CredentialID, &passkey.PublicKey,
+		&passkey.AttestationType, &passkey.Transports, &passkey.AAGUID, &passkey.SignCount,
+		&passkey.BackupEligible, &passkey.BackupState, &passkey.CreatedAt, &passkey.LastUsedAt,
+	)
+	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return nil, domainErrors.ErrPasskeyExists
+		}
+
+		return nil, err
+	}
+
+	return &passkey, nil
+}
+
+func (r *PasskeyRepository) Create(ctx context.Context, passkey *models.Passkey) (*models.Passkey, error) {
+	query := `
+		INSERT INTO passkeys (user_id, name, credential_id, public_key, attestation_type, transports, aaguid, sign_count, backup_eligible, backup_state, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW())
+		RETURNING ` + columns
+
+	return scan(r.conn(ctx).QueryRow(ctx, query,
+		passkey.UserID, passkey.Name, passkey.CredentialID, passkey.PublicKey, passkey.AttestationType,
+		passkey.Transports, passkey.AAGUID, passkey.SignCount, passkey.BackupEligible, passkey.BackupState,
+	))
+}
+
+func (r *PasskeyRepository) FindByUserID(ctx context.Context, userID string) ([]*models.Passkey, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM passkeys
+		WHERE user_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := r.conn(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	passkeys := make([]*models.Passkey, 0)
+	for rows.Next() {
+		passkey, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		passkeys = append(passkeys, passkey)
+	}
+
+	return passkeys, rows.Err()
+}
+
+func (r *PasskeyRepository) FindByCredentialID(ctx context.Context, credentialID []byte) (*models.Passkey, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM passkeys
+		WHERE credential_id = $1
+	`
+
+	return scan(r.conn(ctx).QueryRow(ctx, query, credentialID))
+}
+
+func (r *PasskeyRepository) Rename(ctx context.Context, userID, passkeyID, name string) (*models.Passkey, error) {
+	query := `
+		UPDATE passkeys
+		SET name = $3
+		WHERE id = $1 AND user_id = $2
+		RETURNING ` + columns
+
+	return scan(r.conn(ctx).QueryRow(ctx, query, passkeyID, userID, name))
+}
+
+func (r *PasskeyRepository) UpdateUsage(ctx context.Context, passkey *models.Passkey) error {
+	query := `
+		UPDATE passkeys
+		SET
+			sign_count = $2,
+			backup_state = $3,
+			last_used_at = NOW()
+		WHERE id = $1
+	`
+
+	tag, err := r.conn(ctx).Exec(ctx, query, passkey.ID, passkey.SignCount, passkey.BackupState)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *PasskeyRepository) Delete(ctx context.Context, userID, passkeyID string) error {
+	query := `
+		DELETE FROM passkeys
+		WHERE id = $1 AND user_id = $2
+	`
+
+	tag, err := r.conn(ctx).Exec(ctx, query, passkeyID, userID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
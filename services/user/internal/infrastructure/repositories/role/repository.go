@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories"
+	database "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/database/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RoleRepository struct {
+	db     *pgxpool.Pool
+	logger logger.Logger
+	cfg    *configs.Config
+}
+
+func NewRoleRepository(db *pgxpool.Pool, logger logger.Logger, cfg *configs.Config) (domain.RoleRepository, error) {
+	loggerTag := "role.repository.newRoleRepository"
+
+	logger.Info(loggerTag, "Role repository initialized")
+
+	return &RoleRepository{
+		db,
+		logger,
+		cfg,
+	}, nil
+}
+
+func (r *RoleRepository) conn(ctx context.Context) database.Querier {
+	return database.Conn(ctx, r.db)
+}
+
+func (r *RoleRepository) FindAll(ctx context.Context) ([]*models.Role, error) {
+	query := `
+		SELECT name, description, ARRAY(SELECT permission FROM role_permissions WHERE role = roles.name ORDER BY permission)
+		FROM roles
+		ORDER BY name
+	`
+
+	rows, err := r.conn(ctx).Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := make([]*models.Role, 0)
+	for rows.Next() {
+		var role models.Role
+		if err := rows.Scan(&role.Name, &role.Description, &role.Permissions); err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, &role)
+	}
+
+	return roles, rows.Err()
+}
+
+func (r *RoleRepository) Assign(ctx context.Context, userID, role string) error {
+	query := `
+		INSERT INTO user_roles (user_id, role, created_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (user_id, role) DO NOTHING
+	`
+
+	if _, err := r.conn(ctx).Exec(ctx, query, userID, role); err != nil {
+		if database.IsForeignKeyViolation(err) {
+			return domainErrors.ErrRoleNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *RoleRepository) Revoke(ctx context.Context, userID, role string) error {
+	query := `
+		DELETE FROM user_roles
+		WHERE user_id = $1 AND role = $2
+	`
+
+	tag, err := r.conn(ctx).Exec(ctx, query, userID, role)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
@@ -1,5 +0,0 @@
-package repositories
-
-const (
-	ErrConnecting = "error connecting to the database"
-)
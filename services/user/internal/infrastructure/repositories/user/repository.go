@@ -2,82 +2,91 @@ package repositories
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/BlazeCoder04/online_store/libs/logger"
 	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
 	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
 	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories"
+	database "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/database/postgres"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// columns ends with the roles of the user and the permissions they grant,
+// so every user read carries them.
+const columns = `id, email, COALESCE(password, ''), first_name, last_name, created_at, updated_at, blocked_at, phone, phone_verified, avatar_key, avatar_urls,
+	ARRAY(SELECT role FROM user_roles WHERE user_id = users.id ORDER BY role),
+	ARRAY(
+		SELECT DISTINCT rp.permission
+		FROM user_roles ur JOIN role_permissions rp ON rp.role = ur.role
+		WHERE ur.user_id = users.id
+		ORDER BY rp.permission
+	)`
+
+// createdColumns is columns for a user just inserted, whose role is not
+// visible to the statement yet.
+const createdColumns = `id, email, COALESCE(password, ''), first_name, last_name, created_at, updated_at, blocked_at, phone, phone_verified, avatar_key, avatar_urls,
+	ARRAY[$5::TEXT],
+	ARRAY(SELECT permission FROM role_permissions WHERE role = $5 ORDER BY permission)`
+
 type UserRepository struct {
 	db     *pgxpool.Pool
 	logger logger.Logger
 	cfg    *configs.Config
 }
 
-func NewUserRepository(repoLogger logger.Logger, cfg *configs.Config) (domain.UserRepository, error) {
+func NewUserRepository(db *pgxpool.Pool, logger logger.Logger, cfg *configs.Config) (domain.UserRepository, error) {
 	loggerTag := "user.repository.newUserRepository"
 
-	repoLogger.Info(loggerTag, "Initializing the user repository")
-
-	repoLogger.Info(loggerTag, "Connecting to the database via DSN")
-	db, err := pgxpool.New(context.Background(), cfg.PostgresDSN)
-	if err != nil {
-		repoLogger.Error(loggerTag, ErrConnecting, logger.Field{
-			Key:   "error",
-			Value: err.Error(),
-		})
-
-		return nil, fmt.Errorf("%s: %v", ErrConnecting, err)
-	}
-	repoLogger.Info(loggerTag, "Connection to the database has been completed")
+	logger.Info(loggerTag, "User repository initialized")
 
 	return &UserRepository{
 		db,
-		repoLogger,
+		logger,
 		cfg,
 	}, nil
 }
 
-func (r *UserRepository) Create(ctx context.Context, email, password, firstName, lastName string) (*models.User, error) {
-	user := &models.User{
-		Email:     email,
-		Password:  password,
-		FirstName: firstName,
-		LastName:  lastName,
-		Role:      models.UserRole,
-	}
+func (r *UserRepository) conn(ctx context.Context) database.Querier {
+	return database.Conn(ctx, r.db)
+}
 
+// Create gives the user the customer role, in the same statement so no user
+// is ever left without one.
+func (r *UserRepository) Create(ctx context.Context, email, password, firstName, lastName string) (*models.User, error) {
 	query := `
-		INSERT INTO users (email, password, first_name, last_name, role, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
-		RETURNING id, created_at, updated_at
+		WITH created AS (
+			INSERT INTO users (email, password, first_name, last_name, created_at, updated_at)
+			VALUES ($1, NULLIF($2, ''), $3, $4, NOW(), NOW())
+			RETURNING *
+		), assigned AS (
+			INSERT INTO user_roles (user_id, role)
+			SELECT id, $5 FROM created
+		)
+		SELECT ` + createdColumns + `
+		FROM created
 	`
 
-	err := r.db.
-		QueryRow(ctx, query, user.Email, user.Password, user.FirstName, user.LastName, user.Role).
-		Scan(&user.ID, &user.CreatedAt, &user.UpdatedAt)
+	user, err := scan(r.conn(ctx).QueryRow(ctx, query, email, password, firstName, lastName, models.RoleCustomer))
 	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return nil, domainErrors.ErrUserExists
+		}
+
 		return nil, err
 	}
 
 	return user, nil
 }
 
-func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*models.User, error) {
+func scan(row pgx.Row) (*models.User, error) {
 	var user models.User
 
-	query := `
-		SELECT *
-		FROM users
-		WHERE email = $1
-	`
-
-	err := r.db.
-		QueryRow(ctx, query, email).
-		Scan(&user.ID, &user.Email, &user.Password, &user.FirstName, &user.LastName, &user.Role, &user.CreatedAt, &user.UpdatedAt)
+	err := row.Scan(
+		&user.ID, &user.Email, &user.Password, &user.FirstName, &user.LastName, &user.CreatedAt, &user.UpdatedAt,
+		&user.BlockedAt, &user.Phone, &user.PhoneVerified, &user.AvatarKey, &user.AvatarURLs, &user.Roles, &user.Permissions,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -85,28 +94,27 @@ func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*models
 	return &user, nil
 }
 
-func (r *UserRepository) FindByID(ctx context.Context, userID string) (*models.User, error) {
-	var user models.User
+func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*models.User, error) {
+	query := `
+		SELECT ` + columns + `
+		FROM users
+		WHERE email = $1
+	`
 
+	return scan(r.conn(ctx).QueryRow(ctx, query, email))
+}
+
+func (r *UserRepository) FindByID(ctx context.Context, userID string) (*models.User, error) {
 	query := `
-		SELECT *
+		SELECT ` + columns + `
 		FROM users
 		WHERE id = $1
 	`
 
-	err := r.db.
-		QueryRow(ctx, query, userID).
-		Scan(&user.ID, &user.Email, &user.Password, &user.FirstName, &user.LastName, &user.Role, &user.CreatedAt, &user.UpdatedAt)
-	if err != nil {
-		return nil, err
-	}
-
-	return &user, err
+	return scan(r.conn(ctx).QueryRow(ctx, query, userID))
 }
 
 func (r *UserRepository) Update(ctx context.Context, userID string, newEmail, newPassword, newFirstName, newLastName *string) (*models.User, error) {
-	var user models.User
-
 	query := `
 		UPDATE users
 		SET
@@ -116,17 +124,77 @@ func (r *UserRepository) Update(ctx context.Context, userID string, newEmail, ne
 			last_name = COALESCE($5, last_name),
 			updated_at = NOW()
 		WHERE id = $1
-		RETURNING id, email, password, first_name, last_name, role, created_at, updated_at
-	`
+		RETURNING ` + columns
 
-	err := r.db.
-		QueryRow(ctx, query, userID, newEmail, newPassword, newFirstName, newLastName).
-		Scan(&user.ID, &user.Email, &user.Password, &user.FirstName, &user.LastName, &user.Role, &user.CreatedAt, &user.UpdatedAt)
+	user, err := scan(r.conn(ctx).QueryRow(ctx, query, userID, newEmail, newPassword, newFirstName, newLastName))
 	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return nil, domainErrors.ErrUserExists
+		}
+
 		return nil, err
 	}
 
-	return &user, nil
+	return user, nil
+}
+
+func (r *UserRepository) SetBlocked(ctx context.Context, userID string, blocked bool) (*models.User, error) {
+	query := `
+		UPDATE users
+		SET
+			blocked_at = CASE WHEN $2 THEN COALESCE(blocked_at, NOW()) END,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + columns
+
+	return scan(r.conn(ctx).QueryRow(ctx, query, userID, blocked))
+}
+
+func (r *UserRepository) SetPhone(ctx context.Context, userID, phone string) (*models.User, error) {
+	query := `
+		UPDATE users
+		SET
+			phone = $2,
+			phone_verified = FALSE,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + columns
+
+	return scan(r.conn(ctx).QueryRow(ctx, query, userID, phone))
+}
+
+func (r *UserRepository) VerifyPhone(ctx context.Context, userID, phone string) (*models.User, error) {
+	query := `
+		UPDATE users
+		SET
+			phone_verified = TRUE,
+			updated_at = NOW()
+		WHERE id = $1 AND phone = $2
+		RETURNING ` + columns
+
+	user, err := scan(r.conn(ctx).QueryRow(ctx, query, userID, phone))
+	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return nil, domainErrors.ErrPhoneExists
+		}
+
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *UserRepository) SetAvatar(ctx context.Context, userID, key string, urls map[string]string) (*models.User, error) {
+	query := `
+		UPDATE users
+		SET
+			avatar_key = $2,
+			avatar_urls = $3,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + columns
+
+	return scan(r.conn(ctx).QueryRow(ctx, query, userID, key, urls))
 }
 
 func (r *UserRepository) Delete(ctx context.Context, userID string) error {
@@ -135,7 +203,7 @@ func (r *UserRepository) Delete(ctx context.Context, userID string) error {
 		WHERE id = $1
 	`
 
-	if _, err := r.db.Exec(ctx, query, userID); err != nil {
+	if _, err := r.conn(ctx).Exec(ctx, query, userID); err != nil {
 		return err
 	}
 
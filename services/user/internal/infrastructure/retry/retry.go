@@ -0,0 +1,32 @@
+package retry
+
+import (
+	"context"
+	"time"
+)
+
+// Do calls fn until it succeeds or attempts run out, doubling the pause
+// after every failure. The last error from fn is returned.
+func Do(ctx context.Context, attempts int, backoff time.Duration, fn func(ctx context.Context, attempt int) error) error {
+	var err error
+
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err = fn(ctx, attempt); err == nil {
+			return nil
+		}
+
+		if attempt == attempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+	}
+
+	return err
+}
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/scope"
+	adminDesc "github.com/BlazeCoder04/online_store/services/user/pkg/admin/v1"
+	profileDesc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestScope_UnaryServerInterceptor(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		firstParty = &models.Claims{
+			Subject:     "admin",
+			Roles:       []string{models.RoleAdmin},
+			Permissions: []string{models.PermissionRolesRead},
+		}
+
+		clientRead = &models.Claims{
+			Subject:  "user",
+			ClientID: "client",
+			Scopes:   []string{models.ScopeProfileRead},
+		}
+
+		// An API key is scoped even when it was granted no scope at all.
+		apiKeyNoScope = &models.Claims{
+			Subject:  "user",
+			APIKeyID: "key",
+		}
+
+		apiKeyWrite = &models.Claims{
+			Subject:  "user",
+			Scopes:   []string{models.ScopeProfileWrite},
+			APIKeyID: "key",
+		}
+	)
+
+	tests := []struct {
+		name   string
+		method string
+		claims *models.Claims
+		code   codes.Code
+	}{
+		{
+			name:   "scope granted case",
+			method: profileDesc.ProfileV1_Get_FullMethodName,
+			claims: clientRead,
+			code:   codes.OK,
+		},
+		{
+			name:   "scope missing case",
+			method: profileDesc.ProfileV1_Update_FullMethodName,
+			claims: clientRead,
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "scoped token on unlisted method case",
+			method: adminDesc.AdminV1_ListRoles_FullMethodName,
+			claims: clientRead,
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "api key granted case",
+			method: profileDesc.ProfileV1_DeleteAddress_FullMethodName,
+			claims: apiKeyWrite,
+			code:   codes.OK,
+		},
+		{
+			name:   "api key on unlisted method case",
+			method: profileDesc.ProfileV1_CreateApiKey_FullMethodName,
+			claims: apiKeyWrite,
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "api key without scope case",
+			method: profileDesc.ProfileV1_Get_FullMethodName,
+			claims: apiKeyNoScope,
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "first-party token on unlisted method case",
+			method: adminDesc.AdminV1_ListRoles_FullMethodName,
+			claims: firstParty,
+			code:   codes.OK,
+		},
+		{
+			name:   "no claims case",
+			method: profileDesc.ProfileV1_Get_FullMethodName,
+			code:   codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqCtx := ctx
+			if tt.claims != nil {
+				reqCtx = models.ContextWithClaims(ctx, tt.claims)
+			}
+
+			called := false
+			handler := func(context.Context, any) (any, error) {
+				called = true
+
+				return "resp", nil
+			}
+
+			resp, err := scope.UnaryServerInterceptor()(reqCtx, "req", &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			require.Equal(t, tt.code, status.Code(err))
+			require.Equal(t, tt.code == codes.OK, called)
+			if tt.code == codes.OK {
+				require.Equal(t, "resp", resp)
+			}
+		})
+	}
+}
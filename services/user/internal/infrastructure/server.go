@@ -1,35 +1,57 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net"
 
 	"github.com/BlazeCoder04/online_store/libs/logger"
 	"github.com/BlazeCoder04/online_store/services/user/configs"
 	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/health"
+	admin "github.com/BlazeCoder04/online_store/services/user/internal/interfaces/handlers/admin"
 	auth "github.com/BlazeCoder04/online_store/services/user/internal/interfaces/handlers/auth"
+	oauth "github.com/BlazeCoder04/online_store/services/user/internal/interfaces/handlers/oauth"
 	profile "github.com/BlazeCoder04/online_store/services/user/internal/interfaces/handlers/profile"
+	adminDesc "github.com/BlazeCoder04/online_store/services/user/pkg/admin/v1"
 	authDesc "github.com/BlazeCoder04/online_store/services/user/pkg/auth/v1"
+	oauthDesc "github.com/BlazeCoder04/online_store/services/user/pkg/oauth/v1"
 	profileDesc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 type Server struct {
-	authHandler    *auth.AuthHandler
-	profileHandler *profile.ProfileHandler
-	logger         logger.Logger
-	cfg            *configs.Config
+	grpcServer *grpc.Server
+	logger     logger.Logger
+	cfg        *configs.Config
 }
 
-func NewServer(authHandler *auth.AuthHandler, profileHandler *profile.ProfileHandler, logger logger.Logger, cfg *configs.Config) (domain.Server, error) {
+func NewServer(authHandler *auth.AuthHandler, profileHandler *profile.ProfileHandler, oauthHandler *oauth.OAuthHandler, adminHandler *admin.AdminHandler, monitor *health.Monitor, interceptors []grpc.UnaryServerInterceptor, streamInterceptors []grpc.StreamServerInterceptor, logger logger.Logger, cfg *configs.Config) (domain.Server, error) {
 	loggerTag := "server.newServer"
 
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+
+	authDesc.RegisterAuthV1Server(grpcServer, authHandler)
+	profileDesc.RegisterProfileV1Server(grpcServer, profileHandler)
+	oauthDesc.RegisterOAuthV1Server(grpcServer, oauthHandler)
+	adminDesc.RegisterAdminV1Server(grpcServer, adminHandler)
+	healthpb.RegisterHealthServer(grpcServer, monitor.HealthServer())
+
+	monitor.Watch(authDesc.AuthV1_ServiceDesc.ServiceName, profileDesc.ProfileV1_ServiceDesc.ServiceName, oauthDesc.OAuthV1_ServiceDesc.ServiceName, adminDesc.AdminV1_ServiceDesc.ServiceName)
+
+	reflection.Register(grpcServer)
+
 	logger.Info(loggerTag, "Server initialized")
 
 	return &Server{
-		authHandler,
-		profileHandler,
+		grpcServer,
 		logger,
 		cfg,
 	}, nil
@@ -43,17 +65,37 @@ func (s *Server) Run() error {
 		Value: s.cfg.ServerPort,
 	})
 
-	server := grpc.NewServer()
-
-	authDesc.RegisterAuthV1Server(server, s.authHandler)
-	profileDesc.RegisterProfileV1Server(server, s.profileHandler)
-
-	reflection.Register(server)
-
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.cfg.ServerPort))
 	if err != nil {
 		return fmt.Errorf("failed listen: %v", err)
 	}
 
-	return server.Serve(listener)
+	return s.grpcServer.Serve(listener)
+}
+
+// Shutdown stops accepting new RPCs and waits for in-flight ones to finish.
+// Once ctx expires the remaining RPCs are cancelled.
+func (s *Server) Shutdown(ctx context.Context) error {
+	loggerTag := "server.shutdown"
+
+	s.logger.Info(loggerTag, "Draining the server")
+
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		s.logger.Info(loggerTag, "The server has been drained")
+
+		return nil
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+
+		s.logger.Warn(loggerTag, "Drain deadline exceeded, in-flight RPCs were cancelled")
+
+		return ctx.Err()
+	}
 }
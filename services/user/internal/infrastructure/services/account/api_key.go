@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainService "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/apikey"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *AccountService) CreateServiceAPIKey(ctx context.Context, args *domainService.CreateServiceAPIKeyArgs) (*models.APIKey, string, error) {
+	loggerTag := "account.service.createServiceAPIKey"
+
+	for _, scope := range args.Scopes {
+		if !slices.Contains(models.Scopes, scope) {
+			return nil, "", ErrScopeInvalid
+		}
+	}
+
+	if args.ExpiresIn < 0 {
+		return nil, "", ErrAPIKeyExpiryInvalid
+	}
+
+	key, prefix, secretHash := apikey.Generate()
+
+	apiKey := &models.APIKey{
+		Service:    args.Service,
+		Name:       args.Name,
+		Prefix:     prefix,
+		SecretHash: secretHash,
+		Scopes:     args.Scopes,
+	}
+	if args.ExpiresIn > 0 {
+		expiresAt := time.Now().Add(args.ExpiresIn)
+		apiKey.ExpiresAt = &expiresAt
+	}
+
+	created, err := s.apiKeyRepo.Create(ctx, apiKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed create api key: %v", err)
+	}
+
+	s.logger.Info(loggerTag, "API key created", logger.Field{
+		Key:   "api_key_id",
+		Value: created.ID.String(),
+	})
+
+	return created, key, nil
+}
+
+func (s *AccountService) ListServiceAPIKeys(ctx context.Context, service string) ([]*models.APIKey, error) {
+	keys, err := s.apiKeyRepo.FindByService(ctx, service)
+	if err != nil {
+		return nil, fmt.Errorf("failed find api keys: %v", err)
+	}
+
+	return keys, nil
+}
+
+func (s *AccountService) RevokeAPIKey(ctx context.Context, keyID string) (*models.APIKey, error) {
+	loggerTag := "account.service.revokeAPIKey"
+
+	if _, err := uuid.Parse(keyID); err != nil {
+		return nil, ErrAPIKeyNotFound
+	}
+
+	revoked, err := s.apiKeyRepo.Revoke(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAPIKeyNotFound
+		}
+
+		return nil, fmt.Errorf("failed revoke api key: %v", err)
+	}
+
+	s.logger.Info(loggerTag, "API key revoked", logger.Field{
+		Key:   "api_key_id",
+		Value: revoked.ID.String(),
+	})
+
+	return revoked, nil
+}
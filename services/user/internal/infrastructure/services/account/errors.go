@@ -0,0 +1,20 @@
+package services
+
+import (
+	"errors"
+
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+)
+
+var (
+	ErrUserNotFound = errors.New("user.not_found")
+	ErrUserExists   = domainErrors.ErrUserExists
+
+	ErrGrantTypeUnsupported = domainErrors.ErrGrantTypeUnsupported
+	ErrScopeInvalid         = domainErrors.ErrScopeInvalid
+	ErrRedirectURIInvalid   = domainErrors.ErrRedirectURIInvalid
+	ErrClientUnauthorized   = domainErrors.ErrClientUnauthorized
+
+	ErrAPIKeyNotFound      = domainErrors.ErrAPIKeyNotFound
+	ErrAPIKeyExpiryInvalid = domainErrors.ErrAPIKeyExpiryInvalid
+)
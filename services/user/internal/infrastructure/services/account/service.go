@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/BlazeCoder04/online_store/libs/hash"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	domainRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories"
+	domainService "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type AccountService struct {
+	userRepo        domainRepo.UserRepository
+	oauthClientRepo domainRepo.OAuthClientRepository
+	apiKeyRepo      domainRepo.APIKeyRepository
+	roleRepo        domainRepo.RoleRepository
+	txManager       domainRepo.TxManager
+	tokenAdapter    domainAdapter.TokenAdapter
+	logger          logger.Logger
+}
+
+func NewAccountService(userRepo domainRepo.UserRepository, oauthClientRepo domainRepo.OAuthClientRepository, apiKeyRepo domainRepo.APIKeyRepository, roleRepo domainRepo.RoleRepository, txManager domainRepo.TxManager, tokenAdapter domainAdapter.TokenAdapter, logger logger.Logger) (domainService.AccountService, error) {
+	loggerTag := "account.service.newAccountService"
+
+	logger.Debug(loggerTag, "Account service initialized")
+
+	return &AccountService{
+		userRepo,
+		oauthClientRepo,
+		apiKeyRepo,
+		roleRepo,
+		txManager,
+		tokenAdapter,
+		logger,
+	}, nil
+}
+
+func (s *AccountService) CreateAdmin(ctx context.Context, email, password, firstName, lastName string) (*models.User, error) {
+	loggerTag := "account.service.createAdmin"
+
+	email = strings.ToLower(email)
+
+	hashedPassword, err := hash.HashPassword(password)
+	if err != nil {
+		return nil, fmt.Errorf("failed hash password: %v", err)
+	}
+
+	var user *models.User
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		existedUser, err := s.userRepo.FindByEmail(ctx, email)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed find user: %v", err)
+		}
+		if existedUser != nil {
+			return ErrUserExists
+		}
+
+		created, err := s.userRepo.Create(ctx, email, hashedPassword, firstName, lastName)
+		if err != nil {
+			if errors.Is(err, ErrUserExists) {
+				return ErrUserExists
+			}
+
+			return fmt.Errorf("failed create user: %v", err)
+		}
+
+		if err = s.roleRepo.Assign(ctx, created.ID.String(), models.RoleAdmin); err != nil {
+			return fmt.Errorf("failed assign role: %v", err)
+		}
+
+		user, err = s.userRepo.FindByID(ctx, created.ID.String())
+		if err != nil {
+			return fmt.Errorf("failed find user: %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(loggerTag, "Admin created", logger.Field{
+		Key:   "user_id",
+		Value: user.ID.String(),
+	})
+
+	return user, nil
+}
+
+// Block also revokes the refresh token, so the user is signed out once the
+// current access token expires.
+func (s *AccountService) Block(ctx context.Context, user string) (*models.User, error) {
+	loggerTag := "account.service.block"
+
+	found, err := s.find(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, err := s.userRepo.SetBlocked(ctx, found.ID.String(), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed block user: %v", err)
+	}
+
+	if err = s.tokenAdapter.Del(ctx, blocked.ID.String()); err != nil {
+		return nil, fmt.Errorf("failed delete refresh token to redis: %v", err)
+	}
+
+	s.logger.Info(loggerTag, "User blocked", logger.Field{
+		Key:   "user_id",
+		Value: blocked.ID.String(),
+	})
+
+	return blocked, nil
+}
+
+func (s *AccountService) Unblock(ctx context.Context, user string) (*models.User, error) {
+	loggerTag := "account.service.unblock"
+
+	found, err := s.find(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	unblocked, err := s.userRepo.SetBlocked(ctx, found.ID.String(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed unblock user: %v", err)
+	}
+
+	s.logger.Info(loggerTag, "User unblocked", logger.Field{
+		Key:   "user_id",
+		Value: unblocked.ID.String(),
+	})
+
+	return unblocked, nil
+}
+
+func (s *AccountService) find(ctx context.Context, user string) (*models.User, error) {
+	var (
+		found *models.User
+		err   error
+	)
+
+	if _, parseErr := uuid.Parse(user); parseErr == nil {
+		found, err = s.userRepo.FindByID(ctx, user)
+	} else {
+		found, err = s.userRepo.FindByEmail(ctx, strings.ToLower(user))
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed find user: %v", err)
+	}
+
+	return found, nil
+}
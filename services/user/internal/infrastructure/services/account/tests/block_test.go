@@ -0,0 +1,199 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
+	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/account"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountService_Block(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		user string
+	}
+
+	type expect struct {
+		err  error
+		user *models.User
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID = uuid.New()
+		email  = "test1@test.ru"
+
+		blockedAt = time.Now()
+
+		baseUser = &models.User{
+			ID:    userID,
+			Email: email,
+			Roles: []string{models.RoleCustomer},
+		}
+
+		blockedUser = &models.User{
+			ID:        userID,
+			Email:     email,
+			Roles:     []string{models.RoleCustomer},
+			BlockedAt: &blockedAt,
+		}
+
+		errRedis = errors.New("connection refused")
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		mock   func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter)
+		expect expect
+	}{
+		{
+			name: "success by id case",
+			args: args{
+				ctx:  ctx,
+				user: userID.String(),
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(baseUser, nil)
+
+				userRepo.EXPECT().
+					SetBlocked(ctx, userID.String(), true).
+					Return(blockedUser, nil)
+
+				tokenAdapter.EXPECT().
+					Del(ctx, userID.String()).
+					Return(nil)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				err:  nil,
+				user: blockedUser,
+			},
+		},
+		{
+			name: "success by email case",
+			args: args{
+				ctx:  ctx,
+				user: "Test1@Test.ru",
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				userRepo.EXPECT().
+					FindByEmail(ctx, email).
+					Return(baseUser, nil)
+
+				userRepo.EXPECT().
+					SetBlocked(ctx, userID.String(), true).
+					Return(blockedUser, nil)
+
+				tokenAdapter.EXPECT().
+					Del(ctx, userID.String()).
+					Return(nil)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				err:  nil,
+				user: blockedUser,
+			},
+		},
+		{
+			name: "user not found case",
+			args: args{
+				ctx:  ctx,
+				user: email,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				userRepo.EXPECT().
+					FindByEmail(ctx, email).
+					Return(nil, pgx.ErrNoRows)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				err:  services.ErrUserNotFound,
+				user: nil,
+			},
+		},
+		{
+			name: "revoke token failed case",
+			args: args{
+				ctx:  ctx,
+				user: userID.String(),
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(baseUser, nil)
+
+				userRepo.EXPECT().
+					SetBlocked(ctx, userID.String(), true).
+					Return(blockedUser, nil)
+
+				tokenAdapter.EXPECT().
+					Del(ctx, userID.String()).
+					Return(errRedis)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				err:  errRedis,
+				user: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo, tokenAdapter := tt.mock(ctrl)
+
+			log, _ := logger.NewAdapter(&logger.Config{
+				Level: logger.LevelError,
+			})
+
+			accountService, _ := services.NewAccountService(userRepo, nil, nil, nil, nil, tokenAdapter, log)
+
+			user, err := accountService.Block(tt.args.ctx, tt.args.user)
+
+			if tt.expect.err != nil {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tt.expect.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.expect.user, user)
+		})
+	}
+}
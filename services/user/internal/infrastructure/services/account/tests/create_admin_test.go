@@ -0,0 +1,174 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BlazeCoder04/online_store/libs/hash"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
+	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/account"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountService_CreateAdmin(t *testing.T) {
+	type args struct {
+		ctx       context.Context
+		email     string
+		password  string
+		firstName string
+		lastName  string
+	}
+
+	type expect struct {
+		err  error
+		user *models.User
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID    = uuid.New()
+		email     = "admin@test.ru"
+		password  = gofakeit.Password(true, true, true, true, false, 12)
+		firstName = gofakeit.FirstName()
+		lastName  = gofakeit.LastName()
+
+		createdUser = &models.User{
+			ID:        userID,
+			Email:     email,
+			FirstName: firstName,
+			LastName:  lastName,
+			Roles:     []string{models.RoleCustomer},
+		}
+
+		adminUser = &models.User{
+			ID:        userID,
+			Email:     email,
+			FirstName: firstName,
+			LastName:  lastName,
+			Roles:     []string{models.RoleAdmin, models.RoleCustomer},
+		}
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		mock   func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksRepo.MockRoleRepository, *mocksRepo.MockTxManager)
+		expect expect
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx:       ctx,
+				email:     "Admin@Test.ru",
+				password:  password,
+				firstName: firstName,
+				lastName:  lastName,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksRepo.MockRoleRepository, *mocksRepo.MockTxManager) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				roleRepo := mocksRepo.NewMockRoleRepository(ctrl)
+				txManager := mocksRepo.NewMockTxManager(ctrl)
+
+				txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
+				userRepo.EXPECT().
+					FindByEmail(ctx, email).
+					Return(nil, pgx.ErrNoRows)
+
+				userRepo.EXPECT().
+					Create(ctx, email, gomock.Any(), firstName, lastName).
+					DoAndReturn(func(_ context.Context, _, hashedPassword, _, _ string) (*models.User, error) {
+						require.NoError(t, hash.ComparePassword(hashedPassword, password))
+
+						return createdUser, nil
+					})
+
+				roleRepo.EXPECT().
+					Assign(ctx, userID.String(), models.RoleAdmin).
+					Return(nil)
+
+				userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(adminUser, nil)
+
+				return userRepo, roleRepo, txManager
+			},
+			expect: expect{
+				err:  nil,
+				user: adminUser,
+			},
+		},
+		{
+			name: "user exists case",
+			args: args{
+				ctx:       ctx,
+				email:     email,
+				password:  password,
+				firstName: firstName,
+				lastName:  lastName,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksRepo.MockRoleRepository, *mocksRepo.MockTxManager) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				roleRepo := mocksRepo.NewMockRoleRepository(ctrl)
+				txManager := mocksRepo.NewMockTxManager(ctrl)
+
+				txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
+				userRepo.EXPECT().
+					FindByEmail(ctx, email).
+					Return(createdUser, nil)
+
+				return userRepo, roleRepo, txManager
+			},
+			expect: expect{
+				err:  services.ErrUserExists,
+				user: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo, roleRepo, txManager := tt.mock(ctrl)
+
+			log, _ := logger.NewAdapter(&logger.Config{
+				Level: logger.LevelError,
+			})
+
+			accountService, _ := services.NewAccountService(userRepo, nil, nil, roleRepo, txManager, mocksAdapter.NewMockTokenAdapter(ctrl), log)
+
+			user, err := accountService.CreateAdmin(tt.args.ctx, tt.args.email, tt.args.password, tt.args.firstName, tt.args.lastName)
+
+			if tt.expect.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.expect.err.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.expect.user, user)
+		})
+	}
+}
+
+func runWithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
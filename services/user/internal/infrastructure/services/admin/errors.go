@@ -0,0 +1,16 @@
+package services
+
+import (
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+)
+
+var (
+	ErrUserNotFound = domainErrors.ErrUserNotFound
+	ErrTokenInvalid = domainErrors.ErrTokenInvalid
+	ErrUserBlocked  = domainErrors.ErrUserBlocked
+
+	ErrImpersonationRefused = domainErrors.ErrImpersonationRefused
+
+	ErrRoleNotFound    = domainErrors.ErrRoleNotFound
+	ErrRoleNotAssigned = domainErrors.ErrRoleNotAssigned
+)
@@ -0,0 +1,237 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	domainRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories"
+	domainService "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgx/v5"
+)
+
+type AdminService struct {
+	userRepo     domainRepo.UserRepository
+	roleRepo     domainRepo.RoleRepository
+	tokenAdapter domainAdapter.TokenAdapter
+	logger       logger.Logger
+	cfg          configs.Provider
+}
+
+func NewAdminService(userRepo domainRepo.UserRepository, roleRepo domainRepo.RoleRepository, tokenAdapter domainAdapter.TokenAdapter, logger logger.Logger, cfg configs.Provider) (domainService.AdminService, error) {
+	loggerTag := "admin.service.newAdminService"
+
+	logger.Info(loggerTag, "Admin service initialized")
+
+	return &AdminService{
+		userRepo,
+		roleRepo,
+		tokenAdapter,
+		logger,
+		cfg,
+	}, nil
+}
+
+// verifyToken checks the access token and that the session it belongs to
+// is still live, and returns the ID of the user it was issued to. Only
+// first-party sessions reach this far; the scope interceptor refuses tokens
+// of OAuth clients and API keys. An admin acting as a user cannot use the
+// user's permissions, nor impersonate from there.
+func (s *AdminService) verifyToken(ctx context.Context, accessToken string) (string, error) {
+	loggerTag := "admin.service.verifyToken"
+
+	claims, err := jwt.Verify(accessToken, s.cfg.Current().AccessTokenPublicKey)
+	if err != nil {
+		return "", ErrTokenInvalid
+	}
+
+	if models.ActorID(claims) != "" {
+		return "", ErrImpersonationRefused
+	}
+
+	userID, _ := claims["sub"].(string)
+
+	if _, err := s.tokenAdapter.Get(ctx, models.SessionKey("", userID)); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", ErrTokenInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed get refresh token from redis: %v", err))
+
+		return "", err
+	}
+
+	return userID, nil
+}
+
+func (s *AdminService) ListRoles(ctx context.Context, accessToken string) ([]*models.Role, error) {
+	loggerTag := "admin.service.listRoles"
+
+	if _, err := s.verifyToken(ctx, accessToken); err != nil {
+		return nil, err
+	}
+
+	roles, err := s.roleRepo.FindAll(ctx)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find roles: %v", err))
+
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (s *AdminService) GetUser(ctx context.Context, userID, accessToken string) (*models.User, error) {
+	if _, err := s.verifyToken(ctx, accessToken); err != nil {
+		return nil, err
+	}
+
+	return s.findUser(ctx, userID)
+}
+
+func (s *AdminService) AssignRole(ctx context.Context, userID, role, accessToken string) error {
+	loggerTag := "admin.service.assignRole"
+
+	adminID, err := s.verifyToken(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.findUser(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := s.roleRepo.Assign(ctx, userID, role); err != nil {
+		if errors.Is(err, ErrRoleNotFound) {
+			return err
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed assign role: %v", err))
+
+		return err
+	}
+
+	s.logger.InfoCtx(ctx, loggerTag, "Role assigned", roleFields(adminID, userID, role)...)
+
+	return nil
+}
+
+func (s *AdminService) RevokeRole(ctx context.Context, userID, role, accessToken string) error {
+	loggerTag := "admin.service.revokeRole"
+
+	adminID, err := s.verifyToken(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.findUser(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := s.roleRepo.Revoke(ctx, userID, role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrRoleNotAssigned
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed revoke role: %v", err))
+
+		return err
+	}
+
+	s.logger.InfoCtx(ctx, loggerTag, "Role revoked", roleFields(adminID, userID, role)...)
+
+	return nil
+}
+
+func (s *AdminService) Impersonate(ctx context.Context, userID, accessToken string) (string, time.Duration, error) {
+	loggerTag := "admin.service.impersonate"
+
+	adminID, err := s.verifyToken(ctx, accessToken)
+	if err != nil {
+		return "", 0, err
+	}
+
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if user.Blocked() {
+		return "", 0, ErrUserBlocked
+	}
+
+	// The token grants what the user's roles grant, so acting as a user
+	// with more than a customer's would let support gain an admin's.
+	if privileged(user) {
+		return "", 0, ErrImpersonationRefused
+	}
+
+	cfg := s.cfg.Current()
+
+	token, err := jwt.CreateWithClaims(cfg.ImpersonationTokenExpiresIn, models.ImpersonationClaims(user, adminID), cfg.AccessTokenPrivateKey)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed create access token: %v", err))
+
+		return "", 0, err
+	}
+
+	s.logger.InfoCtx(ctx, loggerTag, "Impersonation started", logger.Field{
+		Key:   "actor_id",
+		Value: adminID,
+	}, logger.Field{
+		Key:   "user_id",
+		Value: userID,
+	}, logger.Field{
+		Key:   "expires_in",
+		Value: cfg.ImpersonationTokenExpiresIn.String(),
+	})
+
+	return token, cfg.ImpersonationTokenExpiresIn, nil
+}
+
+func (s *AdminService) findUser(ctx context.Context, userID string) (*models.User, error) {
+	loggerTag := "admin.service.findUser"
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
+
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// privileged reports whether user holds a role other than customer or any
+// permission, and so cannot be impersonated.
+func privileged(user *models.User) bool {
+	if len(user.Permissions) > 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(user.Roles, func(role string) bool {
+		return role != models.RoleCustomer
+	})
+}
+
+// roleFields record who changed the roles of whom, since the change only
+// reaches the user's tokens when they are next refreshed.
+func roleFields(adminID, userID, role string) []logger.Field {
+	return []logger.Field{
+		{Key: "admin_id", Value: adminID},
+		{Key: "user_id", Value: userID},
+		{Key: "role", Value: role},
+	}
+}
@@ -0,0 +1,204 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/logger/observer"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/admin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminService_Impersonate(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		adminID = uuid.New()
+		userID  = uuid.New()
+
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		impersonationTokenExpiresIn                    = 15 * time.Minute
+
+		admin = &models.User{
+			ID:          adminID,
+			Roles:       []string{models.RoleAdmin},
+			Permissions: []string{models.PermissionUsersImpersonate},
+		}
+
+		accessToken, _ = jwt.CreateWithClaims(15*time.Minute, models.UserClaims(admin), accessTokenPrivateKey)
+
+		user = &models.User{
+			ID:    userID,
+			Email: "test1@test.ru",
+			Roles: []string{models.RoleCustomer},
+		}
+
+		sellerUser = &models.User{
+			ID:          userID,
+			Email:       "test1@test.ru",
+			Roles:       []string{models.RoleCustomer, "seller"},
+			Permissions: []string{"catalog:write"},
+		}
+
+		adminUser = &models.User{
+			ID:          userID,
+			Email:       "test1@test.ru",
+			Roles:       []string{models.RoleAdmin},
+			Permissions: []string{models.PermissionRolesAssign, models.PermissionUsersImpersonate},
+		}
+
+		blockedAt   = time.Now()
+		blockedUser = &models.User{
+			ID:        userID,
+			Email:     "test1@test.ru",
+			Roles:     []string{models.RoleCustomer},
+			BlockedAt: &blockedAt,
+		}
+
+		// A token issued by impersonation cannot impersonate in turn, even
+		// when the user acted as has the permission.
+		impersonationToken, _ = jwt.CreateWithClaims(15*time.Minute, models.ImpersonationClaims(admin, uuid.NewString()), accessTokenPrivateKey)
+	)
+
+	tests := []struct {
+		name        string
+		accessToken string
+		mock        func(m *roleMocks)
+		err         error
+	}{
+		{
+			name:        "success case",
+			accessToken: accessToken,
+			mock: func(m *roleMocks) {
+				m.tokenAdapter.EXPECT().
+					Get(ctx, adminID.String()).
+					Return("refresh", nil)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(user, nil)
+			},
+		},
+		{
+			name:        "user not found case",
+			accessToken: accessToken,
+			mock: func(m *roleMocks) {
+				m.tokenAdapter.EXPECT().
+					Get(ctx, adminID.String()).
+					Return("refresh", nil)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(nil, pgx.ErrNoRows)
+			},
+			err: services.ErrUserNotFound,
+		},
+		{
+			name:        "user blocked case",
+			accessToken: accessToken,
+			mock: func(m *roleMocks) {
+				m.tokenAdapter.EXPECT().
+					Get(ctx, adminID.String()).
+					Return("refresh", nil)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(blockedUser, nil)
+			},
+			err: services.ErrUserBlocked,
+		},
+		{
+			name:        "admin target case",
+			accessToken: accessToken,
+			mock: func(m *roleMocks) {
+				m.tokenAdapter.EXPECT().
+					Get(ctx, adminID.String()).
+					Return("refresh", nil)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(adminUser, nil)
+			},
+			err: services.ErrImpersonationRefused,
+		},
+		{
+			name:        "seller target case",
+			accessToken: accessToken,
+			mock: func(m *roleMocks) {
+				m.tokenAdapter.EXPECT().
+					Get(ctx, adminID.String()).
+					Return("refresh", nil)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(sellerUser, nil)
+			},
+			err: services.ErrImpersonationRefused,
+		},
+		{
+			name:        "impersonation token case",
+			accessToken: impersonationToken,
+			mock:        func(_ *roleMocks) {},
+			err:         services.ErrImpersonationRefused,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newRoleMocks(ctrl)
+			tt.mock(m)
+
+			cfg := &configs.Config{
+				AccessTokenPrivateKey:       accessTokenPrivateKey,
+				AccessTokenPublicKey:        accessTokenPublicKey,
+				ImpersonationTokenExpiresIn: impersonationTokenExpiresIn,
+			}
+
+			log := observer.New(logger.LevelInfo)
+
+			adminService, _ := services.NewAdminService(m.userRepo, m.roleRepo, m.tokenAdapter, log, cfg)
+
+			token, expiresIn, err := adminService.Impersonate(ctx, userID.String(), tt.accessToken)
+
+			observer.RequireNotLogged(t, log, logger.LevelError)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				require.Empty(t, token)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, impersonationTokenExpiresIn, expiresIn)
+
+			claims, err := jwt.Verify(token, accessTokenPublicKey)
+			require.NoError(t, err)
+			require.Equal(t, userID.String(), claims["sub"])
+			require.Equal(t, adminID.String(), models.ActorID(claims))
+			require.Equal(t, []any{models.RoleCustomer}, claims["roles"])
+			require.Empty(t, claims["permissions"])
+
+			entry := observer.RequireLogged(t, log, logger.LevelInfo, "admin.service.impersonate", "Impersonation started")
+			actorID, _ := entry.Field("actor_id")
+			require.Equal(t, adminID.String(), actorID)
+			loggedUserID, _ := entry.Field("user_id")
+			require.Equal(t, userID.String(), loggedUserID)
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/logger/observer"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
+	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/admin"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type roleMocks struct {
+	userRepo     *mocksRepo.MockUserRepository
+	roleRepo     *mocksRepo.MockRoleRepository
+	tokenAdapter *mocksAdapter.MockTokenAdapter
+}
+
+func newRoleMocks(ctrl *gomock.Controller) *roleMocks {
+	return &roleMocks{
+		userRepo:     mocksRepo.NewMockUserRepository(ctrl),
+		roleRepo:     mocksRepo.NewMockRoleRepository(ctrl),
+		tokenAdapter: mocksAdapter.NewMockTokenAdapter(ctrl),
+	}
+}
+
+func TestAdminService_AssignRole(t *testing.T) {
+	type args struct {
+		role string
+	}
+
+	var (
+		ctx = context.Background()
+
+		adminID = uuid.New()
+		userID  = uuid.New()
+
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+
+		accessToken, _ = jwt.CreateWithClaims(15*time.Minute, models.UserClaims(&models.User{
+			ID:          adminID,
+			Roles:       []string{models.RoleAdmin},
+			Permissions: []string{models.PermissionRolesRead, models.PermissionRolesAssign},
+		}), accessTokenPrivateKey)
+
+		user = &models.User{
+			ID:    userID,
+			Email: "test1@test.ru",
+			Roles: []string{models.RoleCustomer},
+		}
+
+		errDB = errors.New("db")
+	)
+
+	// session expects the admin's session to be live.
+	session := func(m *roleMocks) {
+		m.tokenAdapter.EXPECT().
+			Get(ctx, adminID.String()).
+			Return("refresh", nil)
+	}
+
+	tests := []struct {
+		name   string
+		args   args
+		mock   func(m *roleMocks)
+		logged bool
+		err    error
+	}{
+		{
+			name: "success case",
+			args: args{
+				role: "seller",
+			},
+			mock: func(m *roleMocks) {
+				session(m)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(user, nil)
+
+				m.roleRepo.EXPECT().
+					Assign(ctx, userID.String(), "seller").
+					Return(nil)
+			},
+		},
+		{
+			name: "session logged out case",
+			args: args{
+				role: "seller",
+			},
+			mock: func(m *roleMocks) {
+				m.tokenAdapter.EXPECT().
+					Get(ctx, adminID.String()).
+					Return("", redis.Nil)
+			},
+			err: services.ErrTokenInvalid,
+		},
+		{
+			name: "user not found case",
+			args: args{
+				role: "seller",
+			},
+			mock: func(m *roleMocks) {
+				session(m)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(nil, pgx.ErrNoRows)
+			},
+			err: services.ErrUserNotFound,
+		},
+		{
+			name: "role not found case",
+			args: args{
+				role: "owner",
+			},
+			mock: func(m *roleMocks) {
+				session(m)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(user, nil)
+
+				m.roleRepo.EXPECT().
+					Assign(ctx, userID.String(), "owner").
+					Return(domainErrors.ErrRoleNotFound)
+			},
+			err: services.ErrRoleNotFound,
+		},
+		{
+			name: "database error case",
+			args: args{
+				role: "seller",
+			},
+			mock: func(m *roleMocks) {
+				session(m)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(user, nil)
+
+				m.roleRepo.EXPECT().
+					Assign(ctx, userID.String(), "seller").
+					Return(errDB)
+			},
+			logged: true,
+			err:    errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newRoleMocks(ctrl)
+			tt.mock(m)
+
+			cfg := &configs.Config{
+				AccessTokenPublicKey: accessTokenPublicKey,
+			}
+
+			log := observer.New(logger.LevelError)
+
+			adminService, _ := services.NewAdminService(m.userRepo, m.roleRepo, m.tokenAdapter, log, cfg)
+
+			err := adminService.AssignRole(ctx, userID.String(), tt.args.role, accessToken)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.logged {
+				observer.RequireLogged(t, log, logger.LevelError, "admin.service.assignRole", "failed assign role")
+			} else {
+				observer.RequireNotLogged(t, log, logger.LevelError)
+			}
+		})
+	}
+}
+
+func TestAdminService_RevokeRole(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		adminID = uuid.New()
+		userID  = uuid.New()
+
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+
+		accessToken, _ = jwt.CreateWithClaims(15*time.Minute, models.UserClaims(&models.User{
+			ID:          adminID,
+			Roles:       []string{models.RoleAdmin},
+			Permissions: []string{models.PermissionRolesRead, models.PermissionRolesAssign},
+		}), accessTokenPrivateKey)
+
+		user = &models.User{
+			ID:    userID,
+			Email: "test1@test.ru",
+			Roles: []string{models.RoleCustomer},
+		}
+	)
+
+	tests := []struct {
+		name string
+		mock func(m *roleMocks)
+		err  error
+	}{
+		{
+			name: "success case",
+			mock: func(m *roleMocks) {
+				m.roleRepo.EXPECT().
+					Revoke(ctx, userID.String(), models.RoleCustomer).
+					Return(nil)
+			},
+		},
+		{
+			name: "role not assigned case",
+			mock: func(m *roleMocks) {
+				m.roleRepo.EXPECT().
+					Revoke(ctx, userID.String(), models.RoleCustomer).
+					Return(pgx.ErrNoRows)
+			},
+			err: services.ErrRoleNotAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newRoleMocks(ctrl)
+
+			m.tokenAdapter.EXPECT().
+				Get(ctx, adminID.String()).
+				Return("refresh", nil)
+
+			m.userRepo.EXPECT().
+				FindByID(ctx, userID.String()).
+				Return(user, nil)
+
+			tt.mock(m)
+
+			cfg := &configs.Config{
+				AccessTokenPublicKey: accessTokenPublicKey,
+			}
+
+			log := observer.New(logger.LevelError)
+
+			adminService, _ := services.NewAdminService(m.userRepo, m.roleRepo, m.tokenAdapter, log, cfg)
+
+			err := adminService.RevokeRole(ctx, userID.String(), models.RoleCustomer, accessToken)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			observer.RequireNotLogged(t, log, logger.LevelError)
+		})
+	}
+}
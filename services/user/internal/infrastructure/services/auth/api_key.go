@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/apikey"
+	"github.com/jackc/pgx/v5"
+)
+
+// AuthenticateAPIKey answers a key that is malformed, unknown, revoked or
+// expired the same, so callers cannot probe which keys exist. A user key
+// acts as its user, limited to its scopes rather than granted the
+// permissions of the user's roles, and stops working while the user is
+// blocked.
+func (s *AuthService) AuthenticateAPIKey(ctx context.Context, key string) (*models.Claims, error) {
+	loggerTag := "auth.service.authenticateAPIKey"
+
+	prefix, secret, ok := apikey.Parse(key)
+	if !ok {
+		return nil, ErrAPIKeyInvalid
+	}
+
+	apiKey, err := s.apiKeyRepo.FindByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAPIKeyInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find api key: %v", err))
+
+		return nil, err
+	}
+
+	if !apikey.Matches(secret, apiKey.SecretHash) || !apiKey.Usable(time.Now()) {
+		return nil, ErrAPIKeyInvalid
+	}
+
+	claims := &models.Claims{
+		Subject:  apiKey.Subject(),
+		Scopes:   apiKey.Scopes,
+		APIKeyID: apiKey.ID.String(),
+	}
+
+	if apiKey.UserID != nil {
+		user, err := s.userRepo.FindByID(ctx, apiKey.UserID.String())
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, ErrAPIKeyInvalid
+			}
+
+			s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
+
+			return nil, err
+		}
+
+		if user.Blocked() {
+			return nil, ErrUserBlocked
+		}
+
+		claims.Roles = user.Roles
+	}
+
+	// Last use is only informational, a failed write does not fail the
+	// request.
+	if err = s.apiKeyRepo.Touch(ctx, apiKey.ID.String()); err != nil {
+		s.logger.WarnCtx(ctx, loggerTag, fmt.Sprintf("failed touch api key: %v", err))
+	}
+
+	return claims, nil
+}
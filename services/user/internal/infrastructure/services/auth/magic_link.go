@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgx/v5"
+)
+
+const magicLinkSubject = "Your sign-in link"
+
+func hashNonce(nonce string) string {
+	sum := sha256.Sum256([]byte(nonce))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// RequestMagicLink answers the same whether or not a user has the email, so
+// it cannot be used to find out who has an account. Blocked users get no
+// link.
+func (s *AuthService) RequestMagicLink(ctx context.Context, email string) (string, error) {
+	loggerTag := "auth.service.requestMagicLink"
+
+	email = strings.ToLower(email)
+	nonce := oauthSecret()
+
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nonce, nil
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
+
+		return "", err
+	}
+
+	if user.Blocked() {
+		return nonce, nil
+	}
+
+	// One snapshot, so the link says how long it is valid for.
+	cfg := s.cfg.Current()
+
+	link, err := url.Parse(cfg.MagicLinkURL)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed parse magic link url: %v", err))
+
+		return "", err
+	}
+
+	token := oauthSecret()
+	magicLink := &domainAdapter.MagicLink{
+		UserID:    user.ID.String(),
+		NonceHash: hashNonce(nonce),
+	}
+
+	if err = s.magicLinkAdapter.Set(ctx, token, magicLink, cfg.MagicLinkExpiresIn); err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed add magic link to redis: %v", err))
+
+		return "", err
+	}
+
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	body := fmt.Sprintf("Sign in to the store: %s\n\nThe link expires in %s and only works on the device it was requested from. If you did not ask for it, ignore this email.", link, cfg.MagicLinkExpiresIn)
+	if err = s.emailSender.Send(ctx, user.Email, magicLinkSubject, body); err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed send email: %v", err))
+
+		return "", err
+	}
+
+	return nonce, nil
+}
+
+// ConsumeMagicLink takes the link before checking the nonce, so a link
+// opened on another device is spent and cannot be retried.
+func (s *AuthService) ConsumeMagicLink(ctx context.Context, token, nonce string) (*models.User, string, string, error) {
+	loggerTag := "auth.service.consumeMagicLink"
+
+	magicLink, err := s.magicLinkAdapter.Take(ctx, token)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			s.metrics.LoginFailed("magic_link_invalid")
+
+			return nil, "", "", ErrMagicLinkInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed take magic link from redis: %v", err))
+
+		return nil, "", "", err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashNonce(nonce)), []byte(magicLink.NonceHash)) != 1 {
+		s.metrics.LoginFailed("magic_link_invalid")
+
+		return nil, "", "", ErrMagicLinkInvalid
+	}
+
+	user, err := s.userRepo.FindByID(ctx, magicLink.UserID)
+	if err != nil {
+		// The account was deleted after the link was sent.
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, "", "", ErrMagicLinkInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
+
+		return nil, "", "", err
+	}
+
+	if user.Blocked() {
+		s.metrics.LoginFailed("user_blocked")
+
+		return nil, "", "", ErrUserBlocked
+	}
+
+	accessToken, refreshToken, err := s.generateAndStoreTokens(ctx, user)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return user, accessToken, refreshToken, nil
+}
@@ -0,0 +1,262 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgx/v5"
+)
+
+// oauthSecretBytes is the entropy of the state, nonce and PKCE verifier. 32
+// bytes encode to a 43-character verifier, the shortest RFC 7636 allows.
+const oauthSecretBytes = 32
+
+func oauthSecret() string {
+	b := make([]byte, oauthSecretBytes)
+	rand.Read(b)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func (s *AuthService) OAuthAuthorize(ctx context.Context, providerName, accessToken string) (string, string, error) {
+	loggerTag := "auth.service.oauthAuthorize"
+
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return "", "", ErrProviderUnknown
+	}
+
+	var linkUserID string
+	if accessToken != "" {
+		var err error
+		if linkUserID, err = s.linkingUserID(ctx, accessToken); err != nil {
+			return "", "", err
+		}
+	}
+
+	state := oauthSecret()
+	browserNonce := oauthSecret()
+	oauthState := &domainAdapter.OAuthState{
+		Provider:         providerName,
+		Nonce:            oauthSecret(),
+		CodeVerifier:     oauthSecret(),
+		BrowserNonceHash: hashNonce(browserNonce),
+		LinkUserID:       linkUserID,
+	}
+
+	if err := s.oauthStateAdapter.Set(ctx, state, oauthState, s.cfg.Current().OAuthStateExpiresIn); err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed add oauth state to redis: %v", err))
+
+		return "", "", err
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, oauthState.Nonce, oauthState.CodeVerifier)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed build authorization url: %v", err))
+
+		return "", "", err
+	}
+
+	return authURL, browserNonce, nil
+}
+
+// OAuthLogin takes the state before checking the browser nonce, so a
+// callback opened in another browser spends the state and cannot be
+// retried, like a magic link.
+func (s *AuthService) OAuthLogin(ctx context.Context, providerName, code, state, browserNonce string) (*models.User, string, string, error) {
+	loggerTag := "auth.service.oauthLogin"
+
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, "", "", ErrProviderUnknown
+	}
+
+	oauthState, err := s.oauthStateAdapter.Take(ctx, state)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			s.metrics.LoginFailed("oauth_state_invalid")
+
+			return nil, "", "", ErrOAuthStateInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed take oauth state from redis: %v", err))
+
+		return nil, "", "", err
+	}
+
+	if oauthState.Provider != providerName ||
+		subtle.ConstantTimeCompare([]byte(hashNonce(browserNonce)), []byte(oauthState.BrowserNonceHash)) != 1 {
+		s.metrics.LoginFailed("oauth_state_invalid")
+
+		return nil, "", "", ErrOAuthStateInvalid
+	}
+
+	identity, err := provider.Exchange(ctx, code, oauthState.Nonce, oauthState.CodeVerifier)
+	if err != nil {
+		if errors.Is(err, ErrIdentityRejected) {
+			s.metrics.LoginFailed("identity_rejected")
+
+			return nil, "", "", ErrIdentityRejected
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed exchange code: %v", err))
+
+		return nil, "", "", err
+	}
+
+	user, created, err := s.linkIdentity(ctx, identity, oauthState.LinkUserID)
+	if err != nil {
+		if errors.Is(err, ErrEmailNotVerified) {
+			s.metrics.LoginFailed("email_not_verified")
+		}
+
+		return nil, "", "", err
+	}
+
+	if created {
+		s.metrics.UserRegistered()
+	}
+
+	if user.Blocked() {
+		s.metrics.LoginFailed("user_blocked")
+
+		return nil, "", "", ErrUserBlocked
+	}
+
+	accessToken, refreshToken, err := s.generateAndStoreTokens(ctx, user)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return user, accessToken, refreshToken, nil
+}
+
+// linkingUserID returns the user a signed-in OAuthAuthorize links the
+// identity to. Like Logout, it only accepts a token whose session is live.
+func (s *AuthService) linkingUserID(ctx context.Context, accessToken string) (string, error) {
+	loggerTag := "auth.service.linkingUserID"
+
+	claims, err := jwt.Verify(accessToken, s.cfg.Current().AccessTokenPublicKey)
+	if err != nil {
+		return "", ErrTokenInvalid
+	}
+
+	userID, _ := claims["sub"].(string)
+
+	if _, err = s.tokenAdapter.Get(ctx, models.SessionKey("", userID)); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", ErrTokenInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed get refresh token from redis: %v", err))
+
+		return "", err
+	}
+
+	return userID, nil
+}
+
+// linkIdentity returns the user the identity belongs to, and whether that
+// user was created for it. An identity seen for the first time is linked to
+// linkUserID when a signed-in user started the sign-in, and otherwise to the
+// user with the same email or a new user without a password. Only an email
+// the provider has verified is trusted for this, e.g. Google's
+// email_verified or GitHub's primary email being verified: anyone can sign
+// up at a provider with somebody else's address.
+func (s *AuthService) linkIdentity(ctx context.Context, identity *models.ExternalIdentity, linkUserID string) (*models.User, bool, error) {
+	loggerTag := "auth.service.linkIdentity"
+
+	var (
+		user    *models.User
+		created bool
+	)
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		linked, err := s.identityRepo.FindByProviderSubject(ctx, identity.Provider, identity.Subject)
+		if err == nil {
+			if linkUserID != "" && linked.UserID.String() != linkUserID {
+				return ErrIdentityExists
+			}
+
+			user, err = s.userRepo.FindByID(ctx, linked.UserID.String())
+			if err != nil {
+				s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
+
+				return err
+			}
+
+			return nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find identity: %v", err))
+
+			return err
+		}
+
+		email := strings.ToLower(identity.Email)
+
+		if linkUserID != "" {
+			user, err = s.userRepo.FindByID(ctx, linkUserID)
+			if err != nil {
+				// The account was deleted after the sign-in started.
+				if errors.Is(err, pgx.ErrNoRows) {
+					return ErrOAuthStateInvalid
+				}
+
+				s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
+
+				return err
+			}
+		} else {
+			if email == "" || !identity.EmailVerified {
+				return ErrEmailNotVerified
+			}
+
+			user, err = s.userRepo.FindByEmail(ctx, email)
+			if errors.Is(err, pgx.ErrNoRows) {
+				user, err = s.userRepo.Create(ctx, email, "", identity.FirstName, identity.LastName)
+				created = true
+			}
+			if err != nil {
+				if errors.Is(err, ErrUserExists) {
+					return ErrUserExists
+				}
+
+				s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find or create user: %v", err))
+
+				return err
+			}
+		}
+
+		_, err = s.identityRepo.Create(ctx, &models.UserIdentity{
+			UserID:   user.ID,
+			Provider: identity.Provider,
+			Subject:  identity.Subject,
+			Email:    email,
+		})
+		if err != nil {
+			if errors.Is(err, ErrIdentityExists) {
+				return ErrIdentityExists
+			}
+
+			s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed create identity: %v", err))
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return user, created, nil
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *AuthService) BeginPasskeyLogin(ctx context.Context) (string, []byte, error) {
+	loggerTag := "auth.service.beginPasskeyLogin"
+
+	ceremony, err := s.passkeys.BeginLogin()
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed begin login: %v", err))
+
+		return "", nil, err
+	}
+
+	ceremonyID := oauthSecret()
+	challenge := &domainAdapter.PasskeyChallenge{
+		Session: ceremony.Session,
+	}
+
+	if err = s.passkeyChallengeAdapter.Set(ctx, ceremonyID, challenge, s.cfg.Current().PasskeyChallengeExpiresIn); err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed add passkey challenge to redis: %v", err))
+
+		return "", nil, err
+	}
+
+	return ceremonyID, ceremony.Options, nil
+}
+
+// FinishPasskeyLogin answers a passkey that is not registered, or no longer
+// is, the same as one that fails to verify.
+func (s *AuthService) FinishPasskeyLogin(ctx context.Context, ceremonyID string, response []byte) (*models.User, string, string, error) {
+	loggerTag := "auth.service.finishPasskeyLogin"
+
+	challenge, err := s.passkeyChallengeAdapter.Take(ctx, ceremonyID)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			s.metrics.LoginFailed("passkey_invalid")
+
+			return nil, "", "", ErrPasskeyInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed take passkey challenge from redis: %v", err))
+
+		return nil, "", "", err
+	}
+
+	// A registration ceremony is bound to a user and cannot log in.
+	if challenge.UserID != "" {
+		s.metrics.LoginFailed("passkey_invalid")
+
+		return nil, "", "", ErrPasskeyInvalid
+	}
+
+	passkey, err := s.passkeys.FinishLogin(challenge.Session, response, func(credentialID []byte) (*models.Passkey, error) {
+		passkey, err := s.passkeyRepo.FindByCredentialID(ctx, credentialID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrPasskeyInvalid
+		}
+
+		return passkey, err
+	})
+	if err != nil {
+		if errors.Is(err, ErrPasskeyInvalid) {
+			s.metrics.LoginFailed("passkey_invalid")
+
+			return nil, "", "", ErrPasskeyInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed finish login: %v", err))
+
+		return nil, "", "", err
+	}
+
+	if err = s.passkeyRepo.UpdateUsage(ctx, passkey); err != nil {
+		// The passkey was deleted while it was being used.
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.metrics.LoginFailed("passkey_invalid")
+
+			return nil, "", "", ErrPasskeyInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed update passkey usage: %v", err))
+
+		return nil, "", "", err
+	}
+
+	user, err := s.userRepo.FindByID(ctx, passkey.UserID.String())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, "", "", ErrPasskeyInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
+
+		return nil, "", "", err
+	}
+
+	if user.Blocked() {
+		s.metrics.LoginFailed("user_blocked")
+
+		return nil, "", "", ErrUserBlocked
+	}
+
+	accessToken, refreshToken, err := s.generateAndStoreTokens(ctx, user)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return user, accessToken, refreshToken, nil
+}
@@ -6,11 +6,11 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/BlazeCoder04/online_store/libs/hash"
 	"github.com/BlazeCoder04/online_store/libs/jwt"
 	"github.com/BlazeCoder04/online_store/libs/logger"
 	"github.com/BlazeCoder04/online_store/services/user/configs"
 	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
 	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
 	domainRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories"
 	domainService "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
@@ -20,44 +20,77 @@ import (
 )
 
 type AuthService struct {
-	userRepo     domainRepo.UserRepository
-	tokenAdapter domainAdapter.TokenAdapter
-	logger       logger.Logger
-	cfg          *configs.Config
+	userRepo                domainRepo.UserRepository
+	identityRepo            domainRepo.IdentityRepository
+	passkeyRepo             domainRepo.PasskeyRepository
+	apiKeyRepo              domainRepo.APIKeyRepository
+	txManager               domainRepo.TxManager
+	tokenAdapter            domainAdapter.TokenAdapter
+	oauthStateAdapter       domainAdapter.OAuthStateAdapter
+	magicLinkAdapter        domainAdapter.MagicLinkAdapter
+	passkeyChallengeAdapter domainAdapter.PasskeyChallengeAdapter
+	providers               map[string]domain.IdentityProvider
+	passkeys                domain.Passkeys
+	emailSender             domain.EmailSender
+	metrics                 domain.BusinessMetrics
+	logger                  logger.Logger
+	cfg                     configs.Provider
 }
 
-func NewAuthService(userRepo domainRepo.UserRepository, tokenAdapter domainAdapter.TokenAdapter, logger logger.Logger, cfg *configs.Config) (domainService.AuthService, error) {
+func NewAuthService(userRepo domainRepo.UserRepository, identityRepo domainRepo.IdentityRepository, passkeyRepo domainRepo.PasskeyRepository, apiKeyRepo domainRepo.APIKeyRepository, txManager domainRepo.TxManager, tokenAdapter domainAdapter.TokenAdapter, oauthStateAdapter domainAdapter.OAuthStateAdapter, magicLinkAdapter domainAdapter.MagicLinkAdapter, passkeyChallengeAdapter domainAdapter.PasskeyChallengeAdapter, providers []domain.IdentityProvider, passkeys domain.Passkeys, emailSender domain.EmailSender, metrics domain.BusinessMetrics, logger logger.Logger, cfg configs.Provider) (domainService.AuthService, error) {
 	loggerTag := "auth.service.newAuthService"
 
+	providersByName := make(map[string]domain.IdentityProvider, len(providers))
+	for _, provider := range providers {
+		providersByName[provider.Name()] = provider
+	}
+
 	logger.Info(loggerTag, "Auth service initialized")
 
 	return &AuthService{
 		userRepo,
+		identityRepo,
+		passkeyRepo,
+		apiKeyRepo,
+		txManager,
 		tokenAdapter,
+		oauthStateAdapter,
+		magicLinkAdapter,
+		passkeyChallengeAdapter,
+		providersByName,
+		passkeys,
+		emailSender,
+		metrics,
 		logger,
 		cfg,
 	}, nil
 }
 
-func (s *AuthService) generateAndStoreTokens(ctx context.Context, userID, userRole string) (string, string, error) {
+func (s *AuthService) generateAndStoreTokens(ctx context.Context, user *models.User) (string, string, error) {
 	loggerTag := "auth.service.generateAndStoreTokens"
 
-	accessToken, err := jwt.Create(s.cfg.AccessTokenExpiresIn, userID, userRole, s.cfg.AccessTokenPrivateKey)
+	// One snapshot for both tokens, so a reload in between cannot mix TTLs.
+	cfg := s.cfg.Current()
+
+	userID := user.ID.String()
+	claims := models.UserClaims(user)
+
+	accessToken, err := createToken(ctx, cfg.AccessTokenExpiresIn, claims, cfg.AccessTokenPrivateKey)
 	if err != nil {
-		s.logger.Error(loggerTag, fmt.Sprintf("failed create access token: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed create access token: %v", err))
 
 		return "", "", err
 	}
 
-	refreshToken, err := jwt.Create(s.cfg.RefreshTokenExpiresIn, userID, userRole, s.cfg.RefreshTokenPrivateKey)
+	refreshToken, err := createToken(ctx, cfg.RefreshTokenExpiresIn, claims, cfg.RefreshTokenPrivateKey)
 	if err != nil {
-		s.logger.Error(loggerTag, fmt.Sprintf("failed create refresh token: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed create refresh token: %v", err))
 
 		return "", "", err
 	}
 
-	if err = s.tokenAdapter.Set(ctx, userID, refreshToken, s.cfg.RefreshTokenExpiresIn); err != nil {
-		s.logger.Error(loggerTag, fmt.Sprintf("failed add refresh token to redis: %v", err))
+	if err = s.tokenAdapter.Set(ctx, userID, refreshToken, cfg.RefreshTokenExpiresIn); err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed add refresh token to redis: %v", err))
 
 		return "", "", err
 	}
@@ -73,25 +106,35 @@ func (s *AuthService) Login(ctx context.Context, email, password string) (*model
 	user, err := s.userRepo.FindByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
+			s.metrics.LoginFailed("user_not_found")
+
 			return nil, "", "", ErrUserNotFound
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed find user: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
 
 		return nil, "", "", err
 	}
 
-	if err = hash.ComparePassword(user.Password, password); err != nil {
+	if err = comparePassword(ctx, user.Password, password); err != nil {
 		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			s.metrics.LoginFailed("password_wrong")
+
 			return nil, "", "", ErrPasswordWrong
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed compare password: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed compare password: %v", err))
 
 		return nil, "", "", err
 	}
 
-	accessToken, refreshToken, err := s.generateAndStoreTokens(ctx, user.ID.String(), string(user.Role))
+	if user.Blocked() {
+		s.metrics.LoginFailed("user_blocked")
+
+		return nil, "", "", ErrUserBlocked
+	}
+
+	accessToken, refreshToken, err := s.generateAndStoreTokens(ctx, user)
 	if err != nil {
 		return nil, "", "", err
 	}
@@ -104,31 +147,45 @@ func (s *AuthService) Register(ctx context.Context, email, password, firstName,
 
 	email = strings.ToLower(email)
 
-	existedUser, err := s.userRepo.FindByEmail(ctx, email)
-	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
-		s.logger.Error(loggerTag, fmt.Sprintf("failed find user: %v", err))
+	hashedPassword, err := hashPassword(ctx, password)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed hash password: %v", err))
 
 		return nil, "", "", err
 	}
-	if existedUser != nil {
-		return nil, "", "", ErrUserExists
-	}
 
-	hashedPassword, err := hash.HashPassword(password)
-	if err != nil {
-		s.logger.Error(loggerTag, fmt.Sprintf("failed hash password: %v", err))
+	var user *models.User
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		existedUser, err := s.userRepo.FindByEmail(ctx, email)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
 
-		return nil, "", "", err
-	}
+			return err
+		}
+		if existedUser != nil {
+			return ErrUserExists
+		}
 
-	user, err := s.userRepo.Create(ctx, email, hashedPassword, firstName, lastName)
-	if err != nil {
-		s.logger.Error(loggerTag, fmt.Sprintf("failed create user: %v", err))
+		user, err = s.userRepo.Create(ctx, email, hashedPassword, firstName, lastName)
+		if err != nil {
+			if errors.Is(err, ErrUserExists) {
+				return ErrUserExists
+			}
+
+			s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed create user: %v", err))
+
+			return err
+		}
 
+		return nil
+	})
+	if err != nil {
 		return nil, "", "", err
 	}
 
-	accessToken, refreshToken, err := s.generateAndStoreTokens(ctx, user.ID.String(), string(user.Role))
+	s.metrics.UserRegistered()
+
+	accessToken, refreshToken, err := s.generateAndStoreTokens(ctx, user)
 	if err != nil {
 		return nil, "", "", err
 	}
@@ -139,13 +196,13 @@ func (s *AuthService) Register(ctx context.Context, email, password, firstName,
 func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string) (string, error) {
 	loggerTag := "auth.service.refreshToken"
 
-	claims, err := jwt.Verify(refreshToken, s.cfg.RefreshTokenPublicKey)
+	claims, err := jwt.Verify(refreshToken, s.cfg.Current().RefreshTokenPublicKey)
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenInvalid) {
 			return "", err
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed verify token: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed verify token: %v", err))
 
 		return "", err
 	}
@@ -158,7 +215,7 @@ func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string) (st
 			return "", ErrTokenInvalid
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed get refresh token to redis: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed get refresh token to redis: %v", err))
 
 		return "", err
 	}
@@ -173,31 +230,40 @@ func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string) (st
 			return "", ErrUserNotFound
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed find user: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
 
 		return "", err
 	}
 
-	accessToken, err := jwt.Create(s.cfg.AccessTokenExpiresIn, userID, string(user.Role), s.cfg.AccessTokenPrivateKey)
+	if user.Blocked() {
+		return "", ErrUserBlocked
+	}
+
+	cfg := s.cfg.Current()
+
+	// The user is read again, so the new token carries the current roles.
+	accessToken, err := createToken(ctx, cfg.AccessTokenExpiresIn, models.UserClaims(user), cfg.AccessTokenPrivateKey)
 	if err != nil {
-		s.logger.Error(loggerTag, fmt.Sprintf("failed create access token: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed create access token: %v", err))
 
 		return "", err
 	}
 
+	s.metrics.TokenRefreshed()
+
 	return accessToken, nil
 }
 
 func (s *AuthService) Logout(ctx context.Context, accessToken string) error {
 	loggerTag := "auth.service.logout"
 
-	accessTokenClaims, err := jwt.Verify(accessToken, s.cfg.AccessTokenPublicKey)
+	accessTokenClaims, err := jwt.Verify(accessToken, s.cfg.Current().AccessTokenPublicKey)
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenInvalid) {
 			return err
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed verify access token: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed verify access token: %v", err))
 
 		return err
 	}
@@ -210,24 +276,24 @@ func (s *AuthService) Logout(ctx context.Context, accessToken string) error {
 			return ErrTokenInvalid
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed get refresh token to redis: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed get refresh token to redis: %v", err))
 
 		return err
 	}
 
-	_, err = jwt.Verify(refreshToken, s.cfg.RefreshTokenPublicKey)
+	_, err = jwt.Verify(refreshToken, s.cfg.Current().RefreshTokenPublicKey)
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenInvalid) {
 			return err
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed verify refresh token: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed verify refresh token: %v", err))
 
 		return err
 	}
 
 	if err = s.tokenAdapter.Del(ctx, userID); err != nil {
-		s.logger.Error(loggerTag, fmt.Sprintf("failed delete refresh token to redis: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed delete refresh token to redis: %v", err))
 
 		return err
 	}
@@ -0,0 +1,254 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/logger/observer"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/apikey"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/metrics"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/auth"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type apiKeyMocks struct {
+	userRepo   *mocksRepo.MockUserRepository
+	apiKeyRepo *mocksRepo.MockAPIKeyRepository
+}
+
+func TestAuthService_AuthenticateAPIKey(t *testing.T) {
+	type expect struct {
+		err    error
+		claims *models.Claims
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID   = uuid.New()
+		apiKeyID = uuid.New()
+
+		key, prefix, secretHash = apikey.Generate()
+		otherKey, _, _          = apikey.Generate()
+
+		past   = time.Now().Add(-time.Hour)
+		future = time.Now().Add(time.Hour)
+
+		userKey = &models.APIKey{
+			ID:         apiKeyID,
+			UserID:     &userID,
+			Name:       "warehouse sync",
+			Prefix:     prefix,
+			SecretHash: secretHash,
+			Scopes:     []string{models.ScopeProfileRead},
+			ExpiresAt:  &future,
+		}
+
+		serviceKey = &models.APIKey{
+			ID:         apiKeyID,
+			Service:    "warehouse",
+			Name:       "stock import",
+			Prefix:     prefix,
+			SecretHash: secretHash,
+			Scopes:     []string{models.ScopeProfileRead, models.ScopeProfileWrite},
+		}
+
+		revokedKey = &models.APIKey{
+			ID:         apiKeyID,
+			UserID:     &userID,
+			Prefix:     prefix,
+			SecretHash: secretHash,
+			Scopes:     []string{models.ScopeProfileRead},
+			Revoked:    true,
+		}
+
+		expiredKey = &models.APIKey{
+			ID:         apiKeyID,
+			UserID:     &userID,
+			Prefix:     prefix,
+			SecretHash: secretHash,
+			Scopes:     []string{models.ScopeProfileRead},
+			ExpiresAt:  &past,
+		}
+
+		user = &models.User{
+			ID:          userID,
+			Email:       "test1@test.ru",
+			Roles:       []string{models.RoleCustomer},
+			Permissions: []string{"catalog:write"},
+		}
+
+		blockedUser = &models.User{
+			ID:        userID,
+			Email:     "test1@test.ru",
+			Roles:     []string{models.RoleCustomer},
+			BlockedAt: &past,
+		}
+	)
+
+	tests := []struct {
+		name   string
+		key    string
+		mock   func(m *apiKeyMocks)
+		expect expect
+	}{
+		{
+			name: "user key case",
+			key:  key,
+			mock: func(m *apiKeyMocks) {
+				m.apiKeyRepo.EXPECT().
+					FindByPrefix(ctx, prefix).
+					Return(userKey, nil)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(user, nil)
+
+				m.apiKeyRepo.EXPECT().
+					Touch(ctx, apiKeyID.String()).
+					Return(nil)
+			},
+			expect: expect{
+				claims: &models.Claims{
+					Subject:  userID.String(),
+					Roles:    []string{models.RoleCustomer},
+					Scopes:   []string{models.ScopeProfileRead},
+					APIKeyID: apiKeyID.String(),
+				},
+			},
+		},
+		{
+			name: "service key case",
+			key:  key,
+			mock: func(m *apiKeyMocks) {
+				m.apiKeyRepo.EXPECT().
+					FindByPrefix(ctx, prefix).
+					Return(serviceKey, nil)
+
+				m.apiKeyRepo.EXPECT().
+					Touch(ctx, apiKeyID.String()).
+					Return(nil)
+			},
+			expect: expect{
+				claims: &models.Claims{
+					Subject:  "service:warehouse",
+					Scopes:   []string{models.ScopeProfileRead, models.ScopeProfileWrite},
+					APIKeyID: apiKeyID.String(),
+				},
+			},
+		},
+		{
+			name: "malformed key case",
+			key:  "not-a-key",
+			mock: func(m *apiKeyMocks) {},
+			expect: expect{
+				err: services.ErrAPIKeyInvalid,
+			},
+		},
+		{
+			name: "unknown key case",
+			key:  key,
+			mock: func(m *apiKeyMocks) {
+				m.apiKeyRepo.EXPECT().
+					FindByPrefix(ctx, prefix).
+					Return(nil, pgx.ErrNoRows)
+			},
+			expect: expect{
+				err: services.ErrAPIKeyInvalid,
+			},
+		},
+		{
+			name: "wrong secret case",
+			// The prefix of key with the secret of another key.
+			key: prefix + otherKey[len(prefix):],
+			mock: func(m *apiKeyMocks) {
+				m.apiKeyRepo.EXPECT().
+					FindByPrefix(ctx, prefix).
+					Return(userKey, nil)
+			},
+			expect: expect{
+				err: services.ErrAPIKeyInvalid,
+			},
+		},
+		{
+			name: "revoked key case",
+			key:  key,
+			mock: func(m *apiKeyMocks) {
+				m.apiKeyRepo.EXPECT().
+					FindByPrefix(ctx, prefix).
+					Return(revokedKey, nil)
+			},
+			expect: expect{
+				err: services.ErrAPIKeyInvalid,
+			},
+		},
+		{
+			name: "expired key case",
+			key:  key,
+			mock: func(m *apiKeyMocks) {
+				m.apiKeyRepo.EXPECT().
+					FindByPrefix(ctx, prefix).
+					Return(expiredKey, nil)
+			},
+			expect: expect{
+				err: services.ErrAPIKeyInvalid,
+			},
+		},
+		{
+			name: "user blocked case",
+			key:  key,
+			mock: func(m *apiKeyMocks) {
+				m.apiKeyRepo.EXPECT().
+					FindByPrefix(ctx, prefix).
+					Return(userKey, nil)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(blockedUser, nil)
+			},
+			expect: expect{
+				err: services.ErrUserBlocked,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &apiKeyMocks{
+				userRepo:   mocksRepo.NewMockUserRepository(ctrl),
+				apiKeyRepo: mocksRepo.NewMockAPIKeyRepository(ctrl),
+			}
+			tt.mock(m)
+
+			log := observer.New(logger.LevelError)
+
+			authService, _ := services.NewAuthService(m.userRepo, nil, nil, m.apiKeyRepo, nil, nil, nil, nil, nil, nil, nil, nil, metrics.NewNoop(), log, &configs.Config{})
+
+			claims, err := authService.AuthenticateAPIKey(ctx, tt.key)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.expect.claims, claims)
+
+			observer.RequireNotLogged(t, log, logger.LevelError)
+		})
+	}
+}
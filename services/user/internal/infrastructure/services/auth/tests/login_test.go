@@ -2,15 +2,19 @@ package tests
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/BlazeCoder04/online_store/libs/hash"
 	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/logger/observer"
 	"github.com/BlazeCoder04/online_store/services/user/configs"
 	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
 	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
 	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/metrics"
 	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/auth"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/golang/mock/gomock"
@@ -26,10 +30,16 @@ func TestAuthService_Login(t *testing.T) {
 		password string
 	}
 
+	type logged struct {
+		level   logger.Level
+		message string
+	}
+
 	type expect struct {
-		err   error
-		user  *models.User
-		token bool
+		err    error
+		user   *models.User
+		token  bool
+		logged *logged
 	}
 
 	var (
@@ -44,11 +54,13 @@ func TestAuthService_Login(t *testing.T) {
 		firstName         = gofakeit.FirstName()
 		lastName          = gofakeit.LastName()
 
-		accessTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		accessTokenExpiresIn  = 15 * time.Minute
+		accessTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn        = 15 * time.Minute
+
+		refreshTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn        = 10080 * time.Minute
 
-		refreshTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		refreshTokenExpiresIn  = 10080 * time.Minute
+		errDatabase = errors.New("connection refused")
 
 		baseUser = &models.User{
 			ID:        userID,
@@ -56,7 +68,26 @@ func TestAuthService_Login(t *testing.T) {
 			Password:  hashedPassword,
 			FirstName: firstName,
 			LastName:  lastName,
-			Role:      models.UserRole,
+			Roles:     []string{models.RoleCustomer},
+		}
+
+		socialUser = &models.User{
+			ID:        userID,
+			Email:     correctEmail,
+			FirstName: firstName,
+			LastName:  lastName,
+			Roles:     []string{models.RoleCustomer},
+		}
+
+		blockedAt   = time.Now()
+		blockedUser = &models.User{
+			ID:        userID,
+			Email:     correctEmail,
+			Password:  hashedPassword,
+			FirstName: firstName,
+			LastName:  lastName,
+			Roles:     []string{models.RoleCustomer},
+			BlockedAt: &blockedAt,
 		}
 	)
 
@@ -122,6 +153,35 @@ func TestAuthService_Login(t *testing.T) {
 			accessTokenPrivateKey:  accessTokenPrivateKey,
 			refreshTokenPrivateKey: refreshTokenPrivateKey,
 		},
+		{
+			name: "find user failed case",
+			args: args{
+				ctx:      ctx,
+				email:    correctEmail,
+				password: correctPassword,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				userRepo.EXPECT().
+					FindByEmail(ctx, correctEmail).
+					Return(nil, errDatabase)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				err:   errDatabase,
+				user:  nil,
+				token: false,
+				logged: &logged{
+					level:   logger.LevelError,
+					message: "failed find user",
+				},
+			},
+			accessTokenPrivateKey:  accessTokenPrivateKey,
+			refreshTokenPrivateKey: refreshTokenPrivateKey,
+		},
 		{
 			name: "password wrong case",
 			args: args{
@@ -147,6 +207,56 @@ func TestAuthService_Login(t *testing.T) {
 			accessTokenPrivateKey:  accessTokenPrivateKey,
 			refreshTokenPrivateKey: refreshTokenPrivateKey,
 		},
+		{
+			name: "password not set case",
+			args: args{
+				ctx:      ctx,
+				email:    correctEmail,
+				password: correctPassword,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				userRepo.EXPECT().
+					FindByEmail(ctx, correctEmail).
+					Return(socialUser, nil)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				err:   services.ErrPasswordWrong,
+				user:  nil,
+				token: false,
+			},
+			accessTokenPrivateKey:  accessTokenPrivateKey,
+			refreshTokenPrivateKey: refreshTokenPrivateKey,
+		},
+		{
+			name: "user blocked case",
+			args: args{
+				ctx:      ctx,
+				email:    correctEmail,
+				password: correctPassword,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				userRepo.EXPECT().
+					FindByEmail(ctx, correctEmail).
+					Return(blockedUser, nil)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				err:   services.ErrUserBlocked,
+				user:  nil,
+				token: false,
+			},
+			accessTokenPrivateKey:  accessTokenPrivateKey,
+			refreshTokenPrivateKey: refreshTokenPrivateKey,
+		},
 	}
 
 	for _, tt := range tests {
@@ -166,11 +276,9 @@ func TestAuthService_Login(t *testing.T) {
 				RefreshTokenExpiresIn:  refreshTokenExpiresIn,
 			}
 
-			log, _ := logger.NewAdapter(&logger.Config{
-				Level: logger.LevelError,
-			})
+			log := observer.New(logger.LevelError)
 
-			authService, _ := services.NewAuthService(userRepo, tokenAdapter, log, cfg)
+			authService, _ := services.NewAuthService(userRepo, nil, nil, nil, nil, tokenAdapter, nil, nil, nil, nil, nil, nil, metrics.NewNoop(), log, cfg)
 
 			user, accessToken, refreshToken, err := authService.Login(tt.args.ctx, tt.args.email, tt.args.password)
 
@@ -195,6 +303,12 @@ func TestAuthService_Login(t *testing.T) {
 				require.Empty(t, accessToken)
 				require.Empty(t, refreshToken)
 			}
+
+			if tt.expect.logged != nil {
+				observer.RequireLogged(t, log, tt.expect.logged.level, "auth.service.login", tt.expect.logged.message)
+			} else {
+				observer.RequireNotLogged(t, log, logger.LevelError)
+			}
 		})
 	}
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/BlazeCoder04/online_store/services/user/configs"
 	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
 	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/metrics"
 	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/auth"
 	"github.com/go-redis/redis/v8"
 	"github.com/golang/mock/gomock"
@@ -31,24 +33,22 @@ func TestAuthService_Logout(t *testing.T) {
 		ctx = context.Background()
 
 		userID = uuid.New()
-		role   = models.UserRole
+		role   = models.RoleCustomer
 
-		accessTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		accessTokenPublicKey  = generateRSAPublicKeyBase64(t, accessTokenPrivateKey)
-		accessTokenExpiresIn  = 15 * time.Minute
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn                           = 15 * time.Minute
 
-		refreshTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		refreshTokenPublicKey  = generateRSAPublicKeyBase64(t, refreshTokenPrivateKey)
-		refreshTokenExpiresIn  = 10080 * time.Minute
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn                            = 10080 * time.Minute
 
-		accessToken, _  = jwt.Create(accessTokenExpiresIn, userID.String(), string(role), accessTokenPrivateKey)
-		refreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), string(role), refreshTokenPrivateKey)
+		accessToken, _  = jwt.Create(accessTokenExpiresIn, userID.String(), role, accessTokenPrivateKey)
+		refreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), role, refreshTokenPrivateKey)
 
-		wrongAccessTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		wrongAccessToken, _        = jwt.Create(accessTokenExpiresIn, userID.String(), string(role), wrongAccessTokenPrivateKey)
+		wrongAccessTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		wrongAccessToken, _              = jwt.Create(accessTokenExpiresIn, userID.String(), role, wrongAccessTokenPrivateKey)
 
-		wrongRefreshTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		wrongRefreshToken, _        = jwt.Create(refreshTokenExpiresIn, userID.String(), string(role), wrongRefreshTokenPrivateKey)
+		wrongRefreshTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		wrongRefreshToken, _              = jwt.Create(refreshTokenExpiresIn, userID.String(), role, wrongRefreshTokenPrivateKey)
 	)
 
 	tests := []struct {
@@ -158,7 +158,7 @@ func TestAuthService_Logout(t *testing.T) {
 				Level: logger.LevelError,
 			})
 
-			authService, _ := services.NewAuthService(nil, tokenAdapter, log, cfg)
+			authService, _ := services.NewAuthService(nil, nil, nil, nil, nil, tokenAdapter, nil, nil, nil, nil, nil, nil, metrics.NewNoop(), log, cfg)
 
 			err := authService.Logout(tt.args.ctx, tt.args.accessToken)
 
@@ -0,0 +1,395 @@
+package tests
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/logger/observer"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
+	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	emailAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/email"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/metrics"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/auth"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const magicLinkURL = "http://localhost:3000/auth/magic-link"
+
+type magicLinkMocks struct {
+	userRepo         *mocksRepo.MockUserRepository
+	tokenAdapter     *mocksAdapter.MockTokenAdapter
+	magicLinkAdapter *mocksAdapter.MockMagicLinkAdapter
+}
+
+func newMagicLinkMocks(ctrl *gomock.Controller) *magicLinkMocks {
+	return &magicLinkMocks{
+		userRepo:         mocksRepo.NewMockUserRepository(ctrl),
+		tokenAdapter:     mocksAdapter.NewMockTokenAdapter(ctrl),
+		magicLinkAdapter: mocksAdapter.NewMockMagicLinkAdapter(ctrl),
+	}
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func TestAuthService_RequestMagicLink(t *testing.T) {
+	type logged struct {
+		level   logger.Level
+		message string
+	}
+
+	type expect struct {
+		err    error
+		sent   bool
+		logged *logged
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID        = uuid.New()
+		email         = "test1@test.ru"
+		unknownEmail  = "test2@test.ru"
+		linkExpiresIn = 5 * time.Minute
+
+		errDatabase = errors.New("connection refused")
+
+		// Only the success case stores a link.
+		storedToken string
+		stored      *domainAdapter.MagicLink
+
+		user = &models.User{
+			ID:    userID,
+			Email: email,
+			Roles: []string{models.RoleCustomer},
+		}
+
+		blockedAt   = time.Now()
+		blockedUser = &models.User{
+			ID:        userID,
+			Email:     email,
+			Roles:     []string{models.RoleCustomer},
+			BlockedAt: &blockedAt,
+		}
+	)
+
+	tests := []struct {
+		name   string
+		email  string
+		mock   func(m *magicLinkMocks)
+		expect expect
+	}{
+		{
+			name:  "success case",
+			email: strings.ToUpper(email),
+			mock: func(m *magicLinkMocks) {
+				m.userRepo.EXPECT().
+					FindByEmail(ctx, email).
+					Return(user, nil)
+
+				m.magicLinkAdapter.EXPECT().
+					Set(ctx, gomock.Any(), gomock.Any(), linkExpiresIn).
+					DoAndReturn(func(_ context.Context, token string, magicLink *domainAdapter.MagicLink, _ time.Duration) error {
+						storedToken, stored = token, magicLink
+
+						return nil
+					})
+			},
+			expect: expect{
+				sent: true,
+			},
+		},
+		{
+			name:  "unknown email case",
+			email: unknownEmail,
+			mock: func(m *magicLinkMocks) {
+				m.userRepo.EXPECT().
+					FindByEmail(ctx, unknownEmail).
+					Return(nil, pgx.ErrNoRows)
+			},
+			expect: expect{
+				sent: false,
+			},
+		},
+		{
+			name:  "user blocked case",
+			email: email,
+			mock: func(m *magicLinkMocks) {
+				m.userRepo.EXPECT().
+					FindByEmail(ctx, email).
+					Return(blockedUser, nil)
+			},
+			expect: expect{
+				sent: false,
+			},
+		},
+		{
+			name:  "find user failed case",
+			email: email,
+			mock: func(m *magicLinkMocks) {
+				m.userRepo.EXPECT().
+					FindByEmail(ctx, email).
+					Return(nil, errDatabase)
+			},
+			expect: expect{
+				err: errDatabase,
+				logged: &logged{
+					level:   logger.LevelError,
+					message: "failed find user",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newMagicLinkMocks(ctrl)
+			tt.mock(m)
+
+			cfg := &configs.Config{
+				MagicLinkURL:       magicLinkURL,
+				MagicLinkExpiresIn: linkExpiresIn,
+			}
+
+			log := observer.New(logger.LevelError)
+			sender := emailAdapter.NewMemorySender(log)
+
+			authService, _ := services.NewAuthService(m.userRepo, nil, nil, nil, nil, m.tokenAdapter, nil, m.magicLinkAdapter, nil, nil, nil, sender, metrics.NewNoop(), log, cfg)
+
+			nonce, err := authService.RequestMagicLink(ctx, tt.email)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+				require.Empty(t, nonce)
+				observer.RequireLogged(t, log, tt.expect.logged.level, "auth.service.requestMagicLink", tt.expect.logged.message)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotEmpty(t, nonce)
+			observer.RequireNotLogged(t, log, logger.LevelError)
+
+			messages := sender.Messages()
+			if !tt.expect.sent {
+				require.Empty(t, messages)
+
+				return
+			}
+
+			require.Len(t, messages, 1)
+			require.Equal(t, email, messages[0].To)
+
+			// The emailed link carries the token, the device keeps the nonce,
+			// and only their hashes are stored.
+			require.NotNil(t, stored)
+			require.Equal(t, userID.String(), stored.UserID)
+			require.Equal(t, sha256Hex(nonce), stored.NonceHash)
+
+			var link *url.URL
+			for _, field := range strings.Fields(messages[0].Body) {
+				if strings.HasPrefix(field, magicLinkURL+"?") {
+					link, err = url.Parse(field)
+					require.NoError(t, err)
+				}
+			}
+			require.NotNil(t, link)
+			require.Equal(t, storedToken, link.Query().Get("token"))
+			require.NotEqual(t, nonce, storedToken)
+		})
+	}
+}
+
+func TestAuthService_ConsumeMagicLink(t *testing.T) {
+	type expect struct {
+		err   error
+		user  *models.User
+		token bool
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID = uuid.New()
+		token  = "token"
+		nonce  = "nonce"
+
+		accessTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn        = 15 * time.Minute
+
+		refreshTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn        = 10080 * time.Minute
+
+		magicLink = &domainAdapter.MagicLink{
+			UserID:    userID.String(),
+			NonceHash: sha256Hex(nonce),
+		}
+
+		user = &models.User{
+			ID:    userID,
+			Email: "test1@test.ru",
+			Roles: []string{models.RoleCustomer},
+		}
+
+		blockedAt   = time.Now()
+		blockedUser = &models.User{
+			ID:        userID,
+			Email:     "test1@test.ru",
+			Roles:     []string{models.RoleCustomer},
+			BlockedAt: &blockedAt,
+		}
+	)
+
+	tests := []struct {
+		name   string
+		nonce  string
+		mock   func(m *magicLinkMocks)
+		expect expect
+	}{
+		{
+			name:  "success case",
+			nonce: nonce,
+			mock: func(m *magicLinkMocks) {
+				m.magicLinkAdapter.EXPECT().
+					Take(ctx, token).
+					Return(magicLink, nil)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(user, nil)
+
+				m.tokenAdapter.EXPECT().
+					Set(ctx, userID.String(), gomock.Any(), refreshTokenExpiresIn).
+					Return(nil)
+			},
+			expect: expect{
+				user:  user,
+				token: true,
+			},
+		},
+		{
+			name:  "link used case",
+			nonce: nonce,
+			mock: func(m *magicLinkMocks) {
+				m.magicLinkAdapter.EXPECT().
+					Take(ctx, token).
+					Return(nil, redis.Nil)
+			},
+			expect: expect{
+				err: services.ErrMagicLinkInvalid,
+			},
+		},
+		{
+			name:  "other device case",
+			nonce: "other-nonce",
+			mock: func(m *magicLinkMocks) {
+				m.magicLinkAdapter.EXPECT().
+					Take(ctx, token).
+					Return(magicLink, nil)
+			},
+			expect: expect{
+				err: services.ErrMagicLinkInvalid,
+			},
+		},
+		{
+			name:  "user deleted case",
+			nonce: nonce,
+			mock: func(m *magicLinkMocks) {
+				m.magicLinkAdapter.EXPECT().
+					Take(ctx, token).
+					Return(magicLink, nil)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(nil, pgx.ErrNoRows)
+			},
+			expect: expect{
+				err: services.ErrMagicLinkInvalid,
+			},
+		},
+		{
+			name:  "user blocked case",
+			nonce: nonce,
+			mock: func(m *magicLinkMocks) {
+				m.magicLinkAdapter.EXPECT().
+					Take(ctx, token).
+					Return(magicLink, nil)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(blockedUser, nil)
+			},
+			expect: expect{
+				err: services.ErrUserBlocked,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newMagicLinkMocks(ctrl)
+			tt.mock(m)
+
+			cfg := &configs.Config{
+				AccessTokenPrivateKey:  accessTokenPrivateKey,
+				AccessTokenExpiresIn:   accessTokenExpiresIn,
+				RefreshTokenPrivateKey: refreshTokenPrivateKey,
+				RefreshTokenExpiresIn:  refreshTokenExpiresIn,
+			}
+
+			log := observer.New(logger.LevelError)
+
+			authService, _ := services.NewAuthService(m.userRepo, nil, nil, nil, nil, m.tokenAdapter, nil, m.magicLinkAdapter, nil, nil, nil, nil, metrics.NewNoop(), log, cfg)
+
+			user, accessToken, refreshToken, err := authService.ConsumeMagicLink(ctx, token, tt.nonce)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.expect.user, user)
+
+			if tt.expect.token {
+				require.NotEmpty(t, accessToken)
+				require.NotEmpty(t, refreshToken)
+			} else {
+				require.Empty(t, accessToken)
+				require.Empty(t, refreshToken)
+			}
+
+			observer.RequireNotLogged(t, log, logger.LevelError)
+		})
+	}
+}
@@ -0,0 +1,748 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/logger/observer"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
+	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	oauthAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/oauth"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/oauth/oauthtest"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/metrics"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/auth"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+const (
+	oauthClientID     = "client-id"
+	oauthClientSecret = "client-secret"
+	oauthState        = "state"
+	oauthNonce        = "nonce"
+	oauthCodeVerifier = "code-verifier-code-verifier-code-verifier-123"
+	browserNonce      = "browser-nonce"
+)
+
+type oauthMocks struct {
+	userRepo          *mocksRepo.MockUserRepository
+	identityRepo      *mocksRepo.MockIdentityRepository
+	txManager         *mocksRepo.MockTxManager
+	tokenAdapter      *mocksAdapter.MockTokenAdapter
+	oauthStateAdapter *mocksAdapter.MockOAuthStateAdapter
+}
+
+func newOAuthMocks(ctrl *gomock.Controller) *oauthMocks {
+	return &oauthMocks{
+		userRepo:          mocksRepo.NewMockUserRepository(ctrl),
+		identityRepo:      mocksRepo.NewMockIdentityRepository(ctrl),
+		txManager:         mocksRepo.NewMockTxManager(ctrl),
+		tokenAdapter:      mocksAdapter.NewMockTokenAdapter(ctrl),
+		oauthStateAdapter: mocksAdapter.NewMockOAuthStateAdapter(ctrl),
+	}
+}
+
+func newGoogleProvider(server *oauthtest.Server) domain.IdentityProvider {
+	return oauthAdapter.NewOIDCProvider(oauthAdapter.ProviderGoogle, server.URL, oauth2.Config{
+		ClientID:     oauthClientID,
+		ClientSecret: oauthClientSecret,
+		RedirectURL:  "http://localhost/v1/auth/oauth/google/callback",
+	}, http.DefaultClient)
+}
+
+func TestAuthService_OAuthAuthorize(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		provider    string
+		accessToken string
+	}
+
+	type logged struct {
+		level   logger.Level
+		message string
+	}
+
+	type expect struct {
+		err        error
+		url        bool
+		linkUserID string
+		logged     *logged
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID = uuid.New()
+
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+
+		accessToken, _ = jwt.Create(15*time.Minute, userID.String(), models.RoleCustomer, accessTokenPrivateKey)
+
+		stateExpiresIn = 10 * time.Minute
+
+		errRedis = errors.New("connection refused")
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		mock   func(m *oauthMocks, stored *domainAdapter.OAuthState, storedState *string)
+		expect expect
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx:      ctx,
+				provider: oauthAdapter.ProviderGoogle,
+			},
+			mock: func(m *oauthMocks, stored *domainAdapter.OAuthState, storedState *string) {
+				m.oauthStateAdapter.EXPECT().
+					Set(ctx, gomock.Any(), gomock.Any(), stateExpiresIn).
+					DoAndReturn(func(_ context.Context, state string, oauthState *domainAdapter.OAuthState, _ time.Duration) error {
+						*storedState = state
+						*stored = *oauthState
+
+						return nil
+					})
+			},
+			expect: expect{
+				url: true,
+			},
+		},
+		{
+			name: "signed-in user case",
+			args: args{
+				ctx:         ctx,
+				provider:    oauthAdapter.ProviderGoogle,
+				accessToken: accessToken,
+			},
+			mock: func(m *oauthMocks, stored *domainAdapter.OAuthState, storedState *string) {
+				m.tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return("refresh", nil)
+
+				m.oauthStateAdapter.EXPECT().
+					Set(ctx, gomock.Any(), gomock.Any(), stateExpiresIn).
+					DoAndReturn(func(_ context.Context, state string, oauthState *domainAdapter.OAuthState, _ time.Duration) error {
+						*storedState = state
+						*stored = *oauthState
+
+						return nil
+					})
+			},
+			expect: expect{
+				url:        true,
+				linkUserID: userID.String(),
+			},
+		},
+		{
+			name: "session logged out case",
+			args: args{
+				ctx:         ctx,
+				provider:    oauthAdapter.ProviderGoogle,
+				accessToken: accessToken,
+			},
+			mock: func(m *oauthMocks, stored *domainAdapter.OAuthState, storedState *string) {
+				m.tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return("", redis.Nil)
+			},
+			expect: expect{
+				err: services.ErrTokenInvalid,
+			},
+		},
+		{
+			name: "provider unknown case",
+			args: args{
+				ctx:      ctx,
+				provider: "myspace",
+			},
+			mock: func(m *oauthMocks, stored *domainAdapter.OAuthState, storedState *string) {},
+			expect: expect{
+				err: services.ErrProviderUnknown,
+			},
+		},
+		{
+			name: "state set failed case",
+			args: args{
+				ctx:      ctx,
+				provider: oauthAdapter.ProviderGoogle,
+			},
+			mock: func(m *oauthMocks, stored *domainAdapter.OAuthState, storedState *string) {
+				m.oauthStateAdapter.EXPECT().
+					Set(ctx, gomock.Any(), gomock.Any(), stateExpiresIn).
+					Return(errRedis)
+			},
+			expect: expect{
+				err: errRedis,
+				logged: &logged{
+					level:   logger.LevelError,
+					message: "failed add oauth state to redis",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := oauthtest.NewServer(oauthClientID, oauthClientSecret)
+			defer server.Close()
+
+			m := newOAuthMocks(ctrl)
+
+			var (
+				stored      domainAdapter.OAuthState
+				storedState string
+			)
+			tt.mock(m, &stored, &storedState)
+
+			cfg := &configs.Config{
+				AccessTokenPublicKey: accessTokenPublicKey,
+				OAuthStateExpiresIn:  stateExpiresIn,
+			}
+
+			log := observer.New(logger.LevelError)
+
+			authService, _ := services.NewAuthService(m.userRepo, m.identityRepo, nil, nil, m.txManager, m.tokenAdapter, m.oauthStateAdapter, nil, nil, []domain.IdentityProvider{newGoogleProvider(server)}, nil, nil, metrics.NewNoop(), log, cfg)
+
+			authURL, nonce, err := authService.OAuthAuthorize(tt.args.ctx, tt.args.provider, tt.args.accessToken)
+
+			if tt.expect.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.expect.err.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.expect.url {
+				u, err := url.Parse(authURL)
+				require.NoError(t, err)
+
+				require.Equal(t, oauthAdapter.ProviderGoogle, stored.Provider)
+				require.Equal(t, storedState, u.Query().Get("state"))
+				require.Equal(t, stored.Nonce, u.Query().Get("nonce"))
+				require.Equal(t, oauth2.S256ChallengeFromVerifier(stored.CodeVerifier), u.Query().Get("code_challenge"))
+				require.NotEqual(t, storedState, stored.Nonce)
+				require.GreaterOrEqual(t, len(stored.CodeVerifier), 43)
+
+				// The browser keeps the nonce; only its hash is stored.
+				require.NotEmpty(t, nonce)
+				require.Equal(t, sha256Hex(nonce), stored.BrowserNonceHash)
+				require.NotContains(t, authURL, nonce)
+
+				require.Equal(t, tt.expect.linkUserID, stored.LinkUserID)
+			} else {
+				require.Empty(t, authURL)
+				require.Empty(t, nonce)
+			}
+
+			if tt.expect.logged != nil {
+				observer.RequireLogged(t, log, tt.expect.logged.level, "auth.service.oauthAuthorize", tt.expect.logged.message)
+			} else {
+				observer.RequireNotLogged(t, log, logger.LevelError)
+			}
+		})
+	}
+}
+
+func TestAuthService_OAuthLogin(t *testing.T) {
+	type args struct {
+		ctx          context.Context
+		provider     string
+		identity     oauthtest.Identity
+		code         string
+		browserNonce string
+	}
+
+	type expect struct {
+		err   error
+		user  *models.User
+		token bool
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID    = uuid.New()
+		subject   = "110169484474386276334"
+		email     = "test1@test.ru"
+		firstName = gofakeit.FirstName()
+		lastName  = gofakeit.LastName()
+
+		identity = oauthtest.Identity{
+			Subject:       subject,
+			Email:         strings.ToUpper(email),
+			EmailVerified: true,
+			GivenName:     firstName,
+			FamilyName:    lastName,
+		}
+
+		accessTokenPrivateKey, _, _  = keys.Generate(keys.DefaultBits)
+		refreshTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+
+		pendingState = &domainAdapter.OAuthState{
+			Provider:         oauthAdapter.ProviderGoogle,
+			Nonce:            oauthNonce,
+			CodeVerifier:     oauthCodeVerifier,
+			BrowserNonceHash: sha256Hex(browserNonce),
+		}
+
+		baseUser = &models.User{
+			ID:        userID,
+			Email:     email,
+			FirstName: firstName,
+			LastName:  lastName,
+			Roles:     []string{models.RoleCustomer},
+		}
+
+		blockedAt   = time.Now()
+		blockedUser = &models.User{
+			ID:        userID,
+			Email:     email,
+			FirstName: firstName,
+			LastName:  lastName,
+			Roles:     []string{models.RoleCustomer},
+			BlockedAt: &blockedAt,
+		}
+
+		linkedIdentity = &models.UserIdentity{
+			ID:       uuid.New(),
+			UserID:   userID,
+			Provider: oauthAdapter.ProviderGoogle,
+			Subject:  subject,
+			Email:    email,
+		}
+
+		linkingState = &domainAdapter.OAuthState{
+			Provider:         oauthAdapter.ProviderGoogle,
+			Nonce:            oauthNonce,
+			CodeVerifier:     oauthCodeVerifier,
+			BrowserNonceHash: sha256Hex(browserNonce),
+			LinkUserID:       userID.String(),
+		}
+
+		otherUserState = &domainAdapter.OAuthState{
+			Provider:         oauthAdapter.ProviderGoogle,
+			Nonce:            oauthNonce,
+			CodeVerifier:     oauthCodeVerifier,
+			BrowserNonceHash: sha256Hex(browserNonce),
+			LinkUserID:       uuid.NewString(),
+		}
+
+		newIdentity = &models.UserIdentity{
+			UserID:   userID,
+			Provider: oauthAdapter.ProviderGoogle,
+			Subject:  subject,
+			Email:    email,
+		}
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		mock   func(m *oauthMocks)
+		expect expect
+	}{
+		{
+			name: "linked identity case",
+			args: args{
+				ctx:      ctx,
+				provider: oauthAdapter.ProviderGoogle,
+				identity: identity,
+			},
+			mock: func(m *oauthMocks) {
+				m.oauthStateAdapter.EXPECT().
+					Take(ctx, oauthState).
+					Return(pendingState, nil)
+
+				m.txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
+				m.identityRepo.EXPECT().
+					FindByProviderSubject(ctx, oauthAdapter.ProviderGoogle, subject).
+					Return(linkedIdentity, nil)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(baseUser, nil)
+
+				m.tokenAdapter.EXPECT().
+					Set(ctx, userID.String(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			expect: expect{
+				user:  baseUser,
+				token: true,
+			},
+		},
+		{
+			name: "email of existing user case",
+			args: args{
+				ctx:      ctx,
+				provider: oauthAdapter.ProviderGoogle,
+				identity: identity,
+			},
+			mock: func(m *oauthMocks) {
+				m.oauthStateAdapter.EXPECT().
+					Take(ctx, oauthState).
+					Return(pendingState, nil)
+
+				m.txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
+				m.identityRepo.EXPECT().
+					FindByProviderSubject(ctx, oauthAdapter.ProviderGoogle, subject).
+					Return(nil, pgx.ErrNoRows)
+
+				m.userRepo.EXPECT().
+					FindByEmail(ctx, email).
+					Return(baseUser, nil)
+
+				m.identityRepo.EXPECT().
+					Create(ctx, newIdentity).
+					Return(linkedIdentity, nil)
+
+				m.tokenAdapter.EXPECT().
+					Set(ctx, userID.String(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			expect: expect{
+				user:  baseUser,
+				token: true,
+			},
+		},
+		{
+			name: "link to signed-in user case",
+			args: args{
+				ctx:      ctx,
+				provider: oauthAdapter.ProviderGoogle,
+				identity: identity,
+			},
+			mock: func(m *oauthMocks) {
+				m.oauthStateAdapter.EXPECT().
+					Take(ctx, oauthState).
+					Return(linkingState, nil)
+
+				m.txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
+				m.identityRepo.EXPECT().
+					FindByProviderSubject(ctx, oauthAdapter.ProviderGoogle, subject).
+					Return(nil, pgx.ErrNoRows)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(baseUser, nil)
+
+				m.identityRepo.EXPECT().
+					Create(ctx, newIdentity).
+					Return(linkedIdentity, nil)
+
+				m.tokenAdapter.EXPECT().
+					Set(ctx, userID.String(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			expect: expect{
+				user:  baseUser,
+				token: true,
+			},
+		},
+		{
+			name: "identity of other user case",
+			args: args{
+				ctx:      ctx,
+				provider: oauthAdapter.ProviderGoogle,
+				identity: identity,
+			},
+			mock: func(m *oauthMocks) {
+				m.oauthStateAdapter.EXPECT().
+					Take(ctx, oauthState).
+					Return(otherUserState, nil)
+
+				m.txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
+				m.identityRepo.EXPECT().
+					FindByProviderSubject(ctx, oauthAdapter.ProviderGoogle, subject).
+					Return(linkedIdentity, nil)
+			},
+			expect: expect{
+				err: services.ErrIdentityExists,
+			},
+		},
+		{
+			name: "new user case",
+			args: args{
+				ctx:      ctx,
+				provider: oauthAdapter.ProviderGoogle,
+				identity: identity,
+			},
+			mock: func(m *oauthMocks) {
+				m.oauthStateAdapter.EXPECT().
+					Take(ctx, oauthState).
+					Return(pendingState, nil)
+
+				m.txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
+				m.identityRepo.EXPECT().
+					FindByProviderSubject(ctx, oauthAdapter.ProviderGoogle, subject).
+					Return(nil, pgx.ErrNoRows)
+
+				m.userRepo.EXPECT().
+					FindByEmail(ctx, email).
+					Return(nil, pgx.ErrNoRows)
+
+				m.userRepo.EXPECT().
+					Create(ctx, email, "", firstName, lastName).
+					Return(baseUser, nil)
+
+				m.identityRepo.EXPECT().
+					Create(ctx, newIdentity).
+					Return(linkedIdentity, nil)
+
+				m.tokenAdapter.EXPECT().
+					Set(ctx, userID.String(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			expect: expect{
+				user:  baseUser,
+				token: true,
+			},
+		},
+		{
+			name: "email not verified case",
+			args: args{
+				ctx:      ctx,
+				provider: oauthAdapter.ProviderGoogle,
+				identity: oauthtest.Identity{
+					Subject: subject,
+					Email:   email,
+				},
+			},
+			mock: func(m *oauthMocks) {
+				m.oauthStateAdapter.EXPECT().
+					Take(ctx, oauthState).
+					Return(pendingState, nil)
+
+				m.txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
+				m.identityRepo.EXPECT().
+					FindByProviderSubject(ctx, oauthAdapter.ProviderGoogle, subject).
+					Return(nil, pgx.ErrNoRows)
+			},
+			expect: expect{
+				err: services.ErrEmailNotVerified,
+			},
+		},
+		{
+			name: "user blocked case",
+			args: args{
+				ctx:      ctx,
+				provider: oauthAdapter.ProviderGoogle,
+				identity: identity,
+			},
+			mock: func(m *oauthMocks) {
+				m.oauthStateAdapter.EXPECT().
+					Take(ctx, oauthState).
+					Return(pendingState, nil)
+
+				m.txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
+				m.identityRepo.EXPECT().
+					FindByProviderSubject(ctx, oauthAdapter.ProviderGoogle, subject).
+					Return(linkedIdentity, nil)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(blockedUser, nil)
+			},
+			expect: expect{
+				err: services.ErrUserBlocked,
+			},
+		},
+		{
+			name: "state unknown case",
+			args: args{
+				ctx:      ctx,
+				provider: oauthAdapter.ProviderGoogle,
+				identity: identity,
+			},
+			mock: func(m *oauthMocks) {
+				m.oauthStateAdapter.EXPECT().
+					Take(ctx, oauthState).
+					Return(nil, redis.Nil)
+			},
+			expect: expect{
+				err: services.ErrOAuthStateInvalid,
+			},
+		},
+		{
+			name: "state of other provider case",
+			args: args{
+				ctx:      ctx,
+				provider: oauthAdapter.ProviderGoogle,
+				identity: identity,
+			},
+			mock: func(m *oauthMocks) {
+				m.oauthStateAdapter.EXPECT().
+					Take(ctx, oauthState).
+					Return(&domainAdapter.OAuthState{
+						Provider:         oauthAdapter.ProviderGitHub,
+						Nonce:            oauthNonce,
+						CodeVerifier:     oauthCodeVerifier,
+						BrowserNonceHash: sha256Hex(browserNonce),
+					}, nil)
+			},
+			expect: expect{
+				err: services.ErrOAuthStateInvalid,
+			},
+		},
+		{
+			name: "other browser case",
+			args: args{
+				ctx:          ctx,
+				provider:     oauthAdapter.ProviderGoogle,
+				identity:     identity,
+				browserNonce: "other-browser-nonce",
+			},
+			mock: func(m *oauthMocks) {
+				m.oauthStateAdapter.EXPECT().
+					Take(ctx, oauthState).
+					Return(pendingState, nil)
+			},
+			expect: expect{
+				err: services.ErrOAuthStateInvalid,
+			},
+		},
+		{
+			name: "code rejected case",
+			args: args{
+				ctx:      ctx,
+				provider: oauthAdapter.ProviderGoogle,
+				identity: identity,
+				code:     "forged",
+			},
+			mock: func(m *oauthMocks) {
+				m.oauthStateAdapter.EXPECT().
+					Take(ctx, oauthState).
+					Return(pendingState, nil)
+			},
+			expect: expect{
+				err: services.ErrIdentityRejected,
+			},
+		},
+		{
+			name: "provider unknown case",
+			args: args{
+				ctx:      ctx,
+				provider: "myspace",
+				identity: identity,
+			},
+			mock: func(m *oauthMocks) {},
+			expect: expect{
+				err: services.ErrProviderUnknown,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := oauthtest.NewServer(oauthClientID, oauthClientSecret)
+			defer server.Close()
+
+			provider := newGoogleProvider(server)
+
+			authURL, err := provider.AuthCodeURL(ctx, oauthState, oauthNonce, oauthCodeVerifier)
+			require.NoError(t, err)
+
+			code, _ := server.Authorize(authURL, tt.args.identity)
+			if tt.args.code != "" {
+				code = tt.args.code
+			}
+
+			nonce := browserNonce
+			if tt.args.browserNonce != "" {
+				nonce = tt.args.browserNonce
+			}
+
+			m := newOAuthMocks(ctrl)
+			tt.mock(m)
+
+			cfg := &configs.Config{
+				AccessTokenPrivateKey:  accessTokenPrivateKey,
+				AccessTokenExpiresIn:   15 * time.Minute,
+				RefreshTokenPrivateKey: refreshTokenPrivateKey,
+				RefreshTokenExpiresIn:  10080 * time.Minute,
+			}
+
+			log := observer.New(logger.LevelError)
+
+			authService, _ := services.NewAuthService(m.userRepo, m.identityRepo, nil, nil, m.txManager, m.tokenAdapter, m.oauthStateAdapter, nil, nil, []domain.IdentityProvider{provider}, nil, nil, metrics.NewNoop(), log, cfg)
+
+			user, accessToken, refreshToken, err := authService.OAuthLogin(tt.args.ctx, tt.args.provider, code, oauthState, nonce)
+
+			if tt.expect.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.expect.err.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.expect.user != nil {
+				require.NotNil(t, user)
+				require.Equal(t, tt.expect.user.ID, user.ID)
+			} else {
+				require.Nil(t, user)
+			}
+
+			if tt.expect.token {
+				require.NotEmpty(t, accessToken)
+				require.NotEmpty(t, refreshToken)
+			} else {
+				require.Empty(t, accessToken)
+				require.Empty(t, refreshToken)
+			}
+
+			observer.RequireNotLogged(t, log, logger.LevelError)
+		})
+	}
+}
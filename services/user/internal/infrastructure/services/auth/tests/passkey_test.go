@@ -0,0 +1,290 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/logger/observer"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
+	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	webauthnAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/webauthn"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/webauthn/webauthntest"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/metrics"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/auth"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const passkeyOrigin = "https://store.example"
+
+type passkeyMocks struct {
+	userRepo                *mocksRepo.MockUserRepository
+	passkeyRepo             *mocksRepo.MockPasskeyRepository
+	tokenAdapter            *mocksAdapter.MockTokenAdapter
+	passkeyChallengeAdapter *mocksAdapter.MockPasskeyChallengeAdapter
+}
+
+func newPasskeyMocks(ctrl *gomock.Controller) *passkeyMocks {
+	return &passkeyMocks{
+		userRepo:                mocksRepo.NewMockUserRepository(ctrl),
+		passkeyRepo:             mocksRepo.NewMockPasskeyRepository(ctrl),
+		tokenAdapter:            mocksAdapter.NewMockTokenAdapter(ctrl),
+		passkeyChallengeAdapter: mocksAdapter.NewMockPasskeyChallengeAdapter(ctrl),
+	}
+}
+
+func TestAuthService_PasskeyLogin(t *testing.T) {
+	type expect struct {
+		err   error
+		user  *models.User
+		token bool
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID = uuid.New()
+
+		challengeExpiresIn = 5 * time.Minute
+
+		accessTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn        = 15 * time.Minute
+
+		refreshTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn        = 10080 * time.Minute
+
+		user = &models.User{
+			ID:    userID,
+			Email: "test1@test.ru",
+			Roles: []string{models.RoleCustomer},
+		}
+
+		blockedAt   = time.Now()
+		blockedUser = &models.User{
+			ID:        userID,
+			Email:     "test1@test.ru",
+			Roles:     []string{models.RoleCustomer},
+			BlockedAt: &blockedAt,
+		}
+	)
+
+	tests := []struct {
+		name string
+		// finish sets up the calls made once the authenticator answered,
+		// given the challenge stored when the ceremony began and the passkey
+		// as registered.
+		finish func(m *passkeyMocks, challenge *domainAdapter.PasskeyChallenge, passkey *models.Passkey)
+		expect expect
+	}{
+		{
+			name: "success case",
+			finish: func(m *passkeyMocks, challenge *domainAdapter.PasskeyChallenge, passkey *models.Passkey) {
+				m.passkeyChallengeAdapter.EXPECT().
+					Take(ctx, gomock.Any()).
+					Return(challenge, nil)
+
+				m.passkeyRepo.EXPECT().
+					FindByCredentialID(ctx, passkey.CredentialID).
+					Return(passkey, nil)
+
+				m.passkeyRepo.EXPECT().
+					UpdateUsage(ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, used *models.Passkey) error {
+						require.Equal(t, passkey.ID, used.ID)
+						require.Equal(t, passkey.SignCount+1, used.SignCount)
+
+						return nil
+					})
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(user, nil)
+
+				m.tokenAdapter.EXPECT().
+					Set(ctx, userID.String(), gomock.Any(), refreshTokenExpiresIn).
+					Return(nil)
+			},
+			expect: expect{
+				user:  user,
+				token: true,
+			},
+		},
+		{
+			name: "ceremony expired case",
+			finish: func(m *passkeyMocks, _ *domainAdapter.PasskeyChallenge, _ *models.Passkey) {
+				m.passkeyChallengeAdapter.EXPECT().
+					Take(ctx, gomock.Any()).
+					Return(nil, redis.Nil)
+			},
+			expect: expect{
+				err: services.ErrPasskeyInvalid,
+			},
+		},
+		{
+			name: "registration ceremony case",
+			finish: func(m *passkeyMocks, challenge *domainAdapter.PasskeyChallenge, _ *models.Passkey) {
+				m.passkeyChallengeAdapter.EXPECT().
+					Take(ctx, gomock.Any()).
+					Return(&domainAdapter.PasskeyChallenge{
+						UserID:  userID.String(),
+						Session: challenge.Session,
+					}, nil)
+			},
+			expect: expect{
+				err: services.ErrPasskeyInvalid,
+			},
+		},
+		{
+			name: "passkey not registered case",
+			finish: func(m *passkeyMocks, challenge *domainAdapter.PasskeyChallenge, passkey *models.Passkey) {
+				m.passkeyChallengeAdapter.EXPECT().
+					Take(ctx, gomock.Any()).
+					Return(challenge, nil)
+
+				m.passkeyRepo.EXPECT().
+					FindByCredentialID(ctx, passkey.CredentialID).
+					Return(nil, pgx.ErrNoRows)
+			},
+			expect: expect{
+				err: services.ErrPasskeyInvalid,
+			},
+		},
+		{
+			name: "passkey deleted case",
+			finish: func(m *passkeyMocks, challenge *domainAdapter.PasskeyChallenge, passkey *models.Passkey) {
+				m.passkeyChallengeAdapter.EXPECT().
+					Take(ctx, gomock.Any()).
+					Return(challenge, nil)
+
+				m.passkeyRepo.EXPECT().
+					FindByCredentialID(ctx, passkey.CredentialID).
+					Return(passkey, nil)
+
+				m.passkeyRepo.EXPECT().
+					UpdateUsage(ctx, gomock.Any()).
+					Return(pgx.ErrNoRows)
+			},
+			expect: expect{
+				err: services.ErrPasskeyInvalid,
+			},
+		},
+		{
+			name: "user blocked case",
+			finish: func(m *passkeyMocks, challenge *domainAdapter.PasskeyChallenge, passkey *models.Passkey) {
+				m.passkeyChallengeAdapter.EXPECT().
+					Take(ctx, gomock.Any()).
+					Return(challenge, nil)
+
+				m.passkeyRepo.EXPECT().
+					FindByCredentialID(ctx, passkey.CredentialID).
+					Return(passkey, nil)
+
+				m.passkeyRepo.EXPECT().
+					UpdateUsage(ctx, gomock.Any()).
+					Return(nil)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(blockedUser, nil)
+			},
+			expect: expect{
+				err: services.ErrUserBlocked,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newPasskeyMocks(ctrl)
+
+			cfg := &configs.Config{
+				AccessTokenPrivateKey:     accessTokenPrivateKey,
+				AccessTokenExpiresIn:      accessTokenExpiresIn,
+				RefreshTokenPrivateKey:    refreshTokenPrivateKey,
+				RefreshTokenExpiresIn:     refreshTokenExpiresIn,
+				WebAuthnRPID:              "store.example",
+				WebAuthnRPName:            "Online Store",
+				WebAuthnRPOrigins:         []string{passkeyOrigin},
+				PasskeyChallengeExpiresIn: challengeExpiresIn,
+			}
+
+			log := observer.New(logger.LevelError)
+
+			passkeys, err := webauthnAdapter.NewPasskeys(log, cfg)
+			require.NoError(t, err)
+
+			// The passkey was registered earlier, on this authenticator.
+			authenticator := webauthntest.NewAuthenticator(passkeyOrigin)
+
+			registration, err := passkeys.BeginRegistration(user, nil)
+			require.NoError(t, err)
+
+			registered, err := authenticator.Register(registration.Options)
+			require.NoError(t, err)
+
+			passkey, err := passkeys.FinishRegistration(user, registration.Session, registered)
+			require.NoError(t, err)
+			passkey.ID = uuid.New()
+
+			var (
+				ceremonyID string
+				stored     *domainAdapter.PasskeyChallenge
+			)
+
+			m.passkeyChallengeAdapter.EXPECT().
+				Set(ctx, gomock.Any(), gomock.Any(), challengeExpiresIn).
+				DoAndReturn(func(_ context.Context, id string, challenge *domainAdapter.PasskeyChallenge, _ time.Duration) error {
+					ceremonyID, stored = id, challenge
+
+					return nil
+				})
+
+			authService, _ := services.NewAuthService(m.userRepo, nil, m.passkeyRepo, nil, nil, m.tokenAdapter, nil, nil, m.passkeyChallengeAdapter, nil, passkeys, nil, metrics.NewNoop(), log, cfg)
+
+			beganID, options, err := authService.BeginPasskeyLogin(ctx)
+			require.NoError(t, err)
+			require.Equal(t, ceremonyID, beganID)
+			require.Empty(t, stored.UserID)
+
+			response, err := authenticator.Login(options)
+			require.NoError(t, err)
+
+			tt.finish(m, stored, passkey)
+
+			user, accessToken, refreshToken, err := authService.FinishPasskeyLogin(ctx, ceremonyID, response)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.expect.user, user)
+
+			if tt.expect.token {
+				require.NotEmpty(t, accessToken)
+				require.NotEmpty(t, refreshToken)
+			} else {
+				require.Empty(t, accessToken)
+				require.Empty(t, refreshToken)
+			}
+
+			observer.RequireNotLogged(t, log, logger.LevelError)
+		})
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
 	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
 	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/metrics"
 	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/auth"
 	"github.com/go-redis/redis/v8"
 	"github.com/golang/mock/gomock"
@@ -35,25 +37,23 @@ func TestAuthService_RefreshToken(t *testing.T) {
 
 		userID = uuid.New()
 		email  = "test@test.ru"
-		role   = models.UserRole
+		role   = models.RoleCustomer
 
-		accessTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		accessTokenPublicKey  = generateRSAPublicKeyBase64(t, accessTokenPrivateKey)
-		accessTokenExpiresIn  = 15 * time.Minute
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn                           = 15 * time.Minute
 
-		refreshTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		refreshTokenPublicKey  = generateRSAPublicKeyBase64(t, refreshTokenPrivateKey)
-		refreshTokenExpiresIn  = 10080 * time.Minute
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn                            = 10080 * time.Minute
 
-		refreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), string(role), refreshTokenPrivateKey)
+		refreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), role, refreshTokenPrivateKey)
 
-		wrongRefreshTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		wrongRefreshToken, _        = jwt.Create(refreshTokenExpiresIn, userID.String(), string(role), wrongRefreshTokenPrivateKey)
+		wrongRefreshTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		wrongRefreshToken, _              = jwt.Create(refreshTokenExpiresIn, userID.String(), role, wrongRefreshTokenPrivateKey)
 
 		baseUser = &models.User{
 			ID:    userID,
 			Email: email,
-			Role:  role,
+			Roles: []string{role},
 		}
 	)
 
@@ -197,7 +197,7 @@ func TestAuthService_RefreshToken(t *testing.T) {
 				Level: logger.LevelError,
 			})
 
-			authService, _ := services.NewAuthService(userRepo, tokenAdapter, log, cfg)
+			authService, _ := services.NewAuthService(userRepo, nil, nil, nil, nil, tokenAdapter, nil, nil, nil, nil, nil, nil, metrics.NewNoop(), log, cfg)
 
 			accessToken, err := authService.RefreshToken(tt.args.ctx, tt.args.refreshToken)
 
@@ -2,14 +2,19 @@ package tests
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/BlazeCoder04/online_store/libs/logger"
 	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
 	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
 	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
 	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/metrics"
 	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/auth"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/golang/mock/gomock"
@@ -42,11 +47,11 @@ func TestAuthService_Register(t *testing.T) {
 		firstName = gofakeit.FirstName()
 		lastName  = gofakeit.LastName()
 
-		accessTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		accessTokenExpiresIn  = 15 * time.Minute
+		accessTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn        = 15 * time.Minute
 
-		refreshTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		refreshTokenExpiresIn  = 10080 * time.Minute
+		refreshTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn        = 10080 * time.Minute
 
 		baseUser = &models.User{
 			ID:        userID,
@@ -54,14 +59,14 @@ func TestAuthService_Register(t *testing.T) {
 			Password:  password,
 			FirstName: firstName,
 			LastName:  lastName,
-			Role:      models.UserRole,
+			Roles:     []string{models.RoleCustomer},
 		}
 	)
 
 	tests := []struct {
 		name                   string
 		args                   args
-		mock                   func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter)
+		mock                   func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksRepo.MockTxManager, *mocksAdapter.MockTokenAdapter)
 		expect                 expect
 		accessTokenPrivateKey  string
 		refreshTokenPrivateKey string
@@ -75,10 +80,15 @@ func TestAuthService_Register(t *testing.T) {
 				firstName: firstName,
 				lastName:  lastName,
 			},
-			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksRepo.MockTxManager, *mocksAdapter.MockTokenAdapter) {
 				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				txManager := mocksRepo.NewMockTxManager(ctrl)
 				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
 
+				txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
 				userRepo.EXPECT().
 					FindByEmail(ctx, email).
 					Return(nil, pgx.ErrNoRows)
@@ -91,7 +101,7 @@ func TestAuthService_Register(t *testing.T) {
 					Set(ctx, baseUser.ID.String(), gomock.Any(), gomock.Any()).
 					Return(nil)
 
-				return userRepo, tokenAdapter
+				return userRepo, txManager, tokenAdapter
 			},
 			expect: expect{
 				err:   nil,
@@ -110,15 +120,56 @@ func TestAuthService_Register(t *testing.T) {
 				firstName: firstName,
 				lastName:  lastName,
 			},
-			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksRepo.MockTxManager, *mocksAdapter.MockTokenAdapter) {
 				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				txManager := mocksRepo.NewMockTxManager(ctrl)
 				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
 
+				txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
 				userRepo.EXPECT().
 					FindByEmail(ctx, email).
 					Return(baseUser, nil)
 
-				return userRepo, tokenAdapter
+				return userRepo, txManager, tokenAdapter
+			},
+			expect: expect{
+				err:   services.ErrUserExists,
+				user:  nil,
+				token: false,
+			},
+			accessTokenPrivateKey:  accessTokenPrivateKey,
+			refreshTokenPrivateKey: refreshTokenPrivateKey,
+		},
+		{
+			name: "user created concurrently case",
+			args: args{
+				ctx:       ctx,
+				email:     email,
+				password:  password,
+				firstName: firstName,
+				lastName:  lastName,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksRepo.MockTxManager, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				txManager := mocksRepo.NewMockTxManager(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
+				userRepo.EXPECT().
+					FindByEmail(ctx, email).
+					Return(nil, pgx.ErrNoRows)
+
+				userRepo.EXPECT().
+					Create(ctx, email, gomock.Any(), firstName, lastName).
+					Return(nil, domainErrors.ErrUserExists)
+
+				return userRepo, txManager, tokenAdapter
 			},
 			expect: expect{
 				err:   services.ErrUserExists,
@@ -138,7 +189,7 @@ func TestAuthService_Register(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			userRepo, tokenAdapter := tt.mock(ctrl)
+			userRepo, txManager, tokenAdapter := tt.mock(ctrl)
 
 			cfg := &configs.Config{
 				AccessTokenPrivateKey:  accessTokenPrivateKey,
@@ -151,7 +202,7 @@ func TestAuthService_Register(t *testing.T) {
 				Level: logger.LevelError,
 			})
 
-			authService, _ := services.NewAuthService(userRepo, tokenAdapter, log, cfg)
+			authService, _ := services.NewAuthService(userRepo, nil, nil, nil, txManager, tokenAdapter, nil, nil, nil, nil, nil, nil, metrics.NewNoop(), log, cfg)
 
 			user, accessToken, refreshToken, err := authService.Register(tt.args.ctx, tt.args.email, tt.args.password, tt.args.firstName, tt.args.lastName)
 
@@ -179,3 +230,115 @@ func TestAuthService_Register(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthService_RegisterConcurrent(t *testing.T) {
+	const callers = 8
+
+	var (
+		ctx = context.Background()
+
+		email     = "test1@test.ru"
+		password  = gofakeit.Password(true, true, true, true, false, 12)
+		firstName = gofakeit.FirstName()
+		lastName  = gofakeit.LastName()
+
+		accessTokenPrivateKey, _, _  = keys.Generate(keys.DefaultBits)
+		refreshTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+
+		mu      sync.Mutex
+		created bool
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocksRepo.NewMockUserRepository(ctrl)
+	txManager := mocksRepo.NewMockTxManager(ctrl)
+	tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+	txManager.EXPECT().
+		WithinTx(ctx, gomock.Any()).
+		DoAndReturn(runWithinTx).
+		Times(callers)
+
+	// Every caller passes the existence check before anyone inserts, as two
+	// racing transactions would; the unique constraint then rejects all but one.
+	userRepo.EXPECT().
+		FindByEmail(ctx, email).
+		Return(nil, pgx.ErrNoRows).
+		Times(callers)
+
+	userRepo.EXPECT().
+		Create(ctx, email, gomock.Any(), firstName, lastName).
+		DoAndReturn(func(_ context.Context, email, password, firstName, lastName string) (*models.User, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if created {
+				return nil, domainErrors.ErrUserExists
+			}
+			created = true
+
+			return &models.User{
+				ID:        uuid.New(),
+				Email:     email,
+				Password:  password,
+				FirstName: firstName,
+				LastName:  lastName,
+				Roles:     []string{models.RoleCustomer},
+			}, nil
+		}).
+		Times(callers)
+
+	tokenAdapter.EXPECT().
+		Set(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	cfg := &configs.Config{
+		AccessTokenPrivateKey:  accessTokenPrivateKey,
+		AccessTokenExpiresIn:   15 * time.Minute,
+		RefreshTokenPrivateKey: refreshTokenPrivateKey,
+		RefreshTokenExpiresIn:  10080 * time.Minute,
+	}
+
+	log, _ := logger.NewAdapter(&logger.Config{
+		Level: logger.LevelError,
+	})
+
+	authService, _ := services.NewAuthService(userRepo, nil, nil, nil, txManager, tokenAdapter, nil, nil, nil, nil, nil, nil, metrics.NewNoop(), log, cfg)
+
+	errs := make(chan error, callers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, _, _, err := authService.Register(ctx, email, password, firstName, lastName)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	var succeeded, rejected int
+	for err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.Is(err, services.ErrUserExists):
+			rejected++
+		default:
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	require.Equal(t, 1, succeeded)
+	require.Equal(t, callers-1, rejected)
+}
+
+func runWithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
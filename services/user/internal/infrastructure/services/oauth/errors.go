@@ -0,0 +1,18 @@
+package services
+
+import (
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+)
+
+var (
+	ErrUserBlocked  = domainErrors.ErrUserBlocked
+	ErrTokenInvalid = domainErrors.ErrTokenInvalid
+
+	ErrClientInvalid        = domainErrors.ErrClientInvalid
+	ErrClientUnauthorized   = domainErrors.ErrClientUnauthorized
+	ErrGrantInvalid         = domainErrors.ErrGrantInvalid
+	ErrGrantTypeUnsupported = domainErrors.ErrGrantTypeUnsupported
+	ErrRedirectURIInvalid   = domainErrors.ErrRedirectURIInvalid
+	ErrScopeInvalid         = domainErrors.ErrScopeInvalid
+	ErrScopeInsufficient    = domainErrors.ErrScopeInsufficient
+)
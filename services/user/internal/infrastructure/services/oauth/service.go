@@ -0,0 +1,566 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	domainRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories"
+	domainService "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	claimScope    = "scope"
+	claimClientID = "client_id"
+
+	tokenTypeAccess  = "access_token"
+	tokenTypeRefresh = "refresh_token"
+
+	// codeBytes is the entropy of an authorization code.
+	codeBytes = 32
+
+	// RFC 7636 bounds the length of a PKCE code verifier.
+	minVerifierLength = 43
+	maxVerifierLength = 128
+)
+
+type OAuthService struct {
+	clientRepo      domainRepo.OAuthClientRepository
+	userRepo        domainRepo.UserRepository
+	tokenAdapter    domainAdapter.TokenAdapter
+	authCodeAdapter domainAdapter.AuthCodeAdapter
+	metrics         domain.BusinessMetrics
+	logger          logger.Logger
+	cfg             configs.Provider
+}
+
+func NewOAuthService(clientRepo domainRepo.OAuthClientRepository, userRepo domainRepo.UserRepository, tokenAdapter domainAdapter.TokenAdapter, authCodeAdapter domainAdapter.AuthCodeAdapter, metrics domain.BusinessMetrics, logger logger.Logger, cfg configs.Provider) (domainService.OAuthService, error) {
+	loggerTag := "oauth.service.newOAuthService"
+
+	logger.Info(loggerTag, "OAuth service initialized")
+
+	return &OAuthService{
+		clientRepo,
+		userRepo,
+		tokenAdapter,
+		authCodeAdapter,
+		metrics,
+		logger,
+		cfg,
+	}, nil
+}
+
+// verify reports whether token is signed with the key of publicKey and not
+// expired. Every other failure means the same to a client: the token is of
+// no use.
+func verify(token, publicKey string) (map[string]any, bool) {
+	claims, err := jwt.Verify(token, publicKey)
+	if err != nil {
+		return nil, false
+	}
+
+	return claims, true
+}
+
+// grantedScope checks the requested scopes against the allowed ones and
+// returns them sorted. An empty request is granted everything allowed.
+func grantedScope(allowed []string, requested string) (string, error) {
+	scopes := strings.Fields(requested)
+	if len(scopes) == 0 {
+		scopes = slices.Clone(allowed)
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(allowed, scope) {
+			return "", ErrScopeInvalid
+		}
+	}
+
+	slices.Sort(scopes)
+
+	return strings.Join(slices.Compact(scopes), " "), nil
+}
+
+// verifyCodeChallenge checks the PKCE verifier against the S256 challenge
+// the authorization code was issued for.
+func verifyCodeChallenge(challenge, verifier string) bool {
+	if len(verifier) < minVerifierLength || len(verifier) > maxVerifierLength {
+		return false
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+
+	return subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(sum[:])), []byte(challenge)) == 1
+}
+
+func (s *OAuthService) findClient(ctx context.Context, clientID string) (*models.OAuthClient, error) {
+	loggerTag := "oauth.service.findClient"
+
+	client, err := s.clientRepo.FindByID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClientInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find client: %v", err))
+
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// authenticateClient checks the secret of a confidential client. A public
+// client has none and must not send one.
+func (s *OAuthService) authenticateClient(ctx context.Context, clientID, clientSecret string) (*models.OAuthClient, error) {
+	loggerTag := "oauth.service.authenticateClient"
+
+	client, err := s.findClient(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if client.Public() {
+		if clientSecret != "" {
+			return nil, ErrClientInvalid
+		}
+
+		return client, nil
+	}
+
+	if err = compareSecret(ctx, client.SecretHash, clientSecret); err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return nil, ErrClientInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed compare client secret: %v", err))
+
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// activeUser returns the user a grant was made by, unless the user has been
+// deleted or blocked since.
+func (s *OAuthService) activeUser(ctx context.Context, userID string) (*models.User, error) {
+	loggerTag := "oauth.service.activeUser"
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrGrantInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
+
+		return nil, err
+	}
+
+	if user.Blocked() {
+		return nil, ErrUserBlocked
+	}
+
+	return user, nil
+}
+
+func (s *OAuthService) Authorize(ctx context.Context, args *domainService.AuthorizeArgs) (string, error) {
+	loggerTag := "oauth.service.authorize"
+
+	cfg := s.cfg.Current()
+
+	claims, ok := verify(args.AccessToken, cfg.AccessTokenPublicKey)
+	if !ok {
+		return "", ErrTokenInvalid
+	}
+
+	// Only the user's own session may grant access to a client, not a token
+	// another client was granted.
+	if _, scoped := claims[claimScope]; scoped {
+		return "", ErrScopeInsufficient
+	}
+
+	userID, _ := claims["sub"].(string)
+
+	if _, err := s.tokenAdapter.Get(ctx, models.SessionKey("", userID)); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", ErrTokenInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed get refresh token from redis: %v", err))
+
+		return "", err
+	}
+
+	if _, err := s.activeUser(ctx, userID); err != nil {
+		if errors.Is(err, ErrGrantInvalid) {
+			return "", ErrTokenInvalid
+		}
+
+		return "", err
+	}
+
+	client, err := s.findClient(ctx, args.ClientID)
+	if err != nil {
+		return "", err
+	}
+
+	if !client.AllowsRedirectURI(args.RedirectURI) {
+		return "", ErrRedirectURIInvalid
+	}
+
+	if !client.AllowsGrant(models.GrantAuthorizationCode) {
+		return "", ErrClientUnauthorized
+	}
+
+	scope, err := grantedScope(client.Scopes, args.Scope)
+	if err != nil {
+		return "", err
+	}
+
+	redirectURL, err := url.Parse(args.RedirectURI)
+	if err != nil {
+		return "", ErrRedirectURIInvalid
+	}
+
+	b := make([]byte, codeBytes)
+	rand.Read(b)
+	code := base64.RawURLEncoding.EncodeToString(b)
+
+	authCode := &domainAdapter.AuthCode{
+		ClientID:      client.ID,
+		UserID:        userID,
+		RedirectURI:   args.RedirectURI,
+		Scope:         scope,
+		CodeChallenge: args.CodeChallenge,
+	}
+
+	if err = s.authCodeAdapter.Set(ctx, code, authCode, cfg.OAuthCodeExpiresIn); err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed add auth code to redis: %v", err))
+
+		return "", err
+	}
+
+	query := redirectURL.Query()
+	query.Set("code", code)
+	if args.State != "" {
+		query.Set("state", args.State)
+	}
+	redirectURL.RawQuery = query.Encode()
+
+	return redirectURL.String(), nil
+}
+
+func (s *OAuthService) Token(ctx context.Context, args *domainService.TokenArgs) (*models.OAuthToken, error) {
+	if !slices.Contains(models.GrantTypes, args.GrantType) {
+		return nil, ErrGrantTypeUnsupported
+	}
+
+	client, err := s.authenticateClient(ctx, args.ClientID, args.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if !client.AllowsGrant(args.GrantType) {
+		return nil, ErrClientUnauthorized
+	}
+
+	var token *models.OAuthToken
+	switch args.GrantType {
+	case models.GrantAuthorizationCode:
+		token, err = s.exchangeCode(ctx, client, args)
+	case models.GrantRefreshToken:
+		token, err = s.refresh(ctx, client, args)
+	case models.GrantClientCredentials:
+		token, err = s.clientCredentials(ctx, client, args)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.metrics.OAuthTokenIssued(args.GrantType)
+
+	return token, nil
+}
+
+func (s *OAuthService) exchangeCode(ctx context.Context, client *models.OAuthClient, args *domainService.TokenArgs) (*models.OAuthToken, error) {
+	loggerTag := "oauth.service.exchangeCode"
+
+	authCode, err := s.authCodeAdapter.Take(ctx, args.Code)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrGrantInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed take auth code from redis: %v", err))
+
+		return nil, err
+	}
+
+	// The code is taken either way, so a wrong guess at the verifier burns
+	// it instead of leaving it to be tried again.
+	if authCode.ClientID != client.ID || authCode.RedirectURI != args.RedirectURI {
+		return nil, ErrGrantInvalid
+	}
+
+	if !verifyCodeChallenge(authCode.CodeChallenge, args.CodeVerifier) {
+		return nil, ErrGrantInvalid
+	}
+
+	user, err := s.activeUser(ctx, authCode.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(ctx, client, user, authCode.Scope)
+}
+
+func (s *OAuthService) refresh(ctx context.Context, client *models.OAuthClient, args *domainService.TokenArgs) (*models.OAuthToken, error) {
+	loggerTag := "oauth.service.refresh"
+
+	claims, ok := verify(args.RefreshToken, s.cfg.Current().RefreshTokenPublicKey)
+	if !ok {
+		return nil, ErrGrantInvalid
+	}
+
+	// First-party refresh tokens have no client ID and are never accepted.
+	if clientID, _ := claims[claimClientID].(string); clientID != client.ID {
+		return nil, ErrGrantInvalid
+	}
+
+	userID, _ := claims["sub"].(string)
+
+	storedRefreshToken, err := s.tokenAdapter.Get(ctx, models.SessionKey(client.ID, userID))
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrGrantInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed get refresh token from redis: %v", err))
+
+		return nil, err
+	}
+
+	if storedRefreshToken != args.RefreshToken {
+		return nil, ErrGrantInvalid
+	}
+
+	// A refresh may narrow the scope of the grant, never widen it.
+	granted, _ := claims[claimScope].(string)
+
+	scope, err := grantedScope(strings.Fields(granted), args.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.activeUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(ctx, client, user, scope)
+}
+
+func (s *OAuthService) clientCredentials(ctx context.Context, client *models.OAuthClient, args *domainService.TokenArgs) (*models.OAuthToken, error) {
+	loggerTag := "oauth.service.clientCredentials"
+
+	if client.Public() {
+		return nil, ErrClientUnauthorized
+	}
+
+	scope, err := grantedScope(client.Scopes, args.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := s.cfg.Current()
+
+	accessToken, err := createToken(ctx, cfg.AccessTokenExpiresIn, map[string]any{
+		"sub":         client.ID,
+		claimClientID: client.ID,
+		claimScope:    scope,
+	}, cfg.AccessTokenPrivateKey)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed create access token: %v", err))
+
+		return nil, err
+	}
+
+	return &models.OAuthToken{
+		AccessToken: accessToken,
+		ExpiresIn:   cfg.AccessTokenExpiresIn,
+		Scope:       scope,
+	}, nil
+}
+
+// issueTokens starts a new session of the user at the client, replacing the
+// previous one. The refresh token is stored even for clients that may not
+// use it, since the session lives as long as it is stored; they get a
+// session no longer than the access token instead.
+func (s *OAuthService) issueTokens(ctx context.Context, client *models.OAuthClient, user *models.User, scope string) (*models.OAuthToken, error) {
+	loggerTag := "oauth.service.issueTokens"
+
+	// One snapshot for both tokens, so a reload in between cannot mix TTLs.
+	cfg := s.cfg.Current()
+
+	claims := models.ScopedClaims(user)
+	claims[claimClientID] = client.ID
+	claims[claimScope] = scope
+
+	accessToken, err := createToken(ctx, cfg.AccessTokenExpiresIn, claims, cfg.AccessTokenPrivateKey)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed create access token: %v", err))
+
+		return nil, err
+	}
+
+	refreshable := client.AllowsGrant(models.GrantRefreshToken)
+
+	sessionExpiresIn := cfg.AccessTokenExpiresIn
+	if refreshable {
+		sessionExpiresIn = cfg.RefreshTokenExpiresIn
+	}
+
+	refreshToken, err := createToken(ctx, sessionExpiresIn, claims, cfg.RefreshTokenPrivateKey)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed create refresh token: %v", err))
+
+		return nil, err
+	}
+
+	if err = s.tokenAdapter.Set(ctx, models.SessionKey(client.ID, user.ID.String()), refreshToken, sessionExpiresIn); err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed add refresh token to redis: %v", err))
+
+		return nil, err
+	}
+
+	token := &models.OAuthToken{
+		AccessToken: accessToken,
+		ExpiresIn:   cfg.AccessTokenExpiresIn,
+		Scope:       scope,
+	}
+	if refreshable {
+		token.RefreshToken = refreshToken
+	}
+
+	return token, nil
+}
+
+// parseToken tells access tokens from refresh tokens by the key they are
+// signed with.
+func (s *OAuthService) parseToken(token string) (map[string]any, string, bool) {
+	cfg := s.cfg.Current()
+
+	if claims, ok := verify(token, cfg.AccessTokenPublicKey); ok {
+		return claims, tokenTypeAccess, true
+	}
+
+	if claims, ok := verify(token, cfg.RefreshTokenPublicKey); ok {
+		return claims, tokenTypeRefresh, true
+	}
+
+	return nil, "", false
+}
+
+func (s *OAuthService) Revoke(ctx context.Context, clientID, clientSecret, token string) error {
+	loggerTag := "oauth.service.revoke"
+
+	client, err := s.authenticateClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return err
+	}
+
+	claims, _, ok := s.parseToken(token)
+	if !ok {
+		return nil
+	}
+
+	if tokenClientID, _ := claims[claimClientID].(string); tokenClientID != client.ID {
+		return ErrClientUnauthorized
+	}
+
+	userID, _ := claims["sub"].(string)
+
+	// Access tokens of a session stop working with it, see
+	// ProfileService.VerifyToken. Client credentials tokens have no session
+	// and expire on their own.
+	if err = s.tokenAdapter.Del(ctx, models.SessionKey(client.ID, userID)); err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed delete refresh token from redis: %v", err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *OAuthService) Introspect(ctx context.Context, clientID, clientSecret, token string) (*models.TokenIntrospection, error) {
+	loggerTag := "oauth.service.introspect"
+
+	client, err := s.authenticateClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	// A public client cannot prove who is asking.
+	if client.Public() {
+		return nil, ErrClientUnauthorized
+	}
+
+	inactive := &models.TokenIntrospection{}
+
+	claims, tokenType, ok := s.parseToken(token)
+	if !ok {
+		return inactive, nil
+	}
+
+	tokenClientID, _ := claims[claimClientID].(string)
+	subject, _ := claims["sub"].(string)
+
+	// Client credentials tokens are issued to the client itself and have no
+	// session to look up.
+	if subject != tokenClientID {
+		storedRefreshToken, err := s.tokenAdapter.Get(ctx, models.SessionKey(tokenClientID, subject))
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				return inactive, nil
+			}
+
+			s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed get refresh token from redis: %v", err))
+
+			return nil, err
+		}
+
+		if tokenType == tokenTypeRefresh && storedRefreshToken != token {
+			return inactive, nil
+		}
+	}
+
+	scope, _ := claims[claimScope].(string)
+	exp, _ := claims["exp"].(float64)
+
+	return &models.TokenIntrospection{
+		Active:    true,
+		TokenType: tokenType,
+		ClientID:  tokenClientID,
+		Subject:   subject,
+		Scope:     scope,
+		ExpiresAt: time.Unix(int64(exp), 0),
+	}, nil
+}
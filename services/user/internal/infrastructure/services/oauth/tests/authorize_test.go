@@ -0,0 +1,337 @@
+package tests
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
+	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	domainService "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/metrics"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/oauth"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	clientID    = "mobile-app"
+	redirectURI = "store://oauth/callback"
+	// codeVerifier is 43 characters, the shortest RFC 7636 allows.
+	codeVerifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+)
+
+type oauthMocks struct {
+	clientRepo      *mocksRepo.MockOAuthClientRepository
+	userRepo        *mocksRepo.MockUserRepository
+	tokenAdapter    *mocksAdapter.MockTokenAdapter
+	authCodeAdapter *mocksAdapter.MockAuthCodeAdapter
+}
+
+func newOAuthMocks(ctrl *gomock.Controller) *oauthMocks {
+	return &oauthMocks{
+		clientRepo:      mocksRepo.NewMockOAuthClientRepository(ctrl),
+		userRepo:        mocksRepo.NewMockUserRepository(ctrl),
+		tokenAdapter:    mocksAdapter.NewMockTokenAdapter(ctrl),
+		authCodeAdapter: mocksAdapter.NewMockAuthCodeAdapter(ctrl),
+	}
+}
+
+func newOAuthService(m *oauthMocks, cfg *configs.Config) domainService.OAuthService {
+	log, _ := logger.NewAdapter(&logger.Config{
+		Level: logger.LevelError,
+	})
+
+	oauthService, _ := services.NewOAuthService(m.clientRepo, m.userRepo, m.tokenAdapter, m.authCodeAdapter, metrics.NewNoop(), log, cfg)
+
+	return oauthService
+}
+
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func TestOAuthService_Authorize(t *testing.T) {
+	type expect struct {
+		err error
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID = uuid.New()
+		role   = models.RoleCustomer
+
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn                           = 15 * time.Minute
+		codeExpiresIn                                  = time.Minute
+
+		accessToken, _       = jwt.Create(accessTokenExpiresIn, userID.String(), role, accessTokenPrivateKey)
+		clientAccessToken, _ = jwt.CreateWithClaims(accessTokenExpiresIn, map[string]any{
+			"sub":       userID.String(),
+			"client_id": clientID,
+			"scope":     models.ScopeProfileRead,
+		}, accessTokenPrivateKey)
+
+		wrongPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		wrongAccessToken, _   = jwt.Create(accessTokenExpiresIn, userID.String(), role, wrongPrivateKey)
+
+		blockedAt = time.Now()
+
+		user = &models.User{
+			ID:    userID,
+			Roles: []string{role},
+		}
+		blockedUser = &models.User{
+			ID:        userID,
+			Roles:     []string{role},
+			BlockedAt: &blockedAt,
+		}
+
+		client = &models.OAuthClient{
+			ID:           clientID,
+			RedirectURIs: []string{redirectURI},
+			GrantTypes:   []string{models.GrantAuthorizationCode, models.GrantRefreshToken},
+			Scopes:       []string{models.ScopeProfileRead, models.ScopeProfileWrite},
+		}
+		clientCredentialsClient = &models.OAuthClient{
+			ID:           clientID,
+			SecretHash:   "hash",
+			RedirectURIs: []string{redirectURI},
+			GrantTypes:   []string{models.GrantClientCredentials},
+			Scopes:       []string{models.ScopeProfileRead},
+		}
+
+		baseArgs = domainService.AuthorizeArgs{
+			ClientID:      clientID,
+			RedirectURI:   redirectURI,
+			Scope:         models.ScopeProfileRead,
+			State:         "xyz",
+			CodeChallenge: codeChallenge(codeVerifier),
+			AccessToken:   accessToken,
+		}
+	)
+
+	// withArgs returns baseArgs with change applied.
+	withArgs := func(change func(args *domainService.AuthorizeArgs)) *domainService.AuthorizeArgs {
+		args := baseArgs
+		change(&args)
+
+		return &args
+	}
+
+	session := func(m *oauthMocks) {
+		m.tokenAdapter.EXPECT().
+			Get(ctx, userID.String()).
+			Return("refresh_token", nil)
+	}
+
+	tests := []struct {
+		name   string
+		args   *domainService.AuthorizeArgs
+		mock   func(m *oauthMocks)
+		expect expect
+	}{
+		{
+			name: "success case",
+			args: withArgs(func(*domainService.AuthorizeArgs) {}),
+			mock: func(m *oauthMocks) {
+				session(m)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(user, nil)
+
+				m.clientRepo.EXPECT().
+					FindByID(ctx, clientID).
+					Return(client, nil)
+
+				m.authCodeAdapter.EXPECT().
+					Set(ctx, gomock.Any(), &domainAdapter.AuthCode{
+						ClientID:      clientID,
+						UserID:        userID.String(),
+						RedirectURI:   redirectURI,
+						Scope:         models.ScopeProfileRead,
+						CodeChallenge: codeChallenge(codeVerifier),
+					}, codeExpiresIn).
+					Return(nil)
+			},
+			expect: expect{
+				err: nil,
+			},
+		},
+		{
+			name: "token invalid case",
+			args: withArgs(func(args *domainService.AuthorizeArgs) {
+				args.AccessToken = wrongAccessToken
+			}),
+			mock: func(m *oauthMocks) {},
+			expect: expect{
+				err: services.ErrTokenInvalid,
+			},
+		},
+		{
+			name: "client token case",
+			args: withArgs(func(args *domainService.AuthorizeArgs) {
+				args.AccessToken = clientAccessToken
+			}),
+			mock: func(m *oauthMocks) {},
+			expect: expect{
+				err: services.ErrScopeInsufficient,
+			},
+		},
+		{
+			name: "logged out case",
+			args: withArgs(func(*domainService.AuthorizeArgs) {}),
+			mock: func(m *oauthMocks) {
+				m.tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return("", redis.Nil)
+			},
+			expect: expect{
+				err: services.ErrTokenInvalid,
+			},
+		},
+		{
+			name: "user blocked case",
+			args: withArgs(func(*domainService.AuthorizeArgs) {}),
+			mock: func(m *oauthMocks) {
+				session(m)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(blockedUser, nil)
+			},
+			expect: expect{
+				err: services.ErrUserBlocked,
+			},
+		},
+		{
+			name: "client unknown case",
+			args: withArgs(func(*domainService.AuthorizeArgs) {}),
+			mock: func(m *oauthMocks) {
+				session(m)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(user, nil)
+
+				m.clientRepo.EXPECT().
+					FindByID(ctx, clientID).
+					Return(nil, pgx.ErrNoRows)
+			},
+			expect: expect{
+				err: services.ErrClientInvalid,
+			},
+		},
+		{
+			name: "redirect uri not registered case",
+			args: withArgs(func(args *domainService.AuthorizeArgs) {
+				args.RedirectURI = "https://evil.example/callback"
+			}),
+			mock: func(m *oauthMocks) {
+				session(m)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(user, nil)
+
+				m.clientRepo.EXPECT().
+					FindByID(ctx, clientID).
+					Return(client, nil)
+			},
+			expect: expect{
+				err: services.ErrRedirectURIInvalid,
+			},
+		},
+		{
+			name: "grant not allowed case",
+			args: withArgs(func(*domainService.AuthorizeArgs) {}),
+			mock: func(m *oauthMocks) {
+				session(m)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(user, nil)
+
+				m.clientRepo.EXPECT().
+					FindByID(ctx, clientID).
+					Return(clientCredentialsClient, nil)
+			},
+			expect: expect{
+				err: services.ErrClientUnauthorized,
+			},
+		},
+		{
+			name: "scope invalid case",
+			args: withArgs(func(args *domainService.AuthorizeArgs) {
+				args.Scope = "profile:read admin"
+			}),
+			mock: func(m *oauthMocks) {
+				session(m)
+
+				m.userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(user, nil)
+
+				m.clientRepo.EXPECT().
+					FindByID(ctx, clientID).
+					Return(client, nil)
+			},
+			expect: expect{
+				err: services.ErrScopeInvalid,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newOAuthMocks(ctrl)
+			tt.mock(m)
+
+			cfg := &configs.Config{
+				AccessTokenPrivateKey: accessTokenPrivateKey,
+				AccessTokenPublicKey:  accessTokenPublicKey,
+				AccessTokenExpiresIn:  accessTokenExpiresIn,
+				OAuthCodeExpiresIn:    codeExpiresIn,
+			}
+
+			redirectURL, err := newOAuthService(m, cfg).Authorize(ctx, tt.args)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+				require.Empty(t, redirectURL)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			parsed, err := url.Parse(redirectURL)
+			require.NoError(t, err)
+			require.Equal(t, "store", parsed.Scheme)
+			require.NotEmpty(t, parsed.Query().Get("code"))
+			require.Equal(t, tt.args.State, parsed.Query().Get("state"))
+		})
+	}
+}
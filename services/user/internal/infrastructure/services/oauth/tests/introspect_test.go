@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/hash"
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/oauth"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthService_Introspect(t *testing.T) {
+	type args struct {
+		clientID     string
+		clientSecret string
+		token        string
+	}
+
+	type expect struct {
+		err           error
+		introspection *models.TokenIntrospection
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID = uuid.New()
+
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn                           = 15 * time.Minute
+
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn                            = 10080 * time.Minute
+
+		sessionKey = models.SessionKey(clientID, userID.String())
+
+		resourceClientID = "product-service"
+		clientSecret     = "secret"
+		secretHash, _    = hash.HashPassword(clientSecret)
+
+		resourceClient = &models.OAuthClient{
+			ID:         resourceClientID,
+			SecretHash: secretHash,
+			GrantTypes: []string{models.GrantClientCredentials},
+		}
+		publicClient = &models.OAuthClient{
+			ID:         clientID,
+			GrantTypes: []string{models.GrantAuthorizationCode},
+		}
+
+		claims = map[string]any{
+			"sub":       userID.String(),
+			"client_id": clientID,
+			"scope":     models.ScopeProfileRead,
+		}
+
+		accessToken, _  = jwt.CreateWithClaims(accessTokenExpiresIn, claims, accessTokenPrivateKey)
+		refreshToken, _ = jwt.CreateWithClaims(refreshTokenExpiresIn, claims, refreshTokenPrivateKey)
+
+		resourceArgs = func(token string) args {
+			return args{
+				clientID:     resourceClientID,
+				clientSecret: clientSecret,
+				token:        token,
+			}
+		}
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		mock   func(m *oauthMocks)
+		expect expect
+	}{
+		{
+			name: "access token active case",
+			args: resourceArgs(accessToken),
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, resourceClientID).Return(resourceClient, nil)
+				m.tokenAdapter.EXPECT().Get(ctx, sessionKey).Return(refreshToken, nil)
+			},
+			expect: expect{
+				introspection: &models.TokenIntrospection{
+					Active:    true,
+					TokenType: "access_token",
+					ClientID:  clientID,
+					Subject:   userID.String(),
+					Scope:     models.ScopeProfileRead,
+				},
+			},
+		},
+		{
+			name: "refresh token active case",
+			args: resourceArgs(refreshToken),
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, resourceClientID).Return(resourceClient, nil)
+				m.tokenAdapter.EXPECT().Get(ctx, sessionKey).Return(refreshToken, nil)
+			},
+			expect: expect{
+				introspection: &models.TokenIntrospection{
+					Active:    true,
+					TokenType: "refresh_token",
+					ClientID:  clientID,
+					Subject:   userID.String(),
+					Scope:     models.ScopeProfileRead,
+				},
+			},
+		},
+		{
+			name: "session revoked case",
+			args: resourceArgs(accessToken),
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, resourceClientID).Return(resourceClient, nil)
+				m.tokenAdapter.EXPECT().Get(ctx, sessionKey).Return("", redis.Nil)
+			},
+			expect: expect{
+				introspection: &models.TokenIntrospection{},
+			},
+		},
+		{
+			name: "token invalid case",
+			args: resourceArgs("invalid"),
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, resourceClientID).Return(resourceClient, nil)
+			},
+			expect: expect{
+				introspection: &models.TokenIntrospection{},
+			},
+		},
+		{
+			name: "public client case",
+			args: args{
+				clientID: clientID,
+				token:    accessToken,
+			},
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, clientID).Return(publicClient, nil)
+			},
+			expect: expect{
+				err: services.ErrClientUnauthorized,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newOAuthMocks(ctrl)
+			tt.mock(m)
+
+			cfg := &configs.Config{
+				AccessTokenPublicKey:  accessTokenPublicKey,
+				RefreshTokenPublicKey: refreshTokenPublicKey,
+			}
+
+			introspection, err := newOAuthService(m, cfg).Introspect(ctx, tt.args.clientID, tt.args.clientSecret, tt.args.token)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+				require.Nil(t, introspection)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			if introspection.Active {
+				require.WithinDuration(t, time.Now(), introspection.ExpiresAt, refreshTokenExpiresIn)
+				introspection.ExpiresAt = time.Time{}
+			}
+			require.Equal(t, tt.expect.introspection, introspection)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/oauth"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthService_Revoke(t *testing.T) {
+	type args struct {
+		clientID string
+		token    string
+	}
+
+	type expect struct {
+		err error
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID = uuid.New()
+
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn                           = 15 * time.Minute
+
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn                            = 10080 * time.Minute
+
+		client = &models.OAuthClient{
+			ID:           clientID,
+			RedirectURIs: []string{redirectURI},
+			GrantTypes:   []string{models.GrantAuthorizationCode, models.GrantRefreshToken},
+			Scopes:       []string{models.ScopeProfileRead},
+		}
+
+		claims = map[string]any{
+			"sub":       userID.String(),
+			"client_id": clientID,
+			"scope":     models.ScopeProfileRead,
+		}
+
+		accessToken, _  = jwt.CreateWithClaims(accessTokenExpiresIn, claims, accessTokenPrivateKey)
+		refreshToken, _ = jwt.CreateWithClaims(refreshTokenExpiresIn, claims, refreshTokenPrivateKey)
+
+		firstPartyAccessToken, _ = jwt.Create(accessTokenExpiresIn, userID.String(), models.RoleCustomer, accessTokenPrivateKey)
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		mock   func(m *oauthMocks)
+		expect expect
+	}{
+		{
+			name: "refresh token case",
+			args: args{
+				clientID: clientID,
+				token:    refreshToken,
+			},
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, clientID).Return(client, nil)
+				m.tokenAdapter.EXPECT().Del(ctx, models.SessionKey(clientID, userID.String())).Return(nil)
+			},
+			expect: expect{
+				err: nil,
+			},
+		},
+		{
+			name: "access token case",
+			args: args{
+				clientID: clientID,
+				token:    accessToken,
+			},
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, clientID).Return(client, nil)
+				m.tokenAdapter.EXPECT().Del(ctx, models.SessionKey(clientID, userID.String())).Return(nil)
+			},
+			expect: expect{
+				err: nil,
+			},
+		},
+		{
+			name: "token invalid case",
+			args: args{
+				clientID: clientID,
+				token:    "invalid",
+			},
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, clientID).Return(client, nil)
+			},
+			expect: expect{
+				err: nil,
+			},
+		},
+		{
+			name: "token of another client case",
+			args: args{
+				clientID: clientID,
+				token:    firstPartyAccessToken,
+			},
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, clientID).Return(client, nil)
+			},
+			expect: expect{
+				err: services.ErrClientUnauthorized,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newOAuthMocks(ctrl)
+			tt.mock(m)
+
+			cfg := &configs.Config{
+				AccessTokenPublicKey:  accessTokenPublicKey,
+				RefreshTokenPublicKey: refreshTokenPublicKey,
+			}
+
+			err := newOAuthService(m, cfg).Revoke(ctx, tt.args.clientID, "", tt.args.token)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,335 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/hash"
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	domainService "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/oauth"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthService_Token(t *testing.T) {
+	type expect struct {
+		err     error
+		scope   string
+		refresh bool
+		subject string
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID = uuid.New()
+		role   = models.RoleCustomer
+
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn                           = 15 * time.Minute
+
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn                            = 10080 * time.Minute
+
+		sessionKey = models.SessionKey(clientID, userID.String())
+
+		clientSecret    = "secret"
+		secretHash, _   = hash.HashPassword(clientSecret)
+		partnerClientID = "partner"
+
+		publicClient = &models.OAuthClient{
+			ID:           clientID,
+			RedirectURIs: []string{redirectURI},
+			GrantTypes:   []string{models.GrantAuthorizationCode, models.GrantRefreshToken},
+			Scopes:       []string{models.ScopeProfileRead, models.ScopeProfileWrite},
+		}
+		partnerClient = &models.OAuthClient{
+			ID:         partnerClientID,
+			SecretHash: secretHash,
+			GrantTypes: []string{models.GrantClientCredentials},
+			Scopes:     []string{models.ScopeProfileRead},
+		}
+
+		user = &models.User{
+			ID:          userID,
+			Roles:       []string{role},
+			Permissions: []string{models.PermissionRolesRead},
+		}
+
+		authCode = &domainAdapter.AuthCode{
+			ClientID:      clientID,
+			UserID:        userID.String(),
+			RedirectURI:   redirectURI,
+			Scope:         "profile:read profile:write",
+			CodeChallenge: codeChallenge(codeVerifier),
+		}
+
+		refreshToken, _ = jwt.CreateWithClaims(refreshTokenExpiresIn, map[string]any{
+			"sub":       userID.String(),
+			"roles":     []string{role},
+			"client_id": clientID,
+			"scope":     "profile:read profile:write",
+		}, refreshTokenPrivateKey)
+		firstPartyRefreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), role, refreshTokenPrivateKey)
+	)
+
+	codeArgs := func(change func(args *domainService.TokenArgs)) *domainService.TokenArgs {
+		args := &domainService.TokenArgs{
+			GrantType:    models.GrantAuthorizationCode,
+			ClientID:     clientID,
+			Code:         "code",
+			RedirectURI:  redirectURI,
+			CodeVerifier: codeVerifier,
+		}
+		change(args)
+
+		return args
+	}
+
+	refreshArgs := func(change func(args *domainService.TokenArgs)) *domainService.TokenArgs {
+		args := &domainService.TokenArgs{
+			GrantType:    models.GrantRefreshToken,
+			ClientID:     clientID,
+			RefreshToken: refreshToken,
+		}
+		change(args)
+
+		return args
+	}
+
+	// storesSession expects the new refresh token of the session to be
+	// stored with the refresh TTL.
+	storesSession := func(m *oauthMocks) {
+		m.tokenAdapter.EXPECT().
+			Set(ctx, sessionKey, gomock.Any(), refreshTokenExpiresIn).
+			Return(nil)
+	}
+
+	tests := []struct {
+		name   string
+		args   *domainService.TokenArgs
+		mock   func(m *oauthMocks)
+		expect expect
+	}{
+		{
+			name: "authorization code success case",
+			args: codeArgs(func(*domainService.TokenArgs) {}),
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, clientID).Return(publicClient, nil)
+				m.authCodeAdapter.EXPECT().Take(ctx, "code").Return(authCode, nil)
+				m.userRepo.EXPECT().FindByID(ctx, userID.String()).Return(user, nil)
+				storesSession(m)
+			},
+			expect: expect{
+				scope:   "profile:read profile:write",
+				refresh: true,
+				subject: userID.String(),
+			},
+		},
+		{
+			name: "authorization code used case",
+			args: codeArgs(func(*domainService.TokenArgs) {}),
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, clientID).Return(publicClient, nil)
+				m.authCodeAdapter.EXPECT().Take(ctx, "code").Return(nil, redis.Nil)
+			},
+			expect: expect{
+				err: services.ErrGrantInvalid,
+			},
+		},
+		{
+			name: "code verifier wrong case",
+			args: codeArgs(func(args *domainService.TokenArgs) {
+				args.CodeVerifier = "wrong-verifier-wrong-verifier-wrong-verifier"
+			}),
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, clientID).Return(publicClient, nil)
+				m.authCodeAdapter.EXPECT().Take(ctx, "code").Return(authCode, nil)
+			},
+			expect: expect{
+				err: services.ErrGrantInvalid,
+			},
+		},
+		{
+			name: "redirect uri mismatch case",
+			args: codeArgs(func(args *domainService.TokenArgs) {
+				args.RedirectURI = "store://other"
+			}),
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, clientID).Return(publicClient, nil)
+				m.authCodeAdapter.EXPECT().Take(ctx, "code").Return(authCode, nil)
+			},
+			expect: expect{
+				err: services.ErrGrantInvalid,
+			},
+		},
+		{
+			name: "public client sends secret case",
+			args: codeArgs(func(args *domainService.TokenArgs) {
+				args.ClientSecret = clientSecret
+			}),
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, clientID).Return(publicClient, nil)
+			},
+			expect: expect{
+				err: services.ErrClientInvalid,
+			},
+		},
+		{
+			name: "grant not allowed case",
+			args: codeArgs(func(args *domainService.TokenArgs) {
+				args.ClientID = partnerClientID
+				args.ClientSecret = clientSecret
+			}),
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, partnerClientID).Return(partnerClient, nil)
+			},
+			expect: expect{
+				err: services.ErrClientUnauthorized,
+			},
+		},
+		{
+			name: "grant type unsupported case",
+			args: codeArgs(func(args *domainService.TokenArgs) {
+				args.GrantType = "password"
+			}),
+			mock: func(m *oauthMocks) {},
+			expect: expect{
+				err: services.ErrGrantTypeUnsupported,
+			},
+		},
+		{
+			name: "refresh token success case",
+			args: refreshArgs(func(args *domainService.TokenArgs) {
+				args.Scope = models.ScopeProfileRead
+			}),
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, clientID).Return(publicClient, nil)
+				m.tokenAdapter.EXPECT().Get(ctx, sessionKey).Return(refreshToken, nil)
+				m.userRepo.EXPECT().FindByID(ctx, userID.String()).Return(user, nil)
+				storesSession(m)
+			},
+			expect: expect{
+				scope:   models.ScopeProfileRead,
+				refresh: true,
+				subject: userID.String(),
+			},
+		},
+		{
+			name: "refresh token rotated case",
+			args: refreshArgs(func(*domainService.TokenArgs) {}),
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, clientID).Return(publicClient, nil)
+				m.tokenAdapter.EXPECT().Get(ctx, sessionKey).Return("newer_refresh_token", nil)
+			},
+			expect: expect{
+				err: services.ErrGrantInvalid,
+			},
+		},
+		{
+			name: "refresh token first-party case",
+			args: refreshArgs(func(args *domainService.TokenArgs) {
+				args.RefreshToken = firstPartyRefreshToken
+			}),
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, clientID).Return(publicClient, nil)
+			},
+			expect: expect{
+				err: services.ErrGrantInvalid,
+			},
+		},
+		{
+			name: "refresh widens scope case",
+			args: refreshArgs(func(args *domainService.TokenArgs) {
+				args.Scope = "profile:read admin"
+			}),
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, clientID).Return(publicClient, nil)
+				m.tokenAdapter.EXPECT().Get(ctx, sessionKey).Return(refreshToken, nil)
+			},
+			expect: expect{
+				err: services.ErrScopeInvalid,
+			},
+		},
+		{
+			name: "client credentials success case",
+			args: &domainService.TokenArgs{
+				GrantType:    models.GrantClientCredentials,
+				ClientID:     partnerClientID,
+				ClientSecret: clientSecret,
+			},
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, partnerClientID).Return(partnerClient, nil)
+			},
+			expect: expect{
+				scope:   models.ScopeProfileRead,
+				subject: partnerClientID,
+			},
+		},
+		{
+			name: "client secret wrong case",
+			args: &domainService.TokenArgs{
+				GrantType:    models.GrantClientCredentials,
+				ClientID:     partnerClientID,
+				ClientSecret: "wrong",
+			},
+			mock: func(m *oauthMocks) {
+				m.clientRepo.EXPECT().FindByID(ctx, partnerClientID).Return(partnerClient, nil)
+			},
+			expect: expect{
+				err: services.ErrClientInvalid,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newOAuthMocks(ctrl)
+			tt.mock(m)
+
+			cfg := &configs.Config{
+				AccessTokenPrivateKey:  accessTokenPrivateKey,
+				AccessTokenPublicKey:   accessTokenPublicKey,
+				AccessTokenExpiresIn:   accessTokenExpiresIn,
+				RefreshTokenPrivateKey: refreshTokenPrivateKey,
+				RefreshTokenPublicKey:  refreshTokenPublicKey,
+				RefreshTokenExpiresIn:  refreshTokenExpiresIn,
+			}
+
+			token, err := newOAuthService(m, cfg).Token(ctx, tt.args)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+				require.Nil(t, token)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expect.scope, token.Scope)
+			require.Equal(t, accessTokenExpiresIn, token.ExpiresIn)
+			require.Equal(t, tt.expect.refresh, token.RefreshToken != "")
+
+			claims, err := jwt.Verify(token.AccessToken, accessTokenPublicKey)
+			require.NoError(t, err)
+			require.Equal(t, tt.expect.subject, claims["sub"])
+			require.Equal(t, tt.args.ClientID, claims["client_id"])
+			require.Equal(t, tt.expect.scope, claims["scope"])
+			require.NotContains(t, claims, "permissions")
+		})
+	}
+}
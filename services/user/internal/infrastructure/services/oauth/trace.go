@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/hash"
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var tracer = otel.Tracer("oauth.service")
+
+func compareSecret(ctx context.Context, hashedSecret, secret string) error {
+	_, span := tracer.Start(ctx, "hash.ComparePassword")
+	defer span.End()
+
+	err := hash.ComparePassword(hashedSecret, secret)
+	if err != nil && !errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func createToken(ctx context.Context, ttl time.Duration, claims map[string]any, privateKey string) (string, error) {
+	_, span := tracer.Start(ctx, "jwt.CreateWithClaims")
+	defer span.End()
+
+	token, err := jwt.CreateWithClaims(ttl, claims, privateKey)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return token, err
+}
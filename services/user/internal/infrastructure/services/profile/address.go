@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// authorizeOwner lets only the owner of the profile touch its addresses.
+func (s *ProfileService) authorizeOwner(ctx context.Context, userID, accessToken string) error {
+	tokenUserID, err := s.VerifyToken(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	if tokenUserID != userID {
+		return ErrAccessDenied
+	}
+
+	return nil
+}
+
+// authorizeOwnerOr is authorizeOwner that also lets a caller whose roles
+// grant permission act on any user. The permissions are read from the claims
+// the authn interceptor stored; tokens of OAuth clients and API keys carry
+// none.
+func (s *ProfileService) authorizeOwnerOr(ctx context.Context, userID, accessToken, permission string) error {
+	tokenUserID, err := s.VerifyToken(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	if tokenUserID == userID {
+		return nil
+	}
+
+	if claims, ok := models.ClaimsFromContext(ctx); ok && claims.HasPermission(permission) {
+		return nil
+	}
+
+	return ErrAccessDenied
+}
+
+func (s *ProfileService) ListAddresses(ctx context.Context, userID, accessToken string) ([]*models.Address, error) {
+	loggerTag := "profile.service.listAddresses"
+
+	if err := s.authorizeOwner(ctx, userID, accessToken); err != nil {
+		return nil, err
+	}
+
+	addresses, err := s.addressRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find addresses: %v", err))
+
+		return nil, err
+	}
+
+	return addresses, nil
+}
+
+func (s *ProfileService) GetAddress(ctx context.Context, userID, addressID, accessToken string) (*models.Address, error) {
+	loggerTag := "profile.service.getAddress"
+
+	if err := s.authorizeOwner(ctx, userID, accessToken); err != nil {
+		return nil, err
+	}
+
+	address, err := s.addressRepo.FindByID(ctx, userID, addressID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAddressNotFound
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find address: %v", err))
+
+		return nil, err
+	}
+
+	return address, nil
+}
+
+func (s *ProfileService) CreateAddress(ctx context.Context, address *models.Address, accessToken string) (*models.Address, error) {
+	loggerTag := "profile.service.createAddress"
+
+	if err := s.authorizeOwner(ctx, address.UserID.String(), accessToken); err != nil {
+		return nil, err
+	}
+
+	var created *models.Address
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.takeOverDefaults(ctx, address); err != nil {
+			return err
+		}
+
+		var err error
+		created, err = s.addressRepo.Create(ctx, address)
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, ErrAddressDefaultConflict) {
+			return nil, ErrAddressDefaultConflict
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed create address: %v", err))
+
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *ProfileService) UpdateAddress(ctx context.Context, address *models.Address, accessToken string) (*models.Address, error) {
+	loggerTag := "profile.service.updateAddress"
+
+	if err := s.authorizeOwner(ctx, address.UserID.String(), accessToken); err != nil {
+		return nil, err
+	}
+
+	var updated *models.Address
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := s.addressRepo.FindByID(ctx, address.UserID.String(), address.ID.String()); err != nil {
+			return err
+		}
+
+		if err := s.takeOverDefaults(ctx, address); err != nil {
+			return err
+		}
+
+		var err error
+		updated, err = s.addressRepo.Update(ctx, address)
+
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, ErrAddressNotFound
+		case errors.Is(err, ErrAddressDefaultConflict):
+			return nil, ErrAddressDefaultConflict
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed update address: %v", err))
+
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *ProfileService) DeleteAddress(ctx context.Context, userID, addressID, accessToken string) error {
+	loggerTag := "profile.service.deleteAddress"
+
+	if err := s.authorizeOwner(ctx, userID, accessToken); err != nil {
+		return err
+	}
+
+	if err := s.addressRepo.Delete(ctx, userID, addressID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAddressNotFound
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed delete address: %v", err))
+
+		return err
+	}
+
+	return nil
+}
+
+// takeOverDefaults clears the defaults address is about to claim, so the
+// unique indexes allowing one default of each kind are never violated.
+func (s *ProfileService) takeOverDefaults(ctx context.Context, address *models.Address) error {
+	if !address.DefaultShipping && !address.DefaultBilling {
+		return nil
+	}
+
+	return s.addressRepo.ClearDefaults(ctx, address.UserID.String(), address.DefaultShipping, address.DefaultBilling)
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainService "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/apikey"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *ProfileService) CreateAPIKey(ctx context.Context, args *domainService.CreateAPIKeyArgs) (*models.APIKey, string, error) {
+	loggerTag := "profile.service.createAPIKey"
+
+	if err := s.authorizeOwner(ctx, args.UserID, args.AccessToken); err != nil {
+		return nil, "", err
+	}
+
+	for _, scope := range args.Scopes {
+		if !slices.Contains(models.Scopes, scope) {
+			return nil, "", ErrScopeInvalid
+		}
+	}
+
+	if args.ExpiresAt != nil && !args.ExpiresAt.After(time.Now()) {
+		return nil, "", ErrAPIKeyExpiryInvalid
+	}
+
+	userID, err := uuid.Parse(args.UserID)
+	if err != nil {
+		return nil, "", ErrUserNotFound
+	}
+
+	key, prefix, secretHash := apikey.Generate()
+
+	created, err := s.apiKeyRepo.Create(ctx, &models.APIKey{
+		UserID:     &userID,
+		Name:       args.Name,
+		Prefix:     prefix,
+		SecretHash: secretHash,
+		Scopes:     args.Scopes,
+		ExpiresAt:  args.ExpiresAt,
+	})
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed create api key: %v", err))
+
+		return nil, "", err
+	}
+
+	return created, key, nil
+}
+
+func (s *ProfileService) ListAPIKeys(ctx context.Context, userID, accessToken string) ([]*models.APIKey, error) {
+	loggerTag := "profile.service.listAPIKeys"
+
+	if err := s.authorizeOwner(ctx, userID, accessToken); err != nil {
+		return nil, err
+	}
+
+	keys, err := s.apiKeyRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find api keys: %v", err))
+
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (s *ProfileService) RevokeAPIKey(ctx context.Context, userID, apiKeyID, accessToken string) (*models.APIKey, error) {
+	loggerTag := "profile.service.revokeAPIKey"
+
+	if err := s.authorizeOwner(ctx, userID, accessToken); err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.apiKeyRepo.RevokeForUser(ctx, userID, apiKeyID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAPIKeyNotFound
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed revoke api key: %v", err))
+
+		return nil, err
+	}
+
+	return revoked, nil
+}
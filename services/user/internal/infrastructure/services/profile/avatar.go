@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/imaging"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *ProfileService) UploadAvatar(ctx context.Context, userID, contentType string, file io.Reader, accessToken string) (*models.User, error) {
+	loggerTag := "profile.service.uploadAvatar"
+
+	if err := s.authorizeOwner(ctx, userID, accessToken); err != nil {
+		return nil, err
+	}
+
+	cfg := s.cfg.Current()
+
+	data, err := io.ReadAll(io.LimitReader(file, cfg.AvatarMaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > cfg.AvatarMaxSize {
+		return nil, ErrAvatarTooLarge
+	}
+
+	img, err := imaging.Decode(data, contentType)
+	if err != nil {
+		switch {
+		case errors.Is(err, imaging.ErrUnsupportedType), errors.Is(err, imaging.ErrTypeMismatch):
+			return nil, ErrAvatarContentType
+		case errors.Is(err, imaging.ErrTooManyPixels):
+			return nil, ErrAvatarTooLarge
+		default:
+			return nil, ErrAvatarInvalid
+		}
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
+
+		return nil, err
+	}
+
+	// Every upload gets a new prefix, so the files can be cached forever.
+	key := fmt.Sprintf("avatars/%s/%s", userID, uuid.NewString())
+
+	urls := make(map[string]string, len(cfg.AvatarSizes))
+	for _, size := range cfg.AvatarSizes {
+		thumbnail, encodeErr := imaging.EncodeJPEG(imaging.Thumbnail(img, size))
+		if encodeErr != nil {
+			s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed encode thumbnail: %v", encodeErr))
+			s.removeAvatar(ctx, key)
+
+			return nil, encodeErr
+		}
+
+		fileKey := fmt.Sprintf("%s/%d.jpg", key, size)
+		if err = s.blobStorage.Put(ctx, fileKey, imaging.TypeJPEG, thumbnail); err != nil {
+			s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed store thumbnail: %v", err))
+			s.removeAvatar(ctx, key)
+
+			return nil, err
+		}
+
+		urls[strconv.Itoa(size)] = s.blobStorage.URL(fileKey)
+	}
+
+	updatedUser, err := s.userRepo.SetAvatar(ctx, userID, key, urls)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed set avatar: %v", err))
+		s.removeAvatar(ctx, key)
+
+		return nil, err
+	}
+
+	if user.AvatarKey != "" {
+		s.removeAvatar(ctx, user.AvatarKey)
+	}
+
+	return updatedUser, nil
+}
+
+// removeAvatar deletes the files of an avatar that is no longer referenced.
+// Failures are only logged: a leftover file is not worth failing a request.
+func (s *ProfileService) removeAvatar(ctx context.Context, key string) {
+	loggerTag := "profile.service.removeAvatar"
+
+	if err := s.blobStorage.DeletePrefix(ctx, key); err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed delete avatar %s: %v", key, err))
+	}
+}
@@ -1,6 +1,10 @@
 package services
 
-import "errors"
+import (
+	"errors"
+
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+)
 
 var (
 	ErrUserNotFound       = errors.New("user.not_found")
@@ -10,4 +14,27 @@ var (
 	ErrPasswordUnchanged  = errors.New("password.unchanged")
 	ErrFirstNameUnchanged = errors.New("first_name.unchanged")
 	ErrLastNameUnchanged  = errors.New("last_name.unchanged")
+
+	ErrPhoneInvalid        = domainErrors.ErrPhoneInvalid
+	ErrPhoneExists         = domainErrors.ErrPhoneExists
+	ErrOTPNotFound         = domainErrors.ErrOTPNotFound
+	ErrOTPInvalid          = domainErrors.ErrOTPInvalid
+	ErrOTPAttemptsExceeded = domainErrors.ErrOTPAttemptsExceeded
+	ErrOTPResendTooSoon    = domainErrors.ErrOTPResendTooSoon
+
+	ErrAvatarTooLarge    = domainErrors.ErrAvatarTooLarge
+	ErrAvatarContentType = domainErrors.ErrAvatarContentType
+	ErrAvatarInvalid     = domainErrors.ErrAvatarInvalid
+
+	ErrAccessDenied           = domainErrors.ErrAccessDenied
+	ErrAddressNotFound        = domainErrors.ErrAddressNotFound
+	ErrAddressDefaultConflict = domainErrors.ErrAddressDefaultConflict
+
+	ErrPasskeyInvalid  = domainErrors.ErrPasskeyInvalid
+	ErrPasskeyExists   = domainErrors.ErrPasskeyExists
+	ErrPasskeyNotFound = domainErrors.ErrPasskeyNotFound
+
+	ErrScopeInvalid        = domainErrors.ErrScopeInvalid
+	ErrAPIKeyNotFound      = domainErrors.ErrAPIKeyNotFound
+	ErrAPIKeyExpiryInvalid = domainErrors.ErrAPIKeyExpiryInvalid
 )
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgx/v5"
+)
+
+const ceremonyIDBytes = 32
+
+func newCeremonyID() string {
+	b := make([]byte, ceremonyIDBytes)
+	rand.Read(b)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func (s *ProfileService) BeginPasskeyRegistration(ctx context.Context, userID, accessToken string) (string, []byte, error) {
+	loggerTag := "profile.service.beginPasskeyRegistration"
+
+	if err := s.authorizeOwner(ctx, userID, accessToken); err != nil {
+		return "", nil, err
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil, ErrUserNotFound
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
+
+		return "", nil, err
+	}
+
+	existing, err := s.passkeyRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find passkeys: %v", err))
+
+		return "", nil, err
+	}
+
+	ceremony, err := s.passkeys.BeginRegistration(user, existing)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed begin registration: %v", err))
+
+		return "", nil, err
+	}
+
+	ceremonyID := newCeremonyID()
+	challenge := &domainAdapter.PasskeyChallenge{
+		UserID:  userID,
+		Session: ceremony.Session,
+	}
+
+	if err = s.passkeyChallengeAdapter.Set(ctx, ceremonyID, challenge, s.cfg.Current().PasskeyChallengeExpiresIn); err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed add passkey challenge to redis: %v", err))
+
+		return "", nil, err
+	}
+
+	return ceremonyID, ceremony.Options, nil
+}
+
+// FinishPasskeyRegistration only accepts the ceremony from the user who
+// began it, so a ceremony ID leaking to another session is of no use.
+func (s *ProfileService) FinishPasskeyRegistration(ctx context.Context, userID, ceremonyID, name string, response []byte, accessToken string) (*models.Passkey, error) {
+	loggerTag := "profile.service.finishPasskeyRegistration"
+
+	if err := s.authorizeOwner(ctx, userID, accessToken); err != nil {
+		return nil, err
+	}
+
+	challenge, err := s.passkeyChallengeAdapter.Take(ctx, ceremonyID)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrPasskeyInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed take passkey challenge from redis: %v", err))
+
+		return nil, err
+	}
+
+	if challenge.UserID != userID {
+		return nil, ErrPasskeyInvalid
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
+
+		return nil, err
+	}
+
+	passkey, err := s.passkeys.FinishRegistration(user, challenge.Session, response)
+	if err != nil {
+		if errors.Is(err, ErrPasskeyInvalid) {
+			return nil, ErrPasskeyInvalid
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed finish registration: %v", err))
+
+		return nil, err
+	}
+
+	passkey.Name = name
+
+	created, err := s.passkeyRepo.Create(ctx, passkey)
+	if err != nil {
+		if errors.Is(err, ErrPasskeyExists) {
+			return nil, ErrPasskeyExists
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed create passkey: %v", err))
+
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *ProfileService) ListPasskeys(ctx context.Context, userID, accessToken string) ([]*models.Passkey, error) {
+	loggerTag := "profile.service.listPasskeys"
+
+	if err := s.authorizeOwner(ctx, userID, accessToken); err != nil {
+		return nil, err
+	}
+
+	passkeys, err := s.passkeyRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find passkeys: %v", err))
+
+		return nil, err
+	}
+
+	return passkeys, nil
+}
+
+func (s *ProfileService) RenamePasskey(ctx context.Context, userID, passkeyID, name, accessToken string) (*models.Passkey, error) {
+	loggerTag := "profile.service.renamePasskey"
+
+	if err := s.authorizeOwner(ctx, userID, accessToken); err != nil {
+		return nil, err
+	}
+
+	passkey, err := s.passkeyRepo.Rename(ctx, userID, passkeyID, name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrPasskeyNotFound
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed rename passkey: %v", err))
+
+		return nil, err
+	}
+
+	return passkey, nil
+}
+
+func (s *ProfileService) DeletePasskey(ctx context.Context, userID, passkeyID, accessToken string) error {
+	loggerTag := "profile.service.deletePasskey"
+
+	if err := s.authorizeOwner(ctx, userID, accessToken); err != nil {
+		return err
+	}
+
+	if err := s.passkeyRepo.Delete(ctx, userID, passkeyID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrPasskeyNotFound
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed delete passkey: %v", err))
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/hash"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgx/v5"
+	"github.com/nyaruka/phonenumbers"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const otpDigits = 6
+
+// normalizePhone accepts a number in international format and returns it in
+// E.164, provided it is a valid number for its region.
+func normalizePhone(phone string) (string, error) {
+	number, err := phonenumbers.Parse(phone, "")
+	if err != nil || !phonenumbers.IsValidNumber(number) {
+		return "", ErrPhoneInvalid
+	}
+
+	return phonenumbers.Format(number, phonenumbers.E164), nil
+}
+
+func generateOTP() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", otpDigits, n.Int64()), nil
+}
+
+func (s *ProfileService) SetPhone(ctx context.Context, userID, phone, accessToken string) (*models.User, error) {
+	loggerTag := "profile.service.setPhone"
+
+	if err := s.authorizeOwner(ctx, userID, accessToken); err != nil {
+		return nil, err
+	}
+
+	phone, err := normalizePhone(phone)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := s.cfg.Current()
+
+	pending, err := s.otpAdapter.Get(ctx, userID)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed get otp from redis: %v", err))
+
+		return nil, err
+	}
+	if pending != nil && time.Since(pending.SentAt) < cfg.PhoneOTPResendAfter {
+		return nil, ErrOTPResendTooSoon
+	}
+
+	user, err := s.userRepo.SetPhone(ctx, userID, phone)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed set phone: %v", err))
+
+		return nil, err
+	}
+
+	code, err := generateOTP()
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed generate otp: %v", err))
+
+		return nil, err
+	}
+
+	codeHash, err := hash.HashPassword(code)
+	if err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed hash otp: %v", err))
+
+		return nil, err
+	}
+
+	if err = s.otpAdapter.Set(ctx, userID, &domainAdapter.PhoneOTP{
+		Phone:    phone,
+		CodeHash: codeHash,
+		SentAt:   time.Now(),
+	}, cfg.PhoneOTPExpiresIn); err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed add otp to redis: %v", err))
+
+		return nil, err
+	}
+
+	message := fmt.Sprintf("Your verification code is %s. It expires in %s.", code, cfg.PhoneOTPExpiresIn)
+	if err = s.smsSender.Send(ctx, phone, message); err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed send sms: %v", err))
+
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (s *ProfileService) VerifyPhone(ctx context.Context, userID, code, accessToken string) (*models.User, error) {
+	loggerTag := "profile.service.verifyPhone"
+
+	if err := s.authorizeOwner(ctx, userID, accessToken); err != nil {
+		return nil, err
+	}
+
+	pending, err := s.otpAdapter.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrOTPNotFound
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed get otp from redis: %v", err))
+
+		return nil, err
+	}
+
+	// Every attempt is counted before the code is compared, so concurrent
+	// guesses cannot all pass a check of a count read earlier.
+	attempts, err := s.otpAdapter.IncrAttempts(ctx, userID)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrOTPNotFound
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed count otp attempt: %v", err))
+
+		return nil, err
+	}
+
+	maxAttempts := s.cfg.Current().PhoneOTPMaxAttempts
+
+	if attempts > maxAttempts {
+		return nil, s.dropOTP(ctx, userID)
+	}
+
+	if err = hash.ComparePassword(pending.CodeHash, code); err != nil {
+		if !errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed compare otp: %v", err))
+
+			return nil, err
+		}
+
+		if attempts == maxAttempts {
+			return nil, s.dropOTP(ctx, userID)
+		}
+
+		return nil, ErrOTPInvalid
+	}
+
+	user, err := s.userRepo.VerifyPhone(ctx, userID, pending.Phone)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			// The phone was changed after the code was sent.
+			return nil, ErrOTPInvalid
+		case errors.Is(err, ErrPhoneExists):
+			return nil, ErrPhoneExists
+		}
+
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed verify phone: %v", err))
+
+		return nil, err
+	}
+
+	if err = s.otpAdapter.Del(ctx, userID); err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed delete otp from redis: %v", err))
+	}
+
+	return user, nil
+}
+
+// dropOTP deletes a code that was guessed wrong too often, so a new one has
+// to be requested.
+func (s *ProfileService) dropOTP(ctx context.Context, userID string) error {
+	loggerTag := "profile.service.dropOTP"
+
+	if err := s.otpAdapter.Del(ctx, userID); err != nil {
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed delete otp from redis: %v", err))
+
+		return err
+	}
+
+	return ErrOTPAttemptsExceeded
+}
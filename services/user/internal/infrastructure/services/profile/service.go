@@ -5,11 +5,11 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/BlazeCoder04/online_store/libs/hash"
 	"github.com/BlazeCoder04/online_store/libs/jwt"
 	"github.com/BlazeCoder04/online_store/libs/logger"
 	"github.com/BlazeCoder04/online_store/services/user/configs"
 	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports"
 	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
 	domainRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories"
 	domainService "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
@@ -19,70 +19,105 @@ import (
 )
 
 type ProfileService struct {
-	userRepo     domainRepo.UserRepository
-	tokenAdapter domainAdapter.TokenAdapter
-	logger       logger.Logger
-	cfg          *configs.Config
+	userRepo                domainRepo.UserRepository
+	addressRepo             domainRepo.AddressRepository
+	passkeyRepo             domainRepo.PasskeyRepository
+	apiKeyRepo              domainRepo.APIKeyRepository
+	txManager               domainRepo.TxManager
+	tokenAdapter            domainAdapter.TokenAdapter
+	otpAdapter              domainAdapter.OTPAdapter
+	passkeyChallengeAdapter domainAdapter.PasskeyChallengeAdapter
+	passkeys                domain.Passkeys
+	smsSender               domain.SmsSender
+	blobStorage             domain.BlobStorage
+	logger                  logger.Logger
+	cfg                     configs.Provider
 }
 
-func NewProfileService(userRepo domainRepo.UserRepository, tokenAdapter domainAdapter.TokenAdapter, logger logger.Logger, cfg *configs.Config) (domainService.ProfileService, error) {
+func NewProfileService(userRepo domainRepo.UserRepository, addressRepo domainRepo.AddressRepository, passkeyRepo domainRepo.PasskeyRepository, apiKeyRepo domainRepo.APIKeyRepository, txManager domainRepo.TxManager, tokenAdapter domainAdapter.TokenAdapter, otpAdapter domainAdapter.OTPAdapter, passkeyChallengeAdapter domainAdapter.PasskeyChallengeAdapter, passkeys domain.Passkeys, smsSender domain.SmsSender, blobStorage domain.BlobStorage, logger logger.Logger, cfg configs.Provider) (domainService.ProfileService, error) {
 	loggerTag := "profile.service.newProfileService"
 
 	logger.Info(loggerTag, "Profile service initialized")
 
 	return &ProfileService{
 		userRepo,
+		addressRepo,
+		passkeyRepo,
+		apiKeyRepo,
+		txManager,
 		tokenAdapter,
+		otpAdapter,
+		passkeyChallengeAdapter,
+		passkeys,
+		smsSender,
+		blobStorage,
 		logger,
 		cfg,
 	}, nil
 }
 
-func (s *ProfileService) VerifyToken(ctx context.Context, accessToken string) error {
+// VerifyToken checks the access token and that the session it belongs to
+// has not been logged out or revoked, and returns the ID of the user it was
+// issued to. Tokens issued to an OAuth client belong to that client's
+// session of the user, and tokens issued by impersonation to the session of
+// the admin acting. A request made with an API key, which was checked when
+// the request came in, carries no token and acts as the key's subject.
+func (s *ProfileService) VerifyToken(ctx context.Context, accessToken string) (string, error) {
 	loggerTag := "profile.service.verifyToken"
 
-	accessTokenClaims, err := jwt.Verify(accessToken, s.cfg.AccessTokenPublicKey)
+	if claims, ok := models.ClaimsFromContext(ctx); ok && claims.APIKeyID != "" {
+		return claims.Subject, nil
+	}
+
+	accessTokenClaims, err := jwt.Verify(accessToken, s.cfg.Current().AccessTokenPublicKey)
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenInvalid) {
-			return err
+			return "", err
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed verify access token: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed verify access token: %v", err))
 
-		return err
+		return "", err
 	}
 
 	userID := accessTokenClaims["sub"].(string)
+	clientID, _ := accessTokenClaims["client_id"].(string)
+
+	sessionUserID := userID
+	if actorID := models.ActorID(accessTokenClaims); actorID != "" {
+		sessionUserID = actorID
+	}
 
-	refreshToken, err := s.tokenAdapter.Get(ctx, userID)
+	refreshToken, err := s.tokenAdapter.Get(ctx, models.SessionKey(clientID, sessionUserID))
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
-			return ErrTokenInvalid
+			return "", ErrTokenInvalid
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed get refresh token to redis: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed get refresh token to redis: %v", err))
 
-		return err
+		return "", err
 	}
 
-	_, err = jwt.Verify(refreshToken, s.cfg.RefreshTokenPublicKey)
+	_, err = jwt.Verify(refreshToken, s.cfg.Current().RefreshTokenPublicKey)
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenInvalid) {
-			return err
+			return "", err
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed verify refresh token: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed verify refresh token: %v", err))
 
-		return err
+		return "", err
 	}
 
-	return nil
+	return userID, nil
 }
 
+// Get returns the caller's own profile, or any user's to support.
 func (s *ProfileService) Get(ctx context.Context, userID, accessToken string) (*models.User, error) {
 	loggerTag := "profile.service.get"
 
-	if err := s.VerifyToken(ctx, accessToken); err != nil {
+	if err := s.authorizeOwnerOr(ctx, userID, accessToken, models.PermissionUsersRead); err != nil {
 		return nil, err
 	}
 
@@ -92,7 +127,7 @@ func (s *ProfileService) Get(ctx context.Context, userID, accessToken string) (*
 			return nil, ErrUserNotFound
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed find user: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
 
 		return nil, err
 	}
@@ -103,7 +138,7 @@ func (s *ProfileService) Get(ctx context.Context, userID, accessToken string) (*
 func (s *ProfileService) Update(ctx context.Context, args *domainService.UpdateProfileArgs) (*models.User, error) {
 	loggerTag := "profile.service.update"
 
-	if err := s.VerifyToken(ctx, args.AccessToken); err != nil {
+	if _, err := s.VerifyToken(ctx, args.AccessToken); err != nil {
 		return nil, err
 	}
 
@@ -113,17 +148,17 @@ func (s *ProfileService) Update(ctx context.Context, args *domainService.UpdateP
 			return nil, ErrUserNotFound
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed find user: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
 
 		return nil, err
 	}
 
-	if err = hash.ComparePassword(user.Password, args.Password); err != nil {
+	if err = comparePassword(ctx, user.Password, args.Password); err != nil {
 		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
 			return nil, ErrPasswordWrong
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed compare password: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed compare password: %v", err))
 
 		return nil, err
 	}
@@ -139,13 +174,13 @@ func (s *ProfileService) Update(ctx context.Context, args *domainService.UpdateP
 
 	var hashedPassword *string
 	if args.NewPassword != nil {
-		if passCheckErr := hash.ComparePassword(user.Password, *args.NewPassword); passCheckErr == nil {
+		if passCheckErr := comparePassword(ctx, user.Password, *args.NewPassword); passCheckErr == nil {
 			return nil, ErrPasswordUnchanged
 		}
 
-		hashed, hashErr := hash.HashPassword(*args.NewPassword)
+		hashed, hashErr := hashPassword(ctx, *args.NewPassword)
 		if hashErr != nil {
-			s.logger.Error(loggerTag, fmt.Sprintf("failed hash password: %v", err))
+			s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed hash password: %v", err))
 
 			return nil, err
 		}
@@ -155,7 +190,7 @@ func (s *ProfileService) Update(ctx context.Context, args *domainService.UpdateP
 
 	updatedUser, err := s.userRepo.Update(ctx, args.UserID, args.NewEmail, hashedPassword, args.NewFirstName, args.NewLastName)
 	if err != nil {
-		s.logger.Error(loggerTag, fmt.Sprintf("failed update user: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed update user: %v", err))
 
 		return nil, err
 	}
@@ -166,7 +201,7 @@ func (s *ProfileService) Update(ctx context.Context, args *domainService.UpdateP
 func (s *ProfileService) Delete(ctx context.Context, userID, password, accessToken string) error {
 	loggerTag := "profile.service.delete"
 
-	if err := s.VerifyToken(ctx, accessToken); err != nil {
+	if _, err := s.VerifyToken(ctx, accessToken); err != nil {
 		return err
 	}
 
@@ -176,32 +211,36 @@ func (s *ProfileService) Delete(ctx context.Context, userID, password, accessTok
 			return ErrUserNotFound
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed find user: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed find user: %v", err))
 
 		return err
 	}
 
-	if err = hash.ComparePassword(user.Password, password); err != nil {
+	if err = comparePassword(ctx, user.Password, password); err != nil {
 		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
 			return ErrPasswordWrong
 		}
 
-		s.logger.Error(loggerTag, fmt.Sprintf("failed compare password: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed compare password: %v", err))
 
 		return err
 	}
 
 	if err = s.userRepo.Delete(ctx, userID); err != nil {
-		s.logger.Error(loggerTag, fmt.Sprintf("failed delete user: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed delete user: %v", err))
 
 		return err
 	}
 
 	if err = s.tokenAdapter.Del(ctx, userID); err != nil {
-		s.logger.Error(loggerTag, fmt.Sprintf("failed delete refresh token to redis: %v", err))
+		s.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed delete refresh token to redis: %v", err))
 
 		return err
 	}
 
+	if user.AvatarKey != "" {
+		s.removeAvatar(ctx, user.AvatarKey)
+	}
+
 	return nil
 }
@@ -0,0 +1,312 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
+	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/profile"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileService_CreateAddress(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		address     *models.Address
+		accessToken string
+	}
+
+	type expect struct {
+		err     error
+		address *models.Address
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID      = uuid.New()
+		otherUserID = uuid.New()
+		role        = models.RoleCustomer
+
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn                           = 15 * time.Minute
+
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn                            = 10080 * time.Minute
+
+		accessToken, _  = jwt.Create(accessTokenExpiresIn, userID.String(), role, accessTokenPrivateKey)
+		refreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), role, refreshTokenPrivateKey)
+
+		address = &models.Address{
+			UserID:      userID,
+			Recipient:   "Jane Doe",
+			Line1:       "1 Main Street",
+			City:        "Springfield",
+			Region:      "IL",
+			PostalCode:  "62704",
+			CountryCode: "US",
+		}
+
+		defaultAddress = &models.Address{
+			UserID:          userID,
+			Recipient:       "Jane Doe",
+			Line1:           "1 Main Street",
+			City:            "Springfield",
+			Region:          "IL",
+			PostalCode:      "62704",
+			CountryCode:     "US",
+			DefaultShipping: true,
+		}
+
+		otherAddress = &models.Address{
+			UserID:      otherUserID,
+			Recipient:   "Jane Doe",
+			Line1:       "1 Main Street",
+			City:        "Springfield",
+			Region:      "IL",
+			PostalCode:  "62704",
+			CountryCode: "US",
+		}
+
+		created = &models.Address{
+			ID:          uuid.New(),
+			UserID:      userID,
+			Recipient:   "Jane Doe",
+			Line1:       "1 Main Street",
+			City:        "Springfield",
+			Region:      "IL",
+			PostalCode:  "62704",
+			CountryCode: "US",
+		}
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		mock   func(ctrl *gomock.Controller) (*mocksRepo.MockAddressRepository, *mocksRepo.MockTxManager, *mocksAdapter.MockTokenAdapter)
+		expect expect
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx,
+				address,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockAddressRepository, *mocksRepo.MockTxManager, *mocksAdapter.MockTokenAdapter) {
+				addressRepo := mocksRepo.NewMockAddressRepository(ctrl)
+				txManager := mocksRepo.NewMockTxManager(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
+				addressRepo.EXPECT().
+					Create(ctx, address).
+					Return(created, nil)
+
+				return addressRepo, txManager, tokenAdapter
+			},
+			expect: expect{
+				err:     nil,
+				address: created,
+			},
+		},
+		{
+			name: "default takes over case",
+			args: args{
+				ctx,
+				defaultAddress,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockAddressRepository, *mocksRepo.MockTxManager, *mocksAdapter.MockTokenAdapter) {
+				addressRepo := mocksRepo.NewMockAddressRepository(ctrl)
+				txManager := mocksRepo.NewMockTxManager(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
+				gomock.InOrder(
+					addressRepo.EXPECT().
+						ClearDefaults(ctx, userID.String(), true, false).
+						Return(nil),
+					addressRepo.EXPECT().
+						Create(ctx, defaultAddress).
+						Return(created, nil),
+				)
+
+				return addressRepo, txManager, tokenAdapter
+			},
+			expect: expect{
+				err:     nil,
+				address: created,
+			},
+		},
+		{
+			name: "default conflict case",
+			args: args{
+				ctx,
+				defaultAddress,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockAddressRepository, *mocksRepo.MockTxManager, *mocksAdapter.MockTokenAdapter) {
+				addressRepo := mocksRepo.NewMockAddressRepository(ctrl)
+				txManager := mocksRepo.NewMockTxManager(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				txManager.EXPECT().
+					WithinTx(ctx, gomock.Any()).
+					DoAndReturn(runWithinTx)
+
+				addressRepo.EXPECT().
+					ClearDefaults(ctx, userID.String(), true, false).
+					Return(nil)
+
+				addressRepo.EXPECT().
+					Create(ctx, defaultAddress).
+					Return(nil, domainErrors.ErrAddressDefaultConflict)
+
+				return addressRepo, txManager, tokenAdapter
+			},
+			expect: expect{
+				err:     services.ErrAddressDefaultConflict,
+				address: nil,
+			},
+		},
+		{
+			name: "other user's profile case",
+			args: args{
+				ctx,
+				otherAddress,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockAddressRepository, *mocksRepo.MockTxManager, *mocksAdapter.MockTokenAdapter) {
+				addressRepo := mocksRepo.NewMockAddressRepository(ctrl)
+				txManager := mocksRepo.NewMockTxManager(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				return addressRepo, txManager, tokenAdapter
+			},
+			expect: expect{
+				err:     services.ErrAccessDenied,
+				address: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			addressRepo, txManager, tokenAdapter := tt.mock(ctrl)
+
+			cfg := &configs.Config{
+				AccessTokenPrivateKey:  accessTokenPrivateKey,
+				AccessTokenPublicKey:   accessTokenPublicKey,
+				AccessTokenExpiresIn:   accessTokenExpiresIn,
+				RefreshTokenPrivateKey: refreshTokenPrivateKey,
+				RefreshTokenPublicKey:  refreshTokenPublicKey,
+				RefreshTokenExpiresIn:  refreshTokenExpiresIn,
+			}
+
+			log, _ := logger.NewAdapter(&logger.Config{
+				Level: logger.LevelError,
+			})
+
+			profileService, _ := services.NewProfileService(nil, addressRepo, nil, nil, txManager, tokenAdapter, nil, nil, nil, nil, nil, log, cfg)
+
+			address, err := profileService.CreateAddress(tt.args.ctx, tt.args.address, tt.args.accessToken)
+
+			if tt.expect.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.expect.err.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.expect.address, address)
+		})
+	}
+}
+
+func TestProfileService_DeleteAddress(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		userID    = uuid.New()
+		addressID = uuid.New()
+		role      = models.RoleCustomer
+
+		accessTokenPrivateKey, accessTokenPublicKey, _   = keys.Generate(keys.DefaultBits)
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+
+		accessToken, _  = jwt.Create(15*time.Minute, userID.String(), role, accessTokenPrivateKey)
+		refreshToken, _ = jwt.Create(time.Hour, userID.String(), role, refreshTokenPrivateKey)
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	addressRepo := mocksRepo.NewMockAddressRepository(ctrl)
+	tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+	tokenAdapter.EXPECT().
+		Get(ctx, userID.String()).
+		Return(refreshToken, nil)
+
+	addressRepo.EXPECT().
+		Delete(ctx, userID.String(), addressID.String()).
+		Return(pgx.ErrNoRows)
+
+	cfg := &configs.Config{
+		AccessTokenPublicKey:  accessTokenPublicKey,
+		RefreshTokenPublicKey: refreshTokenPublicKey,
+	}
+
+	log, _ := logger.NewAdapter(&logger.Config{
+		Level: logger.LevelError,
+	})
+
+	profileService, _ := services.NewProfileService(nil, addressRepo, nil, nil, nil, tokenAdapter, nil, nil, nil, nil, nil, log, cfg)
+
+	err := profileService.DeleteAddress(ctx, userID.String(), addressID.String(), accessToken)
+	require.ErrorIs(t, err, services.ErrAddressNotFound)
+}
+
+func runWithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -0,0 +1,200 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/logger/observer"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
+	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	domainService "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/apikey"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/profile"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileService_CreateAPIKey(t *testing.T) {
+	type args struct {
+		scopes    []string
+		expiresAt *time.Time
+	}
+
+	type expect struct {
+		err error
+		key bool
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID = uuid.New()
+		role   = models.RoleCustomer
+
+		accessTokenPrivateKey, accessTokenPublicKey, _   = keys.Generate(keys.DefaultBits)
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+
+		accessToken, _  = jwt.Create(15*time.Minute, userID.String(), role, accessTokenPrivateKey)
+		refreshToken, _ = jwt.Create(time.Hour, userID.String(), role, refreshTokenPrivateKey)
+
+		past   = time.Now().Add(-time.Hour)
+		future = time.Now().Add(24 * time.Hour)
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		mock   func(apiKeyRepo *mocksRepo.MockAPIKeyRepository)
+		expect expect
+	}{
+		{
+			name: "success case",
+			args: args{
+				scopes:    []string{models.ScopeProfileRead},
+				expiresAt: &future,
+			},
+			mock: func(apiKeyRepo *mocksRepo.MockAPIKeyRepository) {
+				apiKeyRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, apiKey *models.APIKey) (*models.APIKey, error) {
+						created := *apiKey
+						created.ID = uuid.New()
+						created.CreatedAt = time.Now()
+
+						return &created, nil
+					})
+			},
+			expect: expect{
+				key: true,
+			},
+		},
+		{
+			name: "scope invalid case",
+			args: args{
+				scopes: []string{"orders:read"},
+			},
+			mock: func(apiKeyRepo *mocksRepo.MockAPIKeyRepository) {},
+			expect: expect{
+				err: services.ErrScopeInvalid,
+			},
+		},
+		{
+			name: "expiry in the past case",
+			args: args{
+				scopes:    []string{models.ScopeProfileRead},
+				expiresAt: &past,
+			},
+			mock: func(apiKeyRepo *mocksRepo.MockAPIKeyRepository) {},
+			expect: expect{
+				err: services.ErrAPIKeyExpiryInvalid,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+			tokenAdapter.EXPECT().
+				Get(ctx, userID.String()).
+				Return(refreshToken, nil)
+
+			apiKeyRepo := mocksRepo.NewMockAPIKeyRepository(ctrl)
+			tt.mock(apiKeyRepo)
+
+			cfg := &configs.Config{
+				AccessTokenPublicKey:  accessTokenPublicKey,
+				RefreshTokenPublicKey: refreshTokenPublicKey,
+			}
+
+			log := observer.New(logger.LevelError)
+
+			profileService, _ := services.NewProfileService(nil, nil, nil, apiKeyRepo, nil, tokenAdapter, nil, nil, nil, nil, nil, log, cfg)
+
+			apiKey, key, err := profileService.CreateAPIKey(ctx, &domainService.CreateAPIKeyArgs{
+				UserID:      userID.String(),
+				Name:        "warehouse sync",
+				Scopes:      tt.args.scopes,
+				ExpiresAt:   tt.args.expiresAt,
+				AccessToken: accessToken,
+			})
+
+			observer.RequireNotLogged(t, log, logger.LevelError)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+				require.Nil(t, apiKey)
+				require.Empty(t, key)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, &userID, apiKey.UserID)
+			require.Equal(t, tt.args.scopes, apiKey.Scopes)
+
+			// The key is only returned here; what is stored has to find and
+			// check it later.
+			prefix, secret, ok := apikey.Parse(key)
+			require.True(t, ok)
+			require.Equal(t, apiKey.Prefix, prefix)
+			require.True(t, apikey.Matches(secret, apiKey.SecretHash))
+		})
+	}
+}
+
+func TestProfileService_RevokeAPIKey(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		userID   = uuid.New()
+		apiKeyID = uuid.New()
+		role     = models.RoleCustomer
+
+		accessTokenPrivateKey, accessTokenPublicKey, _   = keys.Generate(keys.DefaultBits)
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+
+		accessToken, _  = jwt.Create(15*time.Minute, userID.String(), role, accessTokenPrivateKey)
+		refreshToken, _ = jwt.Create(time.Hour, userID.String(), role, refreshTokenPrivateKey)
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+	tokenAdapter.EXPECT().
+		Get(ctx, userID.String()).
+		Return(refreshToken, nil)
+
+	// RevokeForUser also misses a key of another user.
+	apiKeyRepo := mocksRepo.NewMockAPIKeyRepository(ctrl)
+	apiKeyRepo.EXPECT().
+		RevokeForUser(ctx, userID.String(), apiKeyID.String()).
+		Return(nil, pgx.ErrNoRows)
+
+	cfg := &configs.Config{
+		AccessTokenPublicKey:  accessTokenPublicKey,
+		RefreshTokenPublicKey: refreshTokenPublicKey,
+	}
+
+	log := observer.New(logger.LevelError)
+
+	profileService, _ := services.NewProfileService(nil, nil, nil, apiKeyRepo, nil, tokenAdapter, nil, nil, nil, nil, nil, log, cfg)
+
+	apiKey, err := profileService.RevokeAPIKey(ctx, userID.String(), apiKeyID.String(), accessToken)
+	require.ErrorIs(t, err, services.ErrAPIKeyNotFound)
+	require.Nil(t, apiKey)
+}
@@ -0,0 +1,272 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
+	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	storageAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/storage"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/imaging"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/profile"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileService_UploadAvatar(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		userID      string
+		contentType string
+		data        []byte
+		accessToken string
+	}
+
+	type expect struct {
+		err  error
+		urls map[string]string
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID = uuid.New()
+		role   = models.RoleCustomer
+
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn                           = 15 * time.Minute
+
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn                            = 10080 * time.Minute
+
+		accessToken, _  = jwt.Create(accessTokenExpiresIn, userID.String(), role, accessTokenPrivateKey)
+		refreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), role, refreshTokenPrivateKey)
+
+		oldAvatarKey = "avatars/" + userID.String() + "/old"
+
+		picture = func() []byte {
+			img := image.NewRGBA(image.Rect(0, 0, 120, 80))
+			for y := range 80 {
+				for x := range 120 {
+					img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 200, A: 255})
+				}
+			}
+
+			var buf bytes.Buffer
+			_ = png.Encode(&buf, img)
+
+			return buf.Bytes()
+		}()
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		mock   func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter)
+		expect expect
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx,
+				userID.String(),
+				imaging.TypePNG,
+				picture,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(&models.User{ID: userID, AvatarKey: oldAvatarKey}, nil)
+
+				userRepo.EXPECT().
+					SetAvatar(ctx, userID.String(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, key string, urls map[string]string) (*models.User, error) {
+						return &models.User{ID: userID, AvatarKey: key, AvatarURLs: urls}, nil
+					})
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				urls: map[string]string{"32": "", "64": ""},
+			},
+		},
+		{
+			name: "too large case",
+			args: args{
+				ctx,
+				userID.String(),
+				imaging.TypePNG,
+				bytes.Repeat([]byte{0}, 4097),
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				err: services.ErrAvatarTooLarge,
+			},
+		},
+		{
+			name: "content type mismatch case",
+			args: args{
+				ctx,
+				userID.String(),
+				imaging.TypeWebP,
+				picture,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				err: services.ErrAvatarContentType,
+			},
+		},
+		{
+			name: "invalid image case",
+			args: args{
+				ctx,
+				userID.String(),
+				imaging.TypePNG,
+				picture[:len(picture)/2],
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				err: services.ErrAvatarInvalid,
+			},
+		},
+		{
+			name: "access denied case",
+			args: args{
+				ctx,
+				uuid.New().String(),
+				imaging.TypePNG,
+				picture,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				err: services.ErrAccessDenied,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo, tokenAdapter := tt.mock(ctrl)
+
+			log, _ := logger.NewAdapter(&logger.Config{
+				Level: logger.LevelError,
+			})
+
+			cfg := &configs.Config{
+				AccessTokenPublicKey:  accessTokenPublicKey,
+				RefreshTokenPublicKey: refreshTokenPublicKey,
+				BlobLocalDir:          t.TempDir(),
+				BlobPublicURL:         "https://cdn.example.com/media/",
+				AvatarMaxSize:         4096,
+				AvatarSizes:           []int{32, 64},
+			}
+
+			oldAvatarDir := filepath.Join(cfg.BlobLocalDir, filepath.FromSlash(oldAvatarKey))
+			require.NoError(t, os.MkdirAll(oldAvatarDir, 0o755))
+
+			blobStorage, err := storageAdapter.NewLocalStorage(log, cfg)
+			require.NoError(t, err)
+
+			profileService, _ := services.NewProfileService(userRepo, nil, nil, nil, nil, tokenAdapter, nil, nil, nil, nil, blobStorage, log, cfg)
+
+			user, err := profileService.UploadAvatar(ctx, tt.args.userID, tt.args.contentType, bytes.NewReader(tt.args.data), tt.args.accessToken)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+				require.Nil(t, user)
+				require.DirExists(t, oldAvatarDir)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, user.AvatarURLs, len(tt.expect.urls))
+			require.NoDirExists(t, oldAvatarDir)
+
+			for size, url := range user.AvatarURLs {
+				require.Contains(t, tt.expect.urls, size)
+
+				key, ok := strings.CutPrefix(url, "https://cdn.example.com/media/")
+				require.True(t, ok, url)
+				require.Equal(t, user.AvatarKey+"/"+size+".jpg", key)
+
+				data, readErr := os.ReadFile(filepath.Join(cfg.BlobLocalDir, filepath.FromSlash(key)))
+				require.NoError(t, readErr)
+
+				thumbnail, decodeErr := imaging.Decode(data, imaging.TypeJPEG)
+				require.NoError(t, decodeErr)
+				require.Equal(t, size, strconv.Itoa(thumbnail.Bounds().Dx()))
+				require.Equal(t, thumbnail.Bounds().Dx(), thumbnail.Bounds().Dy())
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
 	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
 	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
 	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/profile"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/go-redis/redis/v8"
@@ -43,24 +44,22 @@ func TestProfileService_Delete(t *testing.T) {
 		hashedPassword, _ = hash.HashPassword(password)
 		firstName         = gofakeit.FirstName()
 		lastName          = gofakeit.LastName()
-		role              = models.UserRole
+		role              = models.RoleCustomer
 
-		accessTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		accessTokenPublicKey  = generateRSAPublicKeyBase64(t, accessTokenPrivateKey)
-		accessTokenExpiresIn  = 15 * time.Minute
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn                           = 15 * time.Minute
 
-		refreshTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		refreshTokenPublicKey  = generateRSAPublicKeyBase64(t, refreshTokenPrivateKey)
-		refreshTokenExpiresIn  = 10080 * time.Minute
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn                            = 10080 * time.Minute
 
-		accessToken, _  = jwt.Create(accessTokenExpiresIn, userID.String(), string(role), accessTokenPrivateKey)
-		refreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), string(role), refreshTokenPrivateKey)
+		accessToken, _  = jwt.Create(accessTokenExpiresIn, userID.String(), role, accessTokenPrivateKey)
+		refreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), role, refreshTokenPrivateKey)
 
-		wrongAccessTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		wrongAccessToken, _        = jwt.Create(accessTokenExpiresIn, userID.String(), string(role), wrongAccessTokenPrivateKey)
+		wrongAccessTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		wrongAccessToken, _              = jwt.Create(accessTokenExpiresIn, userID.String(), role, wrongAccessTokenPrivateKey)
 
-		wrongRefreshTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		wrongRefreshToken, _        = jwt.Create(refreshTokenExpiresIn, userID.String(), string(role), wrongRefreshTokenPrivateKey)
+		wrongRefreshTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		wrongRefreshToken, _              = jwt.Create(refreshTokenExpiresIn, userID.String(), role, wrongRefreshTokenPrivateKey)
 
 		baseUser = &models.User{
 			ID:        userID,
@@ -68,7 +67,7 @@ func TestProfileService_Delete(t *testing.T) {
 			Password:  hashedPassword,
 			FirstName: firstName,
 			LastName:  lastName,
-			Role:      role,
+			Roles:     []string{role},
 		}
 	)
 
@@ -251,7 +250,7 @@ func TestProfileService_Delete(t *testing.T) {
 				RefreshTokenExpiresIn:  refreshTokenExpiresIn,
 			}
 
-			profileService, _ := services.NewProfileService(userRepo, tokenAdapter, log, cfg)
+			profileService, _ := services.NewProfileService(userRepo, nil, nil, nil, nil, tokenAdapter, nil, nil, nil, nil, nil, log, cfg)
 
 			err := profileService.Delete(tt.args.ctx, tt.args.userID, tt.args.password, tt.args.accessToken)
 
@@ -12,6 +12,7 @@ import (
 	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
 	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
 	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
 	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/profile"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/go-redis/redis/v8"
@@ -42,24 +43,29 @@ func TestProfileService_Get(t *testing.T) {
 		hashedPassword, _ = hash.HashPassword(password)
 		firstName         = gofakeit.FirstName()
 		lastName          = gofakeit.LastName()
-		role              = models.UserRole
+		role              = models.RoleCustomer
 
-		accessTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		accessTokenPublicKey  = generateRSAPublicKeyBase64(t, accessTokenPrivateKey)
-		accessTokenExpiresIn  = 15 * time.Minute
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn                           = 15 * time.Minute
 
-		refreshTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		refreshTokenPublicKey  = generateRSAPublicKeyBase64(t, refreshTokenPrivateKey)
-		refreshTokenExpiresIn  = 10080 * time.Minute
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn                            = 10080 * time.Minute
 
-		accessToken, _  = jwt.Create(accessTokenExpiresIn, userID.String(), string(role), accessTokenPrivateKey)
-		refreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), string(role), refreshTokenPrivateKey)
+		accessToken, _  = jwt.Create(accessTokenExpiresIn, userID.String(), role, accessTokenPrivateKey)
+		refreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), role, refreshTokenPrivateKey)
 
-		wrongAccessTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		wrongAccessToken, _        = jwt.Create(accessTokenExpiresIn, userID.String(), string(role), wrongAccessTokenPrivateKey)
+		wrongAccessTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		wrongAccessToken, _              = jwt.Create(accessTokenExpiresIn, userID.String(), role, wrongAccessTokenPrivateKey)
 
-		wrongRefreshTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		wrongRefreshToken, _        = jwt.Create(refreshTokenExpiresIn, userID.String(), string(role), wrongRefreshTokenPrivateKey)
+		wrongRefreshTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		wrongRefreshToken, _              = jwt.Create(refreshTokenExpiresIn, userID.String(), role, wrongRefreshTokenPrivateKey)
+
+		// A request made with an API key carries its claims and no token.
+		apiKeyCtx = models.ContextWithClaims(ctx, &models.Claims{
+			Subject:  userID.String(),
+			Scopes:   []string{models.ScopeProfileRead},
+			APIKeyID: uuid.NewString(),
+		})
 
 		baseUser = &models.User{
 			ID:        userID,
@@ -67,8 +73,29 @@ func TestProfileService_Get(t *testing.T) {
 			Password:  hashedPassword,
 			FirstName: firstName,
 			LastName:  lastName,
-			Role:      role,
+			Roles:     []string{role},
+		}
+
+		// An admin acting as the user depends on the admin's session.
+		adminID               = uuid.New()
+		impersonationToken, _ = jwt.CreateWithClaims(accessTokenExpiresIn, models.ImpersonationClaims(baseUser, adminID.String()), accessTokenPrivateKey)
+
+		// Another customer may not read the profile; support may.
+		otherID             = uuid.New()
+		otherAccessToken, _ = jwt.Create(accessTokenExpiresIn, otherID.String(), role, accessTokenPrivateKey)
+
+		supportID = uuid.New()
+		support   = &models.User{
+			ID:          supportID,
+			Roles:       []string{"support"},
+			Permissions: []string{models.PermissionUsersRead},
 		}
+		supportAccessToken, _ = jwt.CreateWithClaims(accessTokenExpiresIn, models.UserClaims(support), accessTokenPrivateKey)
+		supportCtx            = models.ContextWithClaims(ctx, &models.Claims{
+			Subject:     supportID.String(),
+			Roles:       support.Roles,
+			Permissions: support.Permissions,
+		})
 	)
 
 	tests := []struct {
@@ -103,6 +130,102 @@ func TestProfileService_Get(t *testing.T) {
 				baseUser,
 			},
 		},
+		{
+			name: "api key case",
+			args: args{
+				apiKeyCtx,
+				userID.String(),
+				"",
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				userRepo.EXPECT().
+					FindByID(apiKeyCtx, userID.String()).
+					Return(baseUser, nil)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				nil,
+				baseUser,
+			},
+		},
+		{
+			name: "impersonation case",
+			args: args{
+				ctx,
+				userID.String(),
+				impersonationToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, adminID.String()).
+					Return(refreshToken, nil)
+
+				userRepo.EXPECT().
+					FindByID(ctx, userID.String()).
+					Return(baseUser, nil)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				nil,
+				baseUser,
+			},
+		},
+		{
+			name: "other user case",
+			args: args{
+				ctx,
+				userID.String(),
+				otherAccessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, otherID.String()).
+					Return(refreshToken, nil)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				err:  services.ErrAccessDenied,
+				user: nil,
+			},
+		},
+		{
+			name: "support case",
+			args: args{
+				supportCtx,
+				userID.String(),
+				supportAccessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(supportCtx, supportID.String()).
+					Return(refreshToken, nil)
+
+				userRepo.EXPECT().
+					FindByID(supportCtx, userID.String()).
+					Return(baseUser, nil)
+
+				return userRepo, tokenAdapter
+			},
+			expect: expect{
+				nil,
+				baseUser,
+			},
+		},
 		{
 			name: "user not found case",
 			args: args{
@@ -216,9 +339,9 @@ func TestProfileService_Get(t *testing.T) {
 				RefreshTokenExpiresIn:  refreshTokenExpiresIn,
 			}
 
-			profileService, _ := services.NewProfileService(userRepo, tokenAdapter, log, cfg)
+			profileService, _ := services.NewProfileService(userRepo, nil, nil, nil, nil, tokenAdapter, nil, nil, nil, nil, nil, log, cfg)
 
-			user, err := profileService.Get(ctx, tt.args.userID, tt.args.accessToken)
+			user, err := profileService.Get(tt.args.ctx, tt.args.userID, tt.args.accessToken)
 
 			if tt.expect.err != nil {
 				require.Error(t, err)
@@ -0,0 +1,305 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/logger/observer"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
+	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	webauthnAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/webauthn"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/webauthn/webauthntest"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/profile"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const passkeyOrigin = "https://store.example"
+
+type passkeyMocks struct {
+	userRepo                *mocksRepo.MockUserRepository
+	passkeyRepo             *mocksRepo.MockPasskeyRepository
+	tokenAdapter            *mocksAdapter.MockTokenAdapter
+	passkeyChallengeAdapter *mocksAdapter.MockPasskeyChallengeAdapter
+}
+
+func newPasskeyMocks(ctrl *gomock.Controller) *passkeyMocks {
+	return &passkeyMocks{
+		userRepo:                mocksRepo.NewMockUserRepository(ctrl),
+		passkeyRepo:             mocksRepo.NewMockPasskeyRepository(ctrl),
+		tokenAdapter:            mocksAdapter.NewMockTokenAdapter(ctrl),
+		passkeyChallengeAdapter: mocksAdapter.NewMockPasskeyChallengeAdapter(ctrl),
+	}
+}
+
+func TestProfileService_RegisterPasskey(t *testing.T) {
+	type args struct {
+		origin string
+		name   string
+	}
+
+	type expect struct {
+		err     error
+		passkey bool
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID = uuid.New()
+		role   = models.RoleCustomer
+		name   = "MacBook"
+
+		challengeExpiresIn = 5 * time.Minute
+
+		accessTokenPrivateKey, accessTokenPublicKey, _   = keys.Generate(keys.DefaultBits)
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+
+		accessToken, _  = jwt.Create(15*time.Minute, userID.String(), role, accessTokenPrivateKey)
+		refreshToken, _ = jwt.Create(time.Hour, userID.String(), role, refreshTokenPrivateKey)
+
+		user = &models.User{
+			ID:        userID,
+			Email:     "test1@test.ru",
+			FirstName: "Jane",
+			LastName:  "Doe",
+			Roles:     []string{role},
+		}
+	)
+
+	tests := []struct {
+		name string
+		args args
+		// finish sets up the calls made once the authenticator answered,
+		// given the challenge stored when the ceremony began.
+		finish func(m *passkeyMocks, challenge *domainAdapter.PasskeyChallenge)
+		expect expect
+	}{
+		{
+			name: "success case",
+			args: args{
+				origin: passkeyOrigin,
+				name:   name,
+			},
+			finish: func(m *passkeyMocks, challenge *domainAdapter.PasskeyChallenge) {
+				m.passkeyChallengeAdapter.EXPECT().
+					Take(ctx, gomock.Any()).
+					Return(challenge, nil)
+
+				m.passkeyRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, passkey *models.Passkey) (*models.Passkey, error) {
+						created := *passkey
+						created.ID = uuid.New()
+						created.CreatedAt = time.Now()
+
+						return &created, nil
+					})
+			},
+			expect: expect{
+				passkey: true,
+			},
+		},
+		{
+			name: "ceremony expired case",
+			args: args{
+				origin: passkeyOrigin,
+				name:   name,
+			},
+			finish: func(m *passkeyMocks, _ *domainAdapter.PasskeyChallenge) {
+				m.passkeyChallengeAdapter.EXPECT().
+					Take(ctx, gomock.Any()).
+					Return(nil, redis.Nil)
+			},
+			expect: expect{
+				err: services.ErrPasskeyInvalid,
+			},
+		},
+		{
+			name: "ceremony of other user case",
+			args: args{
+				origin: passkeyOrigin,
+				name:   name,
+			},
+			finish: func(m *passkeyMocks, challenge *domainAdapter.PasskeyChallenge) {
+				m.passkeyChallengeAdapter.EXPECT().
+					Take(ctx, gomock.Any()).
+					Return(&domainAdapter.PasskeyChallenge{
+						UserID:  uuid.NewString(),
+						Session: challenge.Session,
+					}, nil)
+			},
+			expect: expect{
+				err: services.ErrPasskeyInvalid,
+			},
+		},
+		{
+			name: "wrong origin case",
+			args: args{
+				origin: "https://evil.example",
+				name:   name,
+			},
+			finish: func(m *passkeyMocks, challenge *domainAdapter.PasskeyChallenge) {
+				m.passkeyChallengeAdapter.EXPECT().
+					Take(ctx, gomock.Any()).
+					Return(challenge, nil)
+			},
+			expect: expect{
+				err: services.ErrPasskeyInvalid,
+			},
+		},
+		{
+			name: "passkey exists case",
+			args: args{
+				origin: passkeyOrigin,
+				name:   name,
+			},
+			finish: func(m *passkeyMocks, challenge *domainAdapter.PasskeyChallenge) {
+				m.passkeyChallengeAdapter.EXPECT().
+					Take(ctx, gomock.Any()).
+					Return(challenge, nil)
+
+				m.passkeyRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					Return(nil, domainErrors.ErrPasskeyExists)
+			},
+			expect: expect{
+				err: services.ErrPasskeyExists,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newPasskeyMocks(ctrl)
+
+			m.tokenAdapter.EXPECT().
+				Get(ctx, userID.String()).
+				Return(refreshToken, nil).
+				Times(2)
+
+			m.userRepo.EXPECT().
+				FindByID(ctx, userID.String()).
+				Return(user, nil).
+				AnyTimes()
+
+			m.passkeyRepo.EXPECT().
+				FindByUserID(ctx, userID.String()).
+				Return(nil, nil)
+
+			var (
+				ceremonyID string
+				stored     *domainAdapter.PasskeyChallenge
+			)
+
+			m.passkeyChallengeAdapter.EXPECT().
+				Set(ctx, gomock.Any(), gomock.Any(), challengeExpiresIn).
+				DoAndReturn(func(_ context.Context, id string, challenge *domainAdapter.PasskeyChallenge, _ time.Duration) error {
+					ceremonyID, stored = id, challenge
+
+					return nil
+				})
+
+			cfg := &configs.Config{
+				AccessTokenPublicKey:      accessTokenPublicKey,
+				RefreshTokenPublicKey:     refreshTokenPublicKey,
+				WebAuthnRPID:              "store.example",
+				WebAuthnRPName:            "Online Store",
+				WebAuthnRPOrigins:         []string{passkeyOrigin},
+				PasskeyChallengeExpiresIn: challengeExpiresIn,
+			}
+
+			log := observer.New(logger.LevelError)
+
+			passkeys, err := webauthnAdapter.NewPasskeys(log, cfg)
+			require.NoError(t, err)
+
+			profileService, _ := services.NewProfileService(m.userRepo, nil, m.passkeyRepo, nil, nil, m.tokenAdapter, nil, m.passkeyChallengeAdapter, passkeys, nil, nil, log, cfg)
+
+			beganID, options, err := profileService.BeginPasskeyRegistration(ctx, userID.String(), accessToken)
+			require.NoError(t, err)
+			require.Equal(t, ceremonyID, beganID)
+			require.Equal(t, userID.String(), stored.UserID)
+
+			response, err := webauthntest.NewAuthenticator(tt.args.origin).Register(options)
+			require.NoError(t, err)
+
+			tt.finish(m, stored)
+
+			passkey, err := profileService.FinishPasskeyRegistration(ctx, userID.String(), ceremonyID, tt.args.name, response, accessToken)
+
+			observer.RequireNotLogged(t, log, logger.LevelError)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+				require.Nil(t, passkey)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotEqual(t, uuid.Nil, passkey.ID)
+			require.Equal(t, userID, passkey.UserID)
+			require.Equal(t, tt.args.name, passkey.Name)
+			require.Equal(t, webauthntest.AAGUID, passkey.AAGUID)
+		})
+	}
+}
+
+func TestProfileService_DeletePasskey(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		userID    = uuid.New()
+		passkeyID = uuid.New()
+		role      = models.RoleCustomer
+
+		accessTokenPrivateKey, accessTokenPublicKey, _   = keys.Generate(keys.DefaultBits)
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+
+		accessToken, _  = jwt.Create(15*time.Minute, userID.String(), role, accessTokenPrivateKey)
+		refreshToken, _ = jwt.Create(time.Hour, userID.String(), role, refreshTokenPrivateKey)
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newPasskeyMocks(ctrl)
+
+	m.tokenAdapter.EXPECT().
+		Get(ctx, userID.String()).
+		Return(refreshToken, nil)
+
+	m.passkeyRepo.EXPECT().
+		Delete(ctx, userID.String(), passkeyID.String()).
+		Return(pgx.ErrNoRows)
+
+	cfg := &configs.Config{
+		AccessTokenPublicKey:  accessTokenPublicKey,
+		RefreshTokenPublicKey: refreshTokenPublicKey,
+	}
+
+	log := observer.New(logger.LevelError)
+
+	profileService, _ := services.NewProfileService(nil, nil, m.passkeyRepo, nil, nil, m.tokenAdapter, nil, nil, nil, nil, nil, log, cfg)
+
+	err := profileService.DeletePasskey(ctx, userID.String(), passkeyID.String(), accessToken)
+	require.ErrorIs(t, err, services.ErrPasskeyNotFound)
+}
@@ -0,0 +1,594 @@
+package tests
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/hash"
+	"github.com/BlazeCoder04/online_store/libs/jwt"
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	domainAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis"
+	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
+	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
+	smsAdapter "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/adapters/sms"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
+	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/profile"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileService_SetPhone(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		userID      string
+		phone       string
+		accessToken string
+	}
+
+	type expect struct {
+		err  error
+		sent bool
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID = uuid.New()
+		role   = models.RoleCustomer
+		phone  = "+14155552671"
+
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn                           = 15 * time.Minute
+
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn                            = 10080 * time.Minute
+
+		accessToken, _  = jwt.Create(accessTokenExpiresIn, userID.String(), role, accessTokenPrivateKey)
+		refreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), role, refreshTokenPrivateKey)
+
+		user = &models.User{
+			ID:    userID,
+			Roles: []string{role},
+			Phone: phone,
+		}
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		mock   func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter, *mocksAdapter.MockOTPAdapter)
+		expect expect
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx,
+				userID.String(),
+				"+1 415 555 2671",
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter, *mocksAdapter.MockOTPAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+				otpAdapter := mocksAdapter.NewMockOTPAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				otpAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(nil, redis.Nil)
+
+				userRepo.EXPECT().
+					SetPhone(ctx, userID.String(), phone).
+					Return(user, nil)
+
+				otpAdapter.EXPECT().
+					Set(ctx, userID.String(), gomock.Any(), 5*time.Minute).
+					DoAndReturn(func(_ context.Context, _ string, otp *domainAdapter.PhoneOTP, _ time.Duration) error {
+						require.Equal(t, phone, otp.Phone)
+						require.Zero(t, otp.Attempts)
+
+						return nil
+					})
+
+				return userRepo, tokenAdapter, otpAdapter
+			},
+			expect: expect{
+				sent: true,
+			},
+		},
+		{
+			name: "phone invalid case",
+			args: args{
+				ctx,
+				userID.String(),
+				"+1 000 000 0000",
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter, *mocksAdapter.MockOTPAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+				otpAdapter := mocksAdapter.NewMockOTPAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				return userRepo, tokenAdapter, otpAdapter
+			},
+			expect: expect{
+				err: services.ErrPhoneInvalid,
+			},
+		},
+		{
+			name: "resend too soon case",
+			args: args{
+				ctx,
+				userID.String(),
+				phone,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter, *mocksAdapter.MockOTPAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+				otpAdapter := mocksAdapter.NewMockOTPAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				otpAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(&domainAdapter.PhoneOTP{Phone: phone, SentAt: time.Now()}, nil)
+
+				return userRepo, tokenAdapter, otpAdapter
+			},
+			expect: expect{
+				err: services.ErrOTPResendTooSoon,
+			},
+		},
+		{
+			name: "user not found case",
+			args: args{
+				ctx,
+				userID.String(),
+				phone,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter, *mocksAdapter.MockOTPAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+				otpAdapter := mocksAdapter.NewMockOTPAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				otpAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(&domainAdapter.PhoneOTP{Phone: phone, SentAt: time.Now().Add(-time.Minute)}, nil)
+
+				userRepo.EXPECT().
+					SetPhone(ctx, userID.String(), phone).
+					Return(nil, pgx.ErrNoRows)
+
+				return userRepo, tokenAdapter, otpAdapter
+			},
+			expect: expect{
+				err: services.ErrUserNotFound,
+			},
+		},
+		{
+			name: "access denied case",
+			args: args{
+				ctx,
+				uuid.New().String(),
+				phone,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter, *mocksAdapter.MockOTPAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+				otpAdapter := mocksAdapter.NewMockOTPAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				return userRepo, tokenAdapter, otpAdapter
+			},
+			expect: expect{
+				err: services.ErrAccessDenied,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo, tokenAdapter, otpAdapter := tt.mock(ctrl)
+
+			log, _ := logger.NewAdapter(&logger.Config{
+				Level: logger.LevelError,
+			})
+
+			smsSender := smsAdapter.NewMemorySender(log)
+
+			cfg := &configs.Config{
+				AccessTokenPublicKey:  accessTokenPublicKey,
+				RefreshTokenPublicKey: refreshTokenPublicKey,
+				PhoneOTPExpiresIn:     5 * time.Minute,
+				PhoneOTPMaxAttempts:   5,
+				PhoneOTPResendAfter:   30 * time.Second,
+			}
+
+			profileService, _ := services.NewProfileService(userRepo, nil, nil, nil, nil, tokenAdapter, otpAdapter, nil, nil, smsSender, nil, log, cfg)
+
+			user, err := profileService.SetPhone(ctx, tt.args.userID, tt.args.phone, tt.args.accessToken)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+				require.Nil(t, user)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, user)
+			}
+
+			messages := smsSender.Messages()
+			if tt.expect.sent {
+				require.Len(t, messages, 1)
+				require.Equal(t, phone, messages[0].Phone)
+				require.Regexp(t, regexp.MustCompile(`\b[0-9]{6}\b`), messages[0].Text)
+			} else {
+				require.Empty(t, messages)
+			}
+		})
+	}
+}
+
+func TestProfileService_VerifyPhone(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		userID      string
+		code        string
+		accessToken string
+	}
+
+	type expect struct {
+		err  error
+		user *models.User
+	}
+
+	var (
+		ctx = context.Background()
+
+		userID = uuid.New()
+		role   = models.RoleCustomer
+		phone  = "+14155552671"
+
+		code                 = "123456"
+		codeHash, _          = hash.HashPassword(code)
+		maxAttempts          = 5
+		accessTokenExpiresIn = 15 * time.Minute
+
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn                            = 10080 * time.Minute
+
+		accessToken, _  = jwt.Create(accessTokenExpiresIn, userID.String(), role, accessTokenPrivateKey)
+		refreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), role, refreshTokenPrivateKey)
+
+		pending = &domainAdapter.PhoneOTP{
+			Phone:    phone,
+			CodeHash: codeHash,
+			SentAt:   time.Now(),
+		}
+
+		verifiedUser = &models.User{
+			ID:            userID,
+			Roles:         []string{role},
+			Phone:         phone,
+			PhoneVerified: true,
+		}
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		mock   func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter, *mocksAdapter.MockOTPAdapter)
+		expect expect
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx,
+				userID.String(),
+				code,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter, *mocksAdapter.MockOTPAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+				otpAdapter := mocksAdapter.NewMockOTPAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				otpAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(pending, nil)
+
+				otpAdapter.EXPECT().
+					IncrAttempts(ctx, userID.String()).
+					Return(1, nil)
+
+				userRepo.EXPECT().
+					VerifyPhone(ctx, userID.String(), phone).
+					Return(verifiedUser, nil)
+
+				otpAdapter.EXPECT().
+					Del(ctx, userID.String()).
+					Return(nil)
+
+				return userRepo, tokenAdapter, otpAdapter
+			},
+			expect: expect{
+				user: verifiedUser,
+			},
+		},
+		{
+			name: "code not requested case",
+			args: args{
+				ctx,
+				userID.String(),
+				code,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter, *mocksAdapter.MockOTPAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+				otpAdapter := mocksAdapter.NewMockOTPAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				otpAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(nil, redis.Nil)
+
+				return userRepo, tokenAdapter, otpAdapter
+			},
+			expect: expect{
+				err: services.ErrOTPNotFound,
+			},
+		},
+		{
+			name: "code wrong case",
+			args: args{
+				ctx,
+				userID.String(),
+				"654321",
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter, *mocksAdapter.MockOTPAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+				otpAdapter := mocksAdapter.NewMockOTPAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				otpAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(pending, nil)
+
+				otpAdapter.EXPECT().
+					IncrAttempts(ctx, userID.String()).
+					Return(1, nil)
+
+				return userRepo, tokenAdapter, otpAdapter
+			},
+			expect: expect{
+				err: services.ErrOTPInvalid,
+			},
+		},
+		{
+			name: "attempts exceeded case",
+			args: args{
+				ctx,
+				userID.String(),
+				"654321",
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter, *mocksAdapter.MockOTPAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+				otpAdapter := mocksAdapter.NewMockOTPAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				otpAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(pending, nil)
+
+				otpAdapter.EXPECT().
+					IncrAttempts(ctx, userID.String()).
+					Return(maxAttempts, nil)
+
+				otpAdapter.EXPECT().
+					Del(ctx, userID.String()).
+					Return(nil)
+
+				return userRepo, tokenAdapter, otpAdapter
+			},
+			expect: expect{
+				err: services.ErrOTPAttemptsExceeded,
+			},
+		},
+		{
+			name: "attempts used up case",
+			args: args{
+				ctx,
+				userID.String(),
+				code,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter, *mocksAdapter.MockOTPAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+				otpAdapter := mocksAdapter.NewMockOTPAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				otpAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(pending, nil)
+
+				// The right code is refused once the attempts are used up.
+				otpAdapter.EXPECT().
+					IncrAttempts(ctx, userID.String()).
+					Return(maxAttempts+1, nil)
+
+				otpAdapter.EXPECT().
+					Del(ctx, userID.String()).
+					Return(nil)
+
+				return userRepo, tokenAdapter, otpAdapter
+			},
+			expect: expect{
+				err: services.ErrOTPAttemptsExceeded,
+			},
+		},
+		{
+			name: "phone taken case",
+			args: args{
+				ctx,
+				userID.String(),
+				code,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter, *mocksAdapter.MockOTPAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+				otpAdapter := mocksAdapter.NewMockOTPAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				otpAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(pending, nil)
+
+				otpAdapter.EXPECT().
+					IncrAttempts(ctx, userID.String()).
+					Return(1, nil)
+
+				userRepo.EXPECT().
+					VerifyPhone(ctx, userID.String(), phone).
+					Return(nil, services.ErrPhoneExists)
+
+				return userRepo, tokenAdapter, otpAdapter
+			},
+			expect: expect{
+				err: services.ErrPhoneExists,
+			},
+		},
+		{
+			name: "phone changed since sending case",
+			args: args{
+				ctx,
+				userID.String(),
+				code,
+				accessToken,
+			},
+			mock: func(ctrl *gomock.Controller) (*mocksRepo.MockUserRepository, *mocksAdapter.MockTokenAdapter, *mocksAdapter.MockOTPAdapter) {
+				userRepo := mocksRepo.NewMockUserRepository(ctrl)
+				tokenAdapter := mocksAdapter.NewMockTokenAdapter(ctrl)
+				otpAdapter := mocksAdapter.NewMockOTPAdapter(ctrl)
+
+				tokenAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(refreshToken, nil)
+
+				otpAdapter.EXPECT().
+					Get(ctx, userID.String()).
+					Return(pending, nil)
+
+				otpAdapter.EXPECT().
+					IncrAttempts(ctx, userID.String()).
+					Return(1, nil)
+
+				userRepo.EXPECT().
+					VerifyPhone(ctx, userID.String(), phone).
+					Return(nil, pgx.ErrNoRows)
+
+				return userRepo, tokenAdapter, otpAdapter
+			},
+			expect: expect{
+				err: services.ErrOTPInvalid,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo, tokenAdapter, otpAdapter := tt.mock(ctrl)
+
+			log, _ := logger.NewAdapter(&logger.Config{
+				Level: logger.LevelError,
+			})
+
+			cfg := &configs.Config{
+				AccessTokenPublicKey:  accessTokenPublicKey,
+				RefreshTokenPublicKey: refreshTokenPublicKey,
+				PhoneOTPMaxAttempts:   maxAttempts,
+			}
+
+			profileService, _ := services.NewProfileService(userRepo, nil, nil, nil, nil, tokenAdapter, otpAdapter, nil, nil, nil, nil, log, cfg)
+
+			user, err := profileService.VerifyPhone(ctx, tt.args.userID, tt.args.code, tt.args.accessToken)
+
+			if tt.expect.err != nil {
+				require.ErrorIs(t, err, tt.expect.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.expect.user != nil {
+				require.NotNil(t, user)
+				require.True(t, user.PhoneVerified)
+			} else {
+				require.Nil(t, user)
+			}
+		})
+	}
+}
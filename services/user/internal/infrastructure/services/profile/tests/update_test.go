@@ -13,6 +13,7 @@ import (
 	mocksAdapter "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/adapters/cache/redis/mocks"
 	mocksRepo "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/repositories/mocks"
 	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+	"github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/keys"
 	services "github.com/BlazeCoder04/online_store/services/user/internal/infrastructure/services/profile"
 	"github.com/go-redis/redis/v8"
 	"github.com/golang/mock/gomock"
@@ -46,24 +47,22 @@ func TestProfileService_Update(t *testing.T) {
 		newFirstName      = "Mike"
 		lastName          = "Doe"
 		newLastName       = "Smith"
-		role              = models.UserRole
+		role              = models.RoleCustomer
 
-		accessTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		accessTokenPublicKey  = generateRSAPublicKeyBase64(t, accessTokenPrivateKey)
-		accessTokenExpiresIn  = 15 * time.Minute
+		accessTokenPrivateKey, accessTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		accessTokenExpiresIn                           = 15 * time.Minute
 
-		refreshTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		refreshTokenPublicKey  = generateRSAPublicKeyBase64(t, refreshTokenPrivateKey)
-		refreshTokenExpiresIn  = 10080 * time.Minute
+		refreshTokenPrivateKey, refreshTokenPublicKey, _ = keys.Generate(keys.DefaultBits)
+		refreshTokenExpiresIn                            = 10080 * time.Minute
 
-		accessToken, _  = jwt.Create(accessTokenExpiresIn, userID.String(), string(role), accessTokenPrivateKey)
-		refreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), string(role), refreshTokenPrivateKey)
+		accessToken, _  = jwt.Create(accessTokenExpiresIn, userID.String(), role, accessTokenPrivateKey)
+		refreshToken, _ = jwt.Create(refreshTokenExpiresIn, userID.String(), role, refreshTokenPrivateKey)
 
-		wrongAccessTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		wrongAccessToken, _        = jwt.Create(accessTokenExpiresIn, userID.String(), string(role), wrongAccessTokenPrivateKey)
+		wrongAccessTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		wrongAccessToken, _              = jwt.Create(accessTokenExpiresIn, userID.String(), role, wrongAccessTokenPrivateKey)
 
-		wrongRefreshTokenPrivateKey = generateRSAPrivateKeyBase64(t)
-		wrongRefreshToken, _        = jwt.Create(refreshTokenExpiresIn, userID.String(), string(role), wrongRefreshTokenPrivateKey)
+		wrongRefreshTokenPrivateKey, _, _ = keys.Generate(keys.DefaultBits)
+		wrongRefreshToken, _              = jwt.Create(refreshTokenExpiresIn, userID.String(), role, wrongRefreshTokenPrivateKey)
 
 		baseUser = &models.User{
 			ID:        userID,
@@ -71,7 +70,7 @@ func TestProfileService_Update(t *testing.T) {
 			Password:  hashedPassword,
 			FirstName: firstName,
 			LastName:  lastName,
-			Role:      role,
+			Roles:     []string{role},
 		}
 	)
 
@@ -559,7 +558,7 @@ func TestProfileService_Update(t *testing.T) {
 				RefreshTokenExpiresIn:  refreshTokenExpiresIn,
 			}
 
-			profileService, _ := services.NewProfileService(userRepo, tokenAdapter, log, cfg)
+			profileService, _ := services.NewProfileService(userRepo, nil, nil, nil, nil, tokenAdapter, nil, nil, nil, nil, nil, log, cfg)
 
 			user, err := profileService.Update(ctx, tt.args.in)
 
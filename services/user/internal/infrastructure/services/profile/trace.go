@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BlazeCoder04/online_store/libs/hash"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var tracer = otel.Tracer("profile.service")
+
+// comparePassword reports a mismatch for users without a password, who
+// signed up through an identity provider.
+func comparePassword(ctx context.Context, hashedPassword, password string) error {
+	if hashedPassword == "" {
+		return bcrypt.ErrMismatchedHashAndPassword
+	}
+
+	_, span := tracer.Start(ctx, "hash.ComparePassword")
+	defer span.End()
+
+	err := hash.ComparePassword(hashedPassword, password)
+	if err != nil && !errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func hashPassword(ctx context.Context, password string) (string, error) {
+	_, span := tracer.Start(ctx, "hash.HashPassword")
+	defer span.End()
+
+	hashedPassword, err := hash.HashPassword(password)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return hashedPassword, err
+}
@@ -0,0 +1,6 @@
+package tracing
+
+const (
+	ErrUnknownExporter = "unknown tracing exporter"
+	ErrCreateExporter  = "error creating the tracing exporter"
+)
@@ -0,0 +1,29 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// LogFields returns the trace and span IDs of the span in ctx, so log lines
+// can be matched with their trace. It returns nothing outside a span.
+// NewProvider registers it with the logger for every *Ctx call.
+func LogFields(ctx context.Context) []logger.Field {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() {
+		return nil
+	}
+
+	return []logger.Field{
+		{
+			Key:   "trace_id",
+			Value: spanCtx.TraceID().String(),
+		},
+		{
+			Key:   "span_id",
+			Value: spanCtx.SpanID().String(),
+		},
+	}
+}
@@ -0,0 +1,81 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/services/user/configs"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+// NewProvider installs the global tracer provider and the W3C trace context
+// propagator. With the "none" exporter spans are still created, so trace IDs
+// keep correlating logs, but nothing is exported.
+func NewProvider(log logger.Logger, cfg *configs.Config) (*sdktrace.TracerProvider, error) {
+	loggerTag := "tracing.newProvider"
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.TracingSampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(cfg.ServiceName),
+		)),
+	}
+
+	switch cfg.TracingExporter {
+	case ExporterNone:
+	case ExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", ErrCreateExporter, err)
+		}
+
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	case ExporterOTLP:
+		exporterOpts := []otlptracegrpc.Option{}
+		if cfg.TracingEndpoint != "" {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithEndpoint(cfg.TracingEndpoint))
+		}
+		if cfg.TracingInsecure {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+		}
+
+		exporter, err := otlptracegrpc.New(context.Background(), exporterOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", ErrCreateExporter, err)
+		}
+
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	default:
+		return nil, fmt.Errorf("%s: %q", ErrUnknownExporter, cfg.TracingExporter)
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	logger.RegisterContextExtractor(LogFields)
+
+	log.Info(loggerTag, "Tracer provider initialized", logger.Field{
+		Key:   "exporter",
+		Value: cfg.TracingExporter,
+	})
+
+	return provider, nil
+}
@@ -0,0 +1,61 @@
+package converters
+
+import (
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	desc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AddressToDesc(address *models.Address) *desc.Address {
+	return &desc.Address{
+		Id:              address.ID.String(),
+		UserId:          address.UserID.String(),
+		Label:           address.Label,
+		Recipient:       address.Recipient,
+		Line1:           address.Line1,
+		Line2:           address.Line2,
+		City:            address.City,
+		Region:          address.Region,
+		PostalCode:      address.PostalCode,
+		CountryCode:     address.CountryCode,
+		Phone:           address.Phone,
+		DefaultShipping: address.DefaultShipping,
+		DefaultBilling:  address.DefaultBilling,
+		CreatedAt:       timestamppb.New(address.CreatedAt.UTC()),
+		UpdatedAt:       timestamppb.New(address.UpdatedAt.UTC()),
+	}
+}
+
+func AddressesToDesc(addresses []*models.Address) []*desc.Address {
+	result := make([]*desc.Address, 0, len(addresses))
+	for _, address := range addresses {
+		result = append(result, AddressToDesc(address))
+	}
+
+	return result
+}
+
+// AddressFromDesc expects IDs that passed request validation.
+func AddressFromDesc(userID, addressID string, input *desc.AddressInput) *models.Address {
+	address := &models.Address{
+		UserID:          uuid.MustParse(userID),
+		Label:           input.Label,
+		Recipient:       input.Recipient,
+		Line1:           input.Line1,
+		Line2:           input.Line2,
+		City:            input.City,
+		Region:          input.Region,
+		PostalCode:      input.PostalCode,
+		CountryCode:     input.CountryCode,
+		Phone:           input.Phone,
+		DefaultShipping: input.DefaultShipping,
+		DefaultBilling:  input.DefaultBilling,
+	}
+
+	if addressID != "" {
+		address.ID = uuid.MustParse(addressID)
+	}
+
+	return address
+}
@@ -0,0 +1,37 @@
+package converters
+
+import (
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	desc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func APIKeyToDesc(apiKey *models.APIKey) *desc.ApiKey {
+	result := &desc.ApiKey{
+		Id:        apiKey.ID.String(),
+		Name:      apiKey.Name,
+		Prefix:    apiKey.Prefix,
+		Scopes:    apiKey.Scopes,
+		Revoked:   apiKey.Revoked,
+		CreatedAt: timestamppb.New(apiKey.CreatedAt.UTC()),
+	}
+
+	if apiKey.ExpiresAt != nil {
+		result.ExpiresAt = timestamppb.New(apiKey.ExpiresAt.UTC())
+	}
+
+	if apiKey.LastUsedAt != nil {
+		result.LastUsedAt = timestamppb.New(apiKey.LastUsedAt.UTC())
+	}
+
+	return result
+}
+
+func APIKeysToDesc(apiKeys []*models.APIKey) []*desc.ApiKey {
+	result := make([]*desc.ApiKey, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		result = append(result, APIKeyToDesc(apiKey))
+	}
+
+	return result
+}
@@ -0,0 +1,55 @@
+package converters
+
+import (
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	desc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func PasskeyToDesc(passkey *models.Passkey) *desc.Passkey {
+	result := &desc.Passkey{
+		Id:             passkey.ID.String(),
+		Name:           passkey.Name,
+		Transports:     passkey.Transports,
+		BackupEligible: passkey.BackupEligible,
+		BackupState:    passkey.BackupState,
+		CreatedAt:      timestamppb.New(passkey.CreatedAt.UTC()),
+	}
+
+	if passkey.AAGUID != uuid.Nil {
+		result.Aaguid = passkey.AAGUID.String()
+	}
+
+	if passkey.LastUsedAt != nil {
+		result.LastUsedAt = timestamppb.New(passkey.LastUsedAt.UTC())
+	}
+
+	return result
+}
+
+func PasskeysToDesc(passkeys []*models.Passkey) []*desc.Passkey {
+	result := make([]*desc.Passkey, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		result = append(result, PasskeyToDesc(passkey))
+	}
+
+	return result
+}
+
+// JSONToStruct carries the WebAuthn options, which are JSON already, as a
+// message, so the HTTP API returns them as an object rather than a string.
+func JSONToStruct(data []byte) (*structpb.Struct, error) {
+	result := &structpb.Struct{}
+	if err := protojson.Unmarshal(data, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func StructToJSON(data *structpb.Struct) ([]byte, error) {
+	return protojson.Marshal(data)
+}
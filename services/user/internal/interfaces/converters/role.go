@@ -0,0 +1,23 @@
+package converters
+
+import (
+	"github.com/BlazeCoder04/online_store/services/user/internal/domain/models"
+	desc "github.com/BlazeCoder04/online_store/services/user/pkg/admin/v1"
+)
+
+func RoleToDesc(role *models.Role) *desc.Role {
+	return &desc.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+	}
+}
+
+func RolesToDesc(roles []*models.Role) []*desc.Role {
+	result := make([]*desc.Role, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, RoleToDesc(role))
+	}
+
+	return result
+}
@@ -8,12 +8,16 @@ import (
 
 func UserToDesc(user *models.User) *desc.User {
 	return &desc.User{
-		Id:        user.ID.String(),
-		Email:     user.Email,
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Role:      desc.UserRole(desc.UserRole_value[string(user.Role)]),
-		CreatedAt: timestamppb.New(user.CreatedAt.UTC()),
-		UpdatedAt: timestamppb.New(user.UpdatedAt.UTC()),
+		Id:            user.ID.String(),
+		Email:         user.Email,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		CreatedAt:     timestamppb.New(user.CreatedAt.UTC()),
+		UpdatedAt:     timestamppb.New(user.UpdatedAt.UTC()),
+		Phone:         user.Phone,
+		PhoneVerified: user.PhoneVerified,
+		AvatarUrls:    user.AvatarURLs,
+		Roles:         user.Roles,
+		Permissions:   user.Permissions,
 	}
 }
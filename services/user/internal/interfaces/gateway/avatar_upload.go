@@ -0,0 +1,133 @@
+// Package gateway holds the HTTP handlers written by hand for RPCs the
+// generated gateway cannot map, such as the avatar upload.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	profileDesc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	avatarUploadPath = "/v1/profiles/{user_id}/avatar"
+	// AvatarFormField is the multipart field that holds the image.
+	AvatarFormField = "avatar"
+	avatarChunkSize = 64 << 10
+)
+
+// RegisterAvatarUploadHandler serves POST /v1/profiles/{user_id}/avatar on
+// mux, next to the handlers of profileDesc.RegisterProfileV1HandlerClient.
+// The image is taken from the "avatar" field of a multipart/form-data body,
+// with the content type of that part, and streamed to UploadAvatar through
+// client.
+func RegisterAvatarUploadHandler(mux *runtime.ServeMux, client profileDesc.ProfileV1Client) error {
+	return mux.HandlePath(http.MethodPost, avatarUploadPath, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, profileDesc.ProfileV1_UploadAvatar_FullMethodName, runtime.WithHTTPPathPattern(avatarUploadPath))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := uploadAvatar(annotatedContext, client, req, pathParams["user_id"])
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		runtime.ForwardResponseMessage(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+}
+
+func uploadAvatar(ctx context.Context, client profileDesc.ProfileV1Client, req *http.Request, userID string) (*profileDesc.UploadAvatarResponse, runtime.ServerMetadata, error) {
+	var metadata runtime.ServerMetadata
+
+	part, err := avatarPart(req)
+	if err != nil {
+		return nil, metadata, err
+	}
+	defer part.Close()
+
+	stream, err := client.UploadAvatar(ctx)
+	if err != nil {
+		return nil, metadata, err
+	}
+
+	err = stream.Send(&profileDesc.UploadAvatarRequest{
+		Data: &profileDesc.UploadAvatarRequest_Info{
+			Info: &profileDesc.AvatarInfo{
+				UserId:      userID,
+				ContentType: part.Header.Get("Content-Type"),
+			},
+		},
+	})
+
+	buf := make([]byte, avatarChunkSize)
+	for err == nil {
+		n, readErr := io.ReadFull(part, buf)
+		if n > 0 {
+			err = stream.Send(&profileDesc.UploadAvatarRequest{
+				Data: &profileDesc.UploadAvatarRequest_Chunk{Chunk: buf[:n]},
+			})
+		}
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, metadata, status.Error(codes.InvalidArgument, readErr.Error())
+		}
+	}
+	// io.EOF from Send means the server has already answered, e.g. because
+	// the file is too large; the answer is read below.
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, err
+	}
+
+	if err = stream.CloseSend(); err != nil {
+		return nil, metadata, err
+	}
+
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+
+	resp, err := stream.CloseAndRecv()
+	metadata.TrailerMD = stream.Trailer()
+
+	return resp, metadata, err
+}
+
+// avatarPart returns the "avatar" part of a multipart/form-data request.
+func avatarPart(req *http.Request) (*multipart.Part, error) {
+	reader, err := req.MultipartReader()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, status.Errorf(codes.InvalidArgument, "multipart field %q not provided", AvatarFormField)
+			}
+
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if part.FormName() == AvatarFormField {
+			return part, nil
+		}
+
+		part.Close()
+	}
+}
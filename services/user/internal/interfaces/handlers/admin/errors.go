@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"errors"
+
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+)
+
+var (
+	ErrMetadataNotProvided = errors.New("metadata.not_provided")
+	ErrHeaderNotProvided   = errors.New("header.not_provided")
+
+	ErrUserNotFound    = domainErrors.ErrUserNotFound
+	ErrTokenInvalid    = domainErrors.ErrTokenInvalid
+	ErrUserBlocked     = domainErrors.ErrUserBlocked
+	ErrRoleNotFound    = domainErrors.ErrRoleNotFound
+	ErrRoleNotAssigned = domainErrors.ErrRoleNotAssigned
+
+	ErrImpersonationRefused = domainErrors.ErrImpersonationRefused
+)
@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/validate"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+	"github.com/BlazeCoder04/online_store/services/user/internal/interfaces/converters"
+	desc "github.com/BlazeCoder04/online_store/services/user/pkg/admin/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type AdminHandler struct {
+	desc.UnimplementedAdminV1Server
+	adminService domain.AdminService
+	logger       logger.Logger
+}
+
+const tokenPrefix = "Bearer "
+
+func NewAdminHandler(adminService domain.AdminService, logger logger.Logger) (*AdminHandler, error) {
+	loggerTag := "admin.handler.newAdminHandler"
+
+	logger.Info(loggerTag, "Admin handler initialized")
+
+	return &AdminHandler{
+		adminService: adminService,
+		logger:       logger,
+	}, nil
+}
+
+func (h *AdminHandler) GetAccessToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ErrMetadataNotProvided
+	}
+
+	authHeader, ok := md["authorization"]
+	if !ok || len(authHeader) == 0 {
+		return "", ErrHeaderNotProvided
+	}
+
+	if !strings.HasPrefix(authHeader[0], tokenPrefix) {
+		return "", ErrTokenInvalid
+	}
+
+	return strings.TrimPrefix(authHeader[0], tokenPrefix), nil
+}
+
+func adminError(err error) error {
+	switch {
+	case errors.Is(err, ErrTokenInvalid):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, ErrUserNotFound), errors.Is(err, ErrRoleNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrRoleNotAssigned), errors.Is(err, ErrUserBlocked):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrImpersonationRefused):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (h *AdminHandler) ListRoles(ctx context.Context, req *desc.ListRolesRequest) (*desc.ListRolesResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	roles, err := h.adminService.ListRoles(ctx, accessToken)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return &desc.ListRolesResponse{
+		Data: converters.RolesToDesc(roles),
+	}, nil
+}
+
+func (h *AdminHandler) ListUserRoles(ctx context.Context, req *desc.ListUserRolesRequest) (*desc.ListUserRolesResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	user, err := h.adminService.GetUser(ctx, req.UserId, accessToken)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return &desc.ListUserRolesResponse{
+		Roles:       user.Roles,
+		Permissions: user.Permissions,
+	}, nil
+}
+
+func (h *AdminHandler) AssignRole(ctx context.Context, req *desc.AssignRoleRequest) (*emptypb.Empty, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err := h.adminService.AssignRole(ctx, req.UserId, req.Role, accessToken); err != nil {
+		return nil, adminError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AdminHandler) RevokeRole(ctx context.Context, req *desc.RevokeRoleRequest) (*emptypb.Empty, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err := h.adminService.RevokeRole(ctx, req.UserId, req.Role, accessToken); err != nil {
+		return nil, adminError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AdminHandler) Impersonate(ctx context.Context, req *desc.ImpersonateRequest) (*desc.ImpersonateResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	impersonationToken, expiresIn, err := h.adminService.Impersonate(ctx, req.UserId, accessToken)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return &desc.ImpersonateResponse{
+		AccessToken: impersonationToken,
+		ExpiresIn:   int64(expiresIn.Seconds()),
+	}, nil
+}
@@ -1,10 +1,24 @@
 package handlers
 
-import "errors"
+import (
+	"errors"
+
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+)
 
 var (
 	ErrUserNotFound  = errors.New("user.not_found")
 	ErrPasswordWrong = errors.New("password.wrong")
-	ErrUserExists    = errors.New("user.exists")
-	ErrTokenInvalid  = errors.New("token.invalid")
+	ErrUserExists    = domainErrors.ErrUserExists
+	ErrUserBlocked   = domainErrors.ErrUserBlocked
+	ErrTokenInvalid  = domainErrors.ErrTokenInvalid
+
+	ErrProviderUnknown   = domainErrors.ErrProviderUnknown
+	ErrOAuthStateInvalid = domainErrors.ErrOAuthStateInvalid
+	ErrIdentityRejected  = domainErrors.ErrIdentityRejected
+	ErrIdentityExists    = domainErrors.ErrIdentityExists
+	ErrEmailNotVerified  = domainErrors.ErrEmailNotVerified
+
+	ErrMagicLinkInvalid = domainErrors.ErrMagicLinkInvalid
+	ErrPasskeyInvalid   = domainErrors.ErrPasskeyInvalid
 )
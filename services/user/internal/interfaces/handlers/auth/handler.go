@@ -51,6 +51,8 @@ func (h *AuthHandler) Login(ctx context.Context, req *desc.LoginRequest) (*desc.
 			return nil, status.Error(codes.NotFound, err.Error())
 		case errors.Is(err, ErrPasswordWrong):
 			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, ErrUserBlocked):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -60,7 +62,7 @@ func (h *AuthHandler) Login(ctx context.Context, req *desc.LoginRequest) (*desc.
 		"access_token", accessToken,
 		"refresh_token", refreshToken,
 	)); err != nil {
-		h.logger.Error(loggerTag, fmt.Sprintf("failed send header: %v", err))
+		h.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed send header: %v", err))
 
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -92,7 +94,7 @@ func (h *AuthHandler) Register(ctx context.Context, req *desc.RegisterRequest) (
 		"access_token", accessToken,
 		"refresh_token", refreshToken,
 	)); err != nil {
-		h.logger.Error(loggerTag, fmt.Sprintf("failed send header: %v", err))
+		h.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed send header: %v", err))
 
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -117,6 +119,8 @@ func (h *AuthHandler) RefreshToken(ctx context.Context, req *desc.RefreshTokenRe
 			return nil, status.Error(codes.NotFound, err.Error())
 		case errors.Is(err, ErrTokenInvalid):
 			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, ErrUserBlocked):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -125,7 +129,7 @@ func (h *AuthHandler) RefreshToken(ctx context.Context, req *desc.RefreshTokenRe
 	if err := grpc.SendHeader(ctx, metadata.Pairs(
 		"access_token", accessToken,
 	)); err != nil {
-		h.logger.Error(loggerTag, fmt.Sprintf("failed send header: %v", err))
+		h.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed send header: %v", err))
 
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -164,3 +168,188 @@ func (h *AuthHandler) Logout(ctx context.Context, req *emptypb.Empty) (*emptypb.
 
 	return &emptypb.Empty{}, nil
 }
+
+func (h *AuthHandler) OAuthAuthorize(ctx context.Context, req *desc.OAuthAuthorizeRequest) (*desc.OAuthAuthorizeResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// The access token is optional: a signed-in user links the identity to
+	// their account.
+	var accessToken string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if authHeader := md.Get("authorization"); len(authHeader) > 0 {
+			if !strings.HasPrefix(authHeader[0], tokenPrefix) {
+				return nil, status.Error(codes.Unauthenticated, "token.invalid")
+			}
+
+			accessToken = strings.TrimPrefix(authHeader[0], tokenPrefix)
+		}
+	}
+
+	authURL, browserNonce, err := h.authService.OAuthAuthorize(ctx, req.Provider, accessToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrProviderUnknown):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, ErrTokenInvalid):
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &desc.OAuthAuthorizeResponse{
+		AuthorizationUrl: authURL,
+		BrowserNonce:     browserNonce,
+	}, nil
+}
+
+func (h *AuthHandler) OAuthCallback(ctx context.Context, req *desc.OAuthCallbackRequest) (*desc.OAuthCallbackResponse, error) {
+	loggerTag := "auth.handler.oauthCallback"
+
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, accessToken, refreshToken, err := h.authService.OAuthLogin(ctx, req.Provider, req.Code, req.State, req.BrowserNonce)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrProviderUnknown):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, ErrOAuthStateInvalid), errors.Is(err, ErrIdentityRejected):
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, ErrEmailNotVerified):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, ErrUserBlocked):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, ErrUserExists), errors.Is(err, ErrIdentityExists):
+			return nil, status.Error(codes.Aborted, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if err := grpc.SendHeader(ctx, metadata.Pairs(
+		"access_token", accessToken,
+		"refresh_token", refreshToken,
+	)); err != nil {
+		h.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed send header: %v", err))
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.OAuthCallbackResponse{
+		Data:        converters.UserToDesc(user),
+		AccessToken: accessToken,
+	}, nil
+}
+
+func (h *AuthHandler) RequestMagicLink(ctx context.Context, req *desc.RequestMagicLinkRequest) (*desc.RequestMagicLinkResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	nonce, err := h.authService.RequestMagicLink(ctx, req.Email)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.RequestMagicLinkResponse{
+		Nonce: nonce,
+	}, nil
+}
+
+func (h *AuthHandler) ConsumeMagicLink(ctx context.Context, req *desc.ConsumeMagicLinkRequest) (*desc.ConsumeMagicLinkResponse, error) {
+	loggerTag := "auth.handler.consumeMagicLink"
+
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, accessToken, refreshToken, err := h.authService.ConsumeMagicLink(ctx, req.Token, req.Nonce)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrMagicLinkInvalid):
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, ErrUserBlocked):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if err := grpc.SendHeader(ctx, metadata.Pairs(
+		"access_token", accessToken,
+		"refresh_token", refreshToken,
+	)); err != nil {
+		h.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed send header: %v", err))
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.ConsumeMagicLinkResponse{
+		Data:        converters.UserToDesc(user),
+		AccessToken: accessToken,
+	}, nil
+}
+
+func (h *AuthHandler) BeginPasskeyLogin(ctx context.Context, req *desc.BeginPasskeyLoginRequest) (*desc.BeginPasskeyLoginResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ceremonyID, options, err := h.authService.BeginPasskeyLogin(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	optionsStruct, err := converters.JSONToStruct(options)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.BeginPasskeyLoginResponse{
+		CeremonyId: ceremonyID,
+		Options:    optionsStruct,
+	}, nil
+}
+
+func (h *AuthHandler) FinishPasskeyLogin(ctx context.Context, req *desc.FinishPasskeyLoginRequest) (*desc.FinishPasskeyLoginResponse, error) {
+	loggerTag := "auth.handler.finishPasskeyLogin"
+
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	credential, err := converters.StructToJSON(req.Credential)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, accessToken, refreshToken, err := h.authService.FinishPasskeyLogin(ctx, req.CeremonyId, credential)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrPasskeyInvalid):
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, ErrUserBlocked):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if err := grpc.SendHeader(ctx, metadata.Pairs(
+		"access_token", accessToken,
+		"refresh_token", refreshToken,
+	)); err != nil {
+		h.logger.ErrorCtx(ctx, loggerTag, fmt.Sprintf("failed send header: %v", err))
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.FinishPasskeyLoginResponse{
+		Data:        converters.UserToDesc(user),
+		AccessToken: accessToken,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"errors"
+
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+)
+
+var (
+	ErrMetadataNotProvided = errors.New("metadata.not_provided")
+	ErrHeaderNotProvided   = errors.New("header.not_provided")
+
+	ErrUserBlocked  = domainErrors.ErrUserBlocked
+	ErrTokenInvalid = domainErrors.ErrTokenInvalid
+
+	ErrClientInvalid        = domainErrors.ErrClientInvalid
+	ErrClientUnauthorized   = domainErrors.ErrClientUnauthorized
+	ErrGrantInvalid         = domainErrors.ErrGrantInvalid
+	ErrGrantTypeUnsupported = domainErrors.ErrGrantTypeUnsupported
+	ErrRedirectURIInvalid   = domainErrors.ErrRedirectURIInvalid
+	ErrScopeInvalid         = domainErrors.ErrScopeInvalid
+	ErrScopeInsufficient    = domainErrors.ErrScopeInsufficient
+)
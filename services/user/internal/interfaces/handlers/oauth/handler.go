@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/BlazeCoder04/online_store/libs/logger"
+	"github.com/BlazeCoder04/online_store/libs/validate"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+	desc "github.com/BlazeCoder04/online_store/services/user/pkg/oauth/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type OAuthHandler struct {
+	desc.UnimplementedOAuthV1Server
+	oauthService domain.OAuthService
+	logger       logger.Logger
+}
+
+const (
+	tokenPrefix = "Bearer "
+	tokenType   = "Bearer"
+)
+
+func NewOAuthHandler(oauthService domain.OAuthService, logger logger.Logger) (*OAuthHandler, error) {
+	loggerTag := "oauth.handler.newOAuthHandler"
+
+	logger.Info(loggerTag, "OAuth handler initialized")
+
+	return &OAuthHandler{
+		oauthService: oauthService,
+		logger:       logger,
+	}, nil
+}
+
+func (h *OAuthHandler) GetAccessToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ErrMetadataNotProvided
+	}
+
+	authHeader, ok := md["authorization"]
+	if !ok || len(authHeader) == 0 {
+		return "", ErrHeaderNotProvided
+	}
+
+	if !strings.HasPrefix(authHeader[0], tokenPrefix) {
+		return "", ErrTokenInvalid
+	}
+
+	return strings.TrimPrefix(authHeader[0], tokenPrefix), nil
+}
+
+// clientStatus maps the errors every client-authenticated RPC can return.
+func clientStatus(err error) error {
+	switch {
+	case errors.Is(err, ErrClientInvalid):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, ErrClientUnauthorized):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (h *OAuthHandler) Authorize(ctx context.Context, req *desc.AuthorizeRequest) (*desc.AuthorizeResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	redirectURL, err := h.oauthService.Authorize(ctx, &domain.AuthorizeArgs{
+		ClientID:      req.ClientId,
+		RedirectURI:   req.RedirectUri,
+		Scope:         req.Scope,
+		State:         req.State,
+		CodeChallenge: req.CodeChallenge,
+		AccessToken:   accessToken,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrTokenInvalid):
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, ErrScopeInsufficient), errors.Is(err, ErrUserBlocked):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, ErrClientInvalid):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, ErrRedirectURIInvalid), errors.Is(err, ErrScopeInvalid):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, clientStatus(err)
+		}
+	}
+
+	return &desc.AuthorizeResponse{
+		RedirectUrl: redirectURL,
+	}, nil
+}
+
+func (h *OAuthHandler) Token(ctx context.Context, req *desc.TokenRequest) (*desc.TokenResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	token, err := h.oauthService.Token(ctx, &domain.TokenArgs{
+		GrantType:    req.GrantType,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Code:         req.Code,
+		RedirectURI:  req.RedirectUri,
+		CodeVerifier: req.CodeVerifier,
+		RefreshToken: req.RefreshToken,
+		Scope:        req.Scope,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrGrantInvalid), errors.Is(err, ErrGrantTypeUnsupported), errors.Is(err, ErrScopeInvalid):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, ErrUserBlocked):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, clientStatus(err)
+		}
+	}
+
+	return &desc.TokenResponse{
+		AccessToken:  token.AccessToken,
+		TokenType:    tokenType,
+		ExpiresIn:    int64(token.ExpiresIn.Seconds()),
+		RefreshToken: token.RefreshToken,
+		Scope:        token.Scope,
+	}, nil
+}
+
+func (h *OAuthHandler) Revoke(ctx context.Context, req *desc.RevokeRequest) (*emptypb.Empty, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.oauthService.Revoke(ctx, req.ClientId, req.ClientSecret, req.Token); err != nil {
+		return nil, clientStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *OAuthHandler) Introspect(ctx context.Context, req *desc.IntrospectRequest) (*desc.IntrospectResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	introspection, err := h.oauthService.Introspect(ctx, req.ClientId, req.ClientSecret, req.Token)
+	if err != nil {
+		return nil, clientStatus(err)
+	}
+
+	if !introspection.Active {
+		return &desc.IntrospectResponse{}, nil
+	}
+
+	return &desc.IntrospectResponse{
+		Active:    true,
+		TokenType: introspection.TokenType,
+		ClientId:  introspection.ClientID,
+		Sub:       introspection.Subject,
+		Scope:     introspection.Scope,
+		Exp:       introspection.ExpiresAt.Unix(),
+	}, nil
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BlazeCoder04/online_store/libs/validate"
+	"github.com/BlazeCoder04/online_store/services/user/internal/interfaces/converters"
+	desc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func addressError(err error) error {
+	switch {
+	case errors.Is(err, ErrAddressNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrTokenInvalid):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, ErrAddressDefaultConflict):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (h *ProfileHandler) ListAddresses(ctx context.Context, req *desc.ListAddressesRequest) (*desc.ListAddressesResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	addresses, err := h.profileService.ListAddresses(ctx, req.UserId, accessToken)
+	if err != nil {
+		return nil, addressError(err)
+	}
+
+	return &desc.ListAddressesResponse{
+		Data: converters.AddressesToDesc(addresses),
+	}, nil
+}
+
+func (h *ProfileHandler) GetAddress(ctx context.Context, req *desc.GetAddressRequest) (*desc.GetAddressResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	address, err := h.profileService.GetAddress(ctx, req.UserId, req.AddressId, accessToken)
+	if err != nil {
+		return nil, addressError(err)
+	}
+
+	return &desc.GetAddressResponse{
+		Data: converters.AddressToDesc(address),
+	}, nil
+}
+
+func (h *ProfileHandler) CreateAddress(ctx context.Context, req *desc.CreateAddressRequest) (*desc.CreateAddressResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	address, err := h.profileService.CreateAddress(ctx, converters.AddressFromDesc(req.UserId, "", req.Address), accessToken)
+	if err != nil {
+		return nil, addressError(err)
+	}
+
+	return &desc.CreateAddressResponse{
+		Data: converters.AddressToDesc(address),
+	}, nil
+}
+
+func (h *ProfileHandler) UpdateAddress(ctx context.Context, req *desc.UpdateAddressRequest) (*desc.UpdateAddressResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	address, err := h.profileService.UpdateAddress(ctx, converters.AddressFromDesc(req.UserId, req.AddressId, req.Address), accessToken)
+	if err != nil {
+		return nil, addressError(err)
+	}
+
+	return &desc.UpdateAddressResponse{
+		Data: converters.AddressToDesc(address),
+	}, nil
+}
+
+func (h *ProfileHandler) DeleteAddress(ctx context.Context, req *desc.DeleteAddressRequest) (*emptypb.Empty, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err := h.profileService.DeleteAddress(ctx, req.UserId, req.AddressId, accessToken); err != nil {
+		return nil, addressError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/BlazeCoder04/online_store/libs/validate"
+	domain "github.com/BlazeCoder04/online_store/services/user/internal/domain/ports/services"
+	"github.com/BlazeCoder04/online_store/services/user/internal/interfaces/converters"
+	desc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func apiKeyError(err error) error {
+	switch {
+	case errors.Is(err, ErrAPIKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrScopeInvalid), errors.Is(err, ErrAPIKeyExpiryInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrTokenInvalid):
+		return status.Error(codes.Unauthenticated, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (h *ProfileHandler) CreateApiKey(ctx context.Context, req *desc.CreateApiKeyRequest) (*desc.CreateApiKeyResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+
+	apiKey, key, err := h.profileService.CreateAPIKey(ctx, &domain.CreateAPIKeyArgs{
+		UserID:      req.UserId,
+		Name:        req.Name,
+		Scopes:      req.Scopes,
+		ExpiresAt:   expiresAt,
+		AccessToken: accessToken,
+	})
+	if err != nil {
+		return nil, apiKeyError(err)
+	}
+
+	return &desc.CreateApiKeyResponse{
+		Data: converters.APIKeyToDesc(apiKey),
+		Key:  key,
+	}, nil
+}
+
+func (h *ProfileHandler) ListApiKeys(ctx context.Context, req *desc.ListApiKeysRequest) (*desc.ListApiKeysResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	apiKeys, err := h.profileService.ListAPIKeys(ctx, req.UserId, accessToken)
+	if err != nil {
+		return nil, apiKeyError(err)
+	}
+
+	return &desc.ListApiKeysResponse{
+		Data: converters.APIKeysToDesc(apiKeys),
+	}, nil
+}
+
+func (h *ProfileHandler) RevokeApiKey(ctx context.Context, req *desc.RevokeApiKeyRequest) (*desc.RevokeApiKeyResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	apiKey, err := h.profileService.RevokeAPIKey(ctx, req.UserId, req.ApiKeyId, accessToken)
+	if err != nil {
+		return nil, apiKeyError(err)
+	}
+
+	return &desc.RevokeApiKeyResponse{
+		Data: converters.APIKeyToDesc(apiKey),
+	}, nil
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+
+	"github.com/BlazeCoder04/online_store/libs/validate"
+	"github.com/BlazeCoder04/online_store/services/user/internal/interfaces/converters"
+	desc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func avatarError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, ErrUserNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrTokenInvalid):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, ErrAvatarContentType), errors.Is(err, ErrAvatarInvalid), errors.Is(err, ErrAvatarInfoRepeated):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrAvatarTooLarge):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// avatarReader turns the chunk messages that follow the info message of an
+// upload into a stream of bytes.
+type avatarReader struct {
+	stream desc.ProfileV1_UploadAvatarServer
+	chunk  []byte
+}
+
+func (r *avatarReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		if err = validate.ValidateRequest(req); err != nil {
+			return 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if req.GetInfo() != nil {
+			return 0, ErrAvatarInfoRepeated
+		}
+
+		r.chunk = req.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+
+	return n, nil
+}
+
+func (h *ProfileHandler) UploadAvatar(stream desc.ProfileV1_UploadAvatarServer) error {
+	ctx := stream.Context()
+
+	req, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, ErrAvatarInfoNotProvided.Error())
+		}
+
+		return err
+	}
+
+	if err = validate.ValidateRequest(req); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	info := req.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, ErrAvatarInfoNotProvided.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	user, err := h.profileService.UploadAvatar(ctx, info.UserId, info.ContentType, &avatarReader{stream: stream}, accessToken)
+	if err != nil {
+		return avatarError(err)
+	}
+
+	return stream.SendAndClose(&desc.UploadAvatarResponse{
+		Data: converters.UserToDesc(user),
+	})
+}
@@ -1,6 +1,10 @@
 package handlers
 
-import "errors"
+import (
+	"errors"
+
+	domainErrors "github.com/BlazeCoder04/online_store/services/user/internal/domain/errors"
+)
 
 var (
 	ErrUserNotFound        = errors.New("user.not_found")
@@ -8,4 +12,30 @@ var (
 	ErrMetadataNotProvided = errors.New("metadata.not_provided")
 	ErrHeaderNotProvided   = errors.New("header.not_provided")
 	ErrTokenInvalid        = errors.New("token.invalid")
+
+	ErrAvatarInfoNotProvided = errors.New("avatar.info_not_provided")
+	ErrAvatarInfoRepeated    = errors.New("avatar.info_repeated")
+
+	ErrPhoneInvalid        = domainErrors.ErrPhoneInvalid
+	ErrPhoneExists         = domainErrors.ErrPhoneExists
+	ErrOTPNotFound         = domainErrors.ErrOTPNotFound
+	ErrOTPInvalid          = domainErrors.ErrOTPInvalid
+	ErrOTPAttemptsExceeded = domainErrors.ErrOTPAttemptsExceeded
+	ErrOTPResendTooSoon    = domainErrors.ErrOTPResendTooSoon
+
+	ErrAvatarTooLarge    = domainErrors.ErrAvatarTooLarge
+	ErrAvatarContentType = domainErrors.ErrAvatarContentType
+	ErrAvatarInvalid     = domainErrors.ErrAvatarInvalid
+
+	ErrAccessDenied           = domainErrors.ErrAccessDenied
+	ErrAddressNotFound        = domainErrors.ErrAddressNotFound
+	ErrAddressDefaultConflict = domainErrors.ErrAddressDefaultConflict
+
+	ErrPasskeyInvalid  = domainErrors.ErrPasskeyInvalid
+	ErrPasskeyExists   = domainErrors.ErrPasskeyExists
+	ErrPasskeyNotFound = domainErrors.ErrPasskeyNotFound
+
+	ErrScopeInvalid        = domainErrors.ErrScopeInvalid
+	ErrAPIKeyNotFound      = domainErrors.ErrAPIKeyNotFound
+	ErrAPIKeyExpiryInvalid = domainErrors.ErrAPIKeyExpiryInvalid
 )
@@ -22,7 +22,10 @@ type ProfileHandler struct {
 	logger         logger.Logger
 }
 
-const tokenPrefix = "Bearer "
+const (
+	tokenPrefix  = "Bearer "
+	apiKeyPrefix = "ApiKey "
+)
 
 func NewProfileHandler(profileService domain.ProfileService, logger logger.Logger) (*ProfileHandler, error) {
 	loggerTag := "profile.handler.newAuthHandler"
@@ -35,6 +38,8 @@ func NewProfileHandler(profileService domain.ProfileService, logger logger.Logge
 	}, nil
 }
 
+// GetAccessToken returns no token for a request made with an API key, which
+// the authn interceptor checked already.
 func (h *ProfileHandler) GetAccessToken(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -46,6 +51,10 @@ func (h *ProfileHandler) GetAccessToken(ctx context.Context) (string, error) {
 		return "", ErrHeaderNotProvided
 	}
 
+	if strings.HasPrefix(authHeader[0], apiKeyPrefix) {
+		return "", nil
+	}
+
 	if !strings.HasPrefix(authHeader[0], tokenPrefix) {
 		return "", ErrTokenInvalid
 	}
@@ -74,6 +83,8 @@ func (h *ProfileHandler) Get(ctx context.Context, req *desc.GetRequest) (*desc.G
 			return nil, status.Error(codes.Unauthenticated, err.Error())
 		case errors.Is(err, ErrTokenInvalid):
 			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, ErrAccessDenied):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, err.Error())
 		}
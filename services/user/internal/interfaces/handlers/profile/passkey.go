@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BlazeCoder04/online_store/libs/validate"
+	"github.com/BlazeCoder04/online_store/services/user/internal/interfaces/converters"
+	desc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func passkeyError(err error) error {
+	switch {
+	case errors.Is(err, ErrPasskeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrPasskeyInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrPasskeyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrTokenInvalid):
+		return status.Error(codes.Unauthenticated, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (h *ProfileHandler) BeginPasskeyRegistration(ctx context.Context, req *desc.BeginPasskeyRegistrationRequest) (*desc.BeginPasskeyRegistrationResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	ceremonyID, options, err := h.profileService.BeginPasskeyRegistration(ctx, req.UserId, accessToken)
+	if err != nil {
+		return nil, passkeyError(err)
+	}
+
+	optionsStruct, err := converters.JSONToStruct(options)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.BeginPasskeyRegistrationResponse{
+		CeremonyId: ceremonyID,
+		Options:    optionsStruct,
+	}, nil
+}
+
+func (h *ProfileHandler) FinishPasskeyRegistration(ctx context.Context, req *desc.FinishPasskeyRegistrationRequest) (*desc.FinishPasskeyRegistrationResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	credential, err := converters.StructToJSON(req.Credential)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	passkey, err := h.profileService.FinishPasskeyRegistration(ctx, req.UserId, req.CeremonyId, req.Name, credential, accessToken)
+	if err != nil {
+		return nil, passkeyError(err)
+	}
+
+	return &desc.FinishPasskeyRegistrationResponse{
+		Data: converters.PasskeyToDesc(passkey),
+	}, nil
+}
+
+func (h *ProfileHandler) ListPasskeys(ctx context.Context, req *desc.ListPasskeysRequest) (*desc.ListPasskeysResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	passkeys, err := h.profileService.ListPasskeys(ctx, req.UserId, accessToken)
+	if err != nil {
+		return nil, passkeyError(err)
+	}
+
+	return &desc.ListPasskeysResponse{
+		Data: converters.PasskeysToDesc(passkeys),
+	}, nil
+}
+
+func (h *ProfileHandler) RenamePasskey(ctx context.Context, req *desc.RenamePasskeyRequest) (*desc.RenamePasskeyResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	passkey, err := h.profileService.RenamePasskey(ctx, req.UserId, req.PasskeyId, req.Name, accessToken)
+	if err != nil {
+		return nil, passkeyError(err)
+	}
+
+	return &desc.RenamePasskeyResponse{
+		Data: converters.PasskeyToDesc(passkey),
+	}, nil
+}
+
+func (h *ProfileHandler) DeletePasskey(ctx context.Context, req *desc.DeletePasskeyRequest) (*emptypb.Empty, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err := h.profileService.DeletePasskey(ctx, req.UserId, req.PasskeyId, accessToken); err != nil {
+		return nil, passkeyError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
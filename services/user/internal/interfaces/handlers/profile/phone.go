@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BlazeCoder04/online_store/libs/validate"
+	"github.com/BlazeCoder04/online_store/services/user/internal/interfaces/converters"
+	desc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func phoneError(err error) error {
+	switch {
+	case errors.Is(err, ErrUserNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrTokenInvalid):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, ErrPhoneInvalid), errors.Is(err, ErrOTPInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrPhoneExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, ErrOTPNotFound):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrOTPAttemptsExceeded), errors.Is(err, ErrOTPResendTooSoon):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (h *ProfileHandler) SetPhone(ctx context.Context, req *desc.SetPhoneRequest) (*desc.SetPhoneResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	user, err := h.profileService.SetPhone(ctx, req.UserId, req.Phone, accessToken)
+	if err != nil {
+		return nil, phoneError(err)
+	}
+
+	return &desc.SetPhoneResponse{
+		Data: converters.UserToDesc(user),
+	}, nil
+}
+
+func (h *ProfileHandler) VerifyPhone(ctx context.Context, req *desc.VerifyPhoneRequest) (*desc.VerifyPhoneResponse, error) {
+	if err := validate.ValidateRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessToken, err := h.GetAccessToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	user, err := h.profileService.VerifyPhone(ctx, req.UserId, req.Code, accessToken)
+	if err != nil {
+		return nil, phoneError(err)
+	}
+
+	return &desc.VerifyPhoneResponse{
+		Data: converters.UserToDesc(user),
+	}, nil
+}
@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/BlazeCoder04/online_store/libs/validate"
+	desc "github.com/BlazeCoder04/online_store/services/user/pkg/profile/v1"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAddressRequest_Validate(t *testing.T) {
+	address := func(countryCode, region, postalCode string) *desc.AddressInput {
+		return &desc.AddressInput{
+			Recipient:   "Jane Doe",
+			Line1:       "1 Main Street",
+			City:        "Springfield",
+			Region:      region,
+			PostalCode:  postalCode,
+			CountryCode: countryCode,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		address *desc.AddressInput
+		valid   bool
+	}{
+		{
+			name:    "us zip case",
+			address: address("US", "IL", "62704"),
+			valid:   true,
+		},
+		{
+			name:    "us zip plus four case",
+			address: address("US", "IL", "62704-1234"),
+			valid:   true,
+		},
+		{
+			name:    "us invalid zip case",
+			address: address("US", "IL", "6270"),
+			valid:   false,
+		},
+		{
+			name:    "us missing state case",
+			address: address("US", "", "62704"),
+			valid:   false,
+		},
+		{
+			name:    "canada postal code case",
+			address: address("CA", "ON", "K1A 0B1"),
+			valid:   true,
+		},
+		{
+			name:    "uk postcode case",
+			address: address("GB", "", "SW1A 1AA"),
+			valid:   true,
+		},
+		{
+			name:    "germany invalid postal code case",
+			address: address("DE", "", "1011"),
+			valid:   false,
+		},
+		{
+			name:    "russia postal code case",
+			address: address("RU", "", "101000"),
+			valid:   true,
+		},
+		{
+			name:    "no postal code country case",
+			address: address("HK", "", ""),
+			valid:   true,
+		},
+		{
+			name:    "missing postal code case",
+			address: address("SE", "", ""),
+			valid:   false,
+		},
+		{
+			name:    "lower case country case",
+			address: address("us", "IL", "62704"),
+			valid:   false,
+		},
+		{
+			name: "invalid phone case",
+			address: func() *desc.AddressInput {
+				a := address("FR", "", "75001")
+				a.Phone = "0612345678"
+
+				return a
+			}(),
+			valid: false,
+		},
+		{
+			name: "e164 phone case",
+			address: func() *desc.AddressInput {
+				a := address("FR", "", "75001")
+				a.Phone = "+33612345678"
+
+				return a
+			}(),
+			valid: true,
+		},
+		{
+			name:    "missing address case",
+			address: nil,
+			valid:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validate.ValidateRequest(&desc.CreateAddressRequest{
+				UserId:  uuid.NewString(),
+				Address: tt.address,
+			})
+
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS addresses (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	label TEXT NOT NULL DEFAULT '',
+	recipient TEXT NOT NULL,
+	line1 TEXT NOT NULL,
+	line2 TEXT NOT NULL DEFAULT '',
+	city TEXT NOT NULL,
+	region TEXT NOT NULL DEFAULT '',
+	postal_code TEXT NOT NULL DEFAULT '',
+	country_code CHAR(2) NOT NULL,
+	phone TEXT NOT NULL DEFAULT '',
+	default_shipping BOOLEAN NOT NULL DEFAULT FALSE,
+	default_billing BOOLEAN NOT NULL DEFAULT FALSE,
+	created_at TIMESTAMP DEFAULT NOW(),
+	updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_addresses_user_id ON addresses (user_id);
+
+-- At most one default of each kind per user.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_addresses_default_shipping ON addresses (user_id) WHERE default_shipping;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_addresses_default_billing ON addresses (user_id) WHERE default_billing;
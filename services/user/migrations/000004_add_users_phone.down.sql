@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_users_verified_phone;
+
+ALTER TABLE users DROP COLUMN IF EXISTS phone_verified;
+ALTER TABLE users DROP COLUMN IF EXISTS phone;
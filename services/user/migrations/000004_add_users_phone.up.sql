@@ -0,0 +1,5 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS phone TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS phone_verified BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- A number may be pending on several accounts, but verified on one only.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_verified_phone ON users (phone) WHERE phone_verified;
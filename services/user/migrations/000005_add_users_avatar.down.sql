@@ -0,0 +1,2 @@
+ALTER TABLE users DROP COLUMN IF EXISTS avatar_urls;
+ALTER TABLE users DROP COLUMN IF EXISTS avatar_key;
@@ -0,0 +1,4 @@
+-- avatar_key is the storage prefix of the current avatar files, avatar_urls
+-- maps every thumbnail size, in pixels, to its URL.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS avatar_key TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS avatar_urls JSONB NOT NULL DEFAULT '{}';
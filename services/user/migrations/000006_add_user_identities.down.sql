@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS user_identities;
+
+-- An empty hash matches no password, so social-only users stay locked out
+-- of password login.
+UPDATE users SET password = '' WHERE password IS NULL;
+ALTER TABLE users ALTER COLUMN password SET NOT NULL;
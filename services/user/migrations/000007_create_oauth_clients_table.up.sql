@@ -0,0 +1,11 @@
+-- Public clients, such as the mobile app, cannot keep a secret and have no
+-- secret_hash; they must use PKCE.
+CREATE TABLE IF NOT EXISTS oauth_clients (
+	id TEXT PRIMARY KEY,
+	secret_hash TEXT,
+	name TEXT NOT NULL,
+	redirect_uris TEXT[] NOT NULL DEFAULT '{}',
+	grant_types TEXT[] NOT NULL DEFAULT '{}',
+	scopes TEXT[] NOT NULL DEFAULT '{}',
+	created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
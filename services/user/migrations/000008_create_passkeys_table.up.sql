@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS passkeys (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	credential_id BYTEA NOT NULL UNIQUE,
+	-- public_key is the COSE-encoded credential public key.
+	public_key BYTEA NOT NULL,
+	attestation_type TEXT NOT NULL DEFAULT '',
+	transports TEXT[] NOT NULL DEFAULT '{}',
+	aaguid UUID NOT NULL,
+	sign_count BIGINT NOT NULL DEFAULT 0,
+	backup_eligible BOOLEAN NOT NULL DEFAULT FALSE,
+	backup_state BOOLEAN NOT NULL DEFAULT FALSE,
+	created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+	last_used_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_passkeys_user_id ON passkeys (user_id);
@@ -0,0 +1,20 @@
+-- An API key belongs to either a user or a service, such as the warehouse
+-- scripts. Only the SHA-256 of its secret is kept; the prefix is public and
+-- finds the key.
+CREATE TABLE IF NOT EXISTS api_keys (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID REFERENCES users (id) ON DELETE CASCADE,
+	service TEXT,
+	name TEXT NOT NULL,
+	prefix TEXT NOT NULL UNIQUE,
+	secret_hash TEXT NOT NULL,
+	scopes TEXT[] NOT NULL DEFAULT '{}',
+	expires_at TIMESTAMP,
+	last_used_at TIMESTAMP,
+	revoked BOOLEAN NOT NULL DEFAULT FALSE,
+	created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+	CHECK ((user_id IS NULL) <> (service IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys (user_id);
+CREATE INDEX IF NOT EXISTS idx_api_keys_service ON api_keys (service);
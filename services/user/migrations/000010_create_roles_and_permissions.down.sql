@@ -0,0 +1,20 @@
+-- Only the admin role survives the way back; every other user is a USER.
+DO $$
+BEGIN
+	IF NOT EXISTS (
+		SELECT 1 FROM pg_type WHERE typname = 'user_role'
+	) THEN
+		CREATE TYPE user_role AS ENUM ('USER', 'ADMIN');
+	END IF;
+END $$;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role user_role NOT NULL DEFAULT 'USER';
+
+UPDATE users
+SET role = 'ADMIN'
+WHERE id IN (SELECT user_id FROM user_roles WHERE role = 'admin');
+
+DROP TABLE IF EXISTS user_roles;
+DROP TABLE IF EXISTS role_permissions;
+DROP TABLE IF EXISTS roles;
+DROP TABLE IF EXISTS permissions;
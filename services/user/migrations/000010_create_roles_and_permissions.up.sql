@@ -0,0 +1,73 @@
+-- Roles and permissions replace the user_role enum. A user may hold several
+-- roles and gets every permission any of them grants. Roles are managed
+-- through migrations, assignments through the admin API.
+CREATE TABLE IF NOT EXISTS permissions (
+	name TEXT PRIMARY KEY,
+	description TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS roles (
+	name TEXT PRIMARY KEY,
+	description TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+	role TEXT NOT NULL REFERENCES roles (name) ON DELETE CASCADE,
+	permission TEXT NOT NULL REFERENCES permissions (name) ON DELETE CASCADE,
+	PRIMARY KEY (role, permission)
+);
+
+CREATE TABLE IF NOT EXISTS user_roles (
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	role TEXT NOT NULL REFERENCES roles (name) ON DELETE CASCADE,
+	created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (user_id, role)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_roles_role ON user_roles (role);
+
+INSERT INTO permissions (name, description) VALUES
+	('users:read', 'Read the profile and roles of any user'),
+	('users:block', 'Block and unblock users'),
+	('roles:read', 'List roles and the roles of users'),
+	('roles:assign', 'Assign roles to users and revoke them'),
+	('catalog:write', 'Manage own products and offers'),
+	('orders:read', 'Read orders of any customer'),
+	('orders:refund', 'Refund orders'),
+	('inventory:write', 'Update stock levels and shipments'),
+	('payouts:read', 'Read seller payouts and invoices')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO roles (name, description) VALUES
+	('customer', 'Shops in the store; every new user has it'),
+	('seller', 'Sells products through the store'),
+	('support', 'Helps customers with their accounts and orders'),
+	('warehouse', 'Ships orders and keeps stock'),
+	('finance', 'Handles refunds and payouts'),
+	('admin', 'Runs the store')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+	('seller', 'catalog:write'),
+	('support', 'users:read'),
+	('support', 'users:block'),
+	('support', 'orders:read'),
+	('warehouse', 'orders:read'),
+	('warehouse', 'inventory:write'),
+	('finance', 'orders:read'),
+	('finance', 'orders:refund'),
+	('finance', 'payouts:read')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role, permission)
+SELECT 'admin', name FROM permissions
+ON CONFLICT DO NOTHING;
+
+INSERT INTO user_roles (user_id, role)
+SELECT id, CASE role WHEN 'ADMIN' THEN 'admin' ELSE 'customer' END
+FROM users
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE users DROP COLUMN IF EXISTS role;
+
+DROP TYPE IF EXISTS user_role;
@@ -0,0 +1,2 @@
+-- role_permissions cascade.
+DELETE FROM permissions WHERE name = 'users:impersonate';
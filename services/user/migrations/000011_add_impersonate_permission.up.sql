@@ -0,0 +1,10 @@
+-- Support impersonates customers to see the store as they do; admins can as
+-- well. The admin service refuses targets with any other role.
+INSERT INTO permissions (name, description) VALUES
+	('users:impersonate', 'Act as another user with a short-lived token')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+	('admin', 'users:impersonate'),
+	('support', 'users:impersonate')
+ON CONFLICT DO NOTHING;
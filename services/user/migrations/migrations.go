@@ -0,0 +1,8 @@
+// Package migrations embeds the SQL migrations into the binary, so they run
+// the same way whatever the working directory is.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,290 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: admin/v1/admin.proto
+
+package admin_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminV1_ListRoles_FullMethodName     = "/admin_v1.AdminV1/ListRoles"
+	AdminV1_ListUserRoles_FullMethodName = "/admin_v1.AdminV1/ListUserRoles"
+	AdminV1_AssignRole_FullMethodName    = "/admin_v1.AdminV1/AssignRole"
+	AdminV1_RevokeRole_FullMethodName    = "/admin_v1.AdminV1/RevokeRole"
+	AdminV1_Impersonate_FullMethodName   = "/admin_v1.AdminV1/Impersonate"
+)
+
+// AdminV1Client is the client API for AdminV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminV1 manages the roles of users and lets admins act as them. Every RPC
+// requires a permission of the caller, and refuses impersonation tokens.
+type AdminV1Client interface {
+	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	ListUserRoles(ctx context.Context, in *ListUserRolesRequest, opts ...grpc.CallOption) (*ListUserRolesResponse, error)
+	// AssignRole succeeds when the user already has the role.
+	AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Impersonate issues a short-lived access token acting as the user, with
+	// the caller as actor. No refresh token is issued; the token stops working
+	// once the caller logs out. Methods such as Delete refuse it. Only
+	// customers without further roles can be impersonated.
+	Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*ImpersonateResponse, error)
+}
+
+type adminV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminV1Client(cc grpc.ClientConnInterface) AdminV1Client {
+	return &adminV1Client{cc}
+}
+
+func (c *adminV1Client) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, AdminV1_ListRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) ListUserRoles(ctx context.Context, in *ListUserRolesRequest, opts ...grpc.CallOption) (*ListUserRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserRolesResponse)
+	err := c.cc.Invoke(ctx, AdminV1_ListUserRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminV1_AssignRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminV1_RevokeRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*ImpersonateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonateResponse)
+	err := c.cc.Invoke(ctx, AdminV1_Impersonate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminV1Server is the server API for AdminV1 service.
+// All implementations must embed UnimplementedAdminV1Server
+// for forward compatibility.
+//
+// AdminV1 manages the roles of users and lets admins act as them. Every RPC
+// requires a permission of the caller, and refuses impersonation tokens.
+type AdminV1Server interface {
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	ListUserRoles(context.Context, *ListUserRolesRequest) (*ListUserRolesResponse, error)
+	// AssignRole succeeds when the user already has the role.
+	AssignRole(context.Context, *AssignRoleRequest) (*emptypb.Empty, error)
+	RevokeRole(context.Context, *RevokeRoleRequest) (*emptypb.Empty, error)
+	// Impersonate issues a short-lived access token acting as the user, with
+	// the caller as actor. No refresh token is issued; the token stops working
+	// once the caller logs out. Methods such as Delete refuse it. Only
+	// customers without further roles can be impersonated.
+	Impersonate(context.Context, *ImpersonateRequest) (*ImpersonateResponse, error)
+	mustEmbedUnimplementedAdminV1Server()
+}
+
+// UnimplementedAdminV1Server must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminV1Server struct{}
+
+func (UnimplementedAdminV1Server) ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedAdminV1Server) ListUserRoles(context.Context, *ListUserRolesRequest) (*ListUserRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserRoles not implemented")
+}
+func (UnimplementedAdminV1Server) AssignRole(context.Context, *AssignRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRole not implemented")
+}
+func (UnimplementedAdminV1Server) RevokeRole(context.Context, *RevokeRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (UnimplementedAdminV1Server) Impersonate(context.Context, *ImpersonateRequest) (*ImpersonateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Impersonate not implemented")
+}
+func (UnimplementedAdminV1Server) mustEmbedUnimplementedAdminV1Server() {}
+func (UnimplementedAdminV1Server) testEmbeddedByValue()                 {}
+
+// UnsafeAdminV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminV1Server will
+// result in compilation errors.
+type UnsafeAdminV1Server interface {
+	mustEmbedUnimplementedAdminV1Server()
+}
+
+func RegisterAdminV1Server(s grpc.ServiceRegistrar, srv AdminV1Server) {
+	// If the following call pancis, it indicates UnimplementedAdminV1Server was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminV1_ServiceDesc, srv)
+}
+
+func _AdminV1_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminV1_ListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).ListRoles(ctx, req.(*ListRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_ListUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).ListUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminV1_ListUserRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).ListUserRoles(ctx, req.(*ListUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_AssignRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).AssignRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminV1_AssignRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).AssignRole(ctx, req.(*AssignRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminV1_RevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).RevokeRole(ctx, req.(*RevokeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_Impersonate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).Impersonate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminV1_Impersonate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).Impersonate(ctx, req.(*ImpersonateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminV1_ServiceDesc is the grpc.ServiceDesc for AdminV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "admin_v1.AdminV1",
+	HandlerType: (*AdminV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRoles",
+			Handler:    _AdminV1_ListRoles_Handler,
+		},
+		{
+			MethodName: "ListUserRoles",
+			Handler:    _AdminV1_ListUserRoles_Handler,
+		},
+		{
+			MethodName: "AssignRole",
+			Handler:    _AdminV1_AssignRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _AdminV1_RevokeRole_Handler,
+		},
+		{
+			MethodName: "Impersonate",
+			Handler:    _AdminV1_Impersonate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin/v1/admin.proto",
+}
@@ -13,6 +13,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -341,11 +342,618 @@ func (x *RefreshTokenResponse) GetAccessToken() string {
 	return ""
 }
 
+// OAuthAuthorize
+type OAuthAuthorizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthAuthorizeRequest) Reset() {
+	*x = OAuthAuthorizeRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthAuthorizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthAuthorizeRequest) ProtoMessage() {}
+
+func (x *OAuthAuthorizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthAuthorizeRequest.ProtoReflect.Descriptor instead.
+func (*OAuthAuthorizeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OAuthAuthorizeRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type OAuthAuthorizeResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	BrowserNonce     string                 `protobuf:"bytes,2,opt,name=browser_nonce,json=browserNonce,proto3" json:"browser_nonce,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OAuthAuthorizeResponse) Reset() {
+	*x = OAuthAuthorizeResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthAuthorizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthAuthorizeResponse) ProtoMessage() {}
+
+func (x *OAuthAuthorizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthAuthorizeResponse.ProtoReflect.Descriptor instead.
+func (*OAuthAuthorizeResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OAuthAuthorizeResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+func (x *OAuthAuthorizeResponse) GetBrowserNonce() string {
+	if x != nil {
+		return x.BrowserNonce
+	}
+	return ""
+}
+
+// OAuthCallback
+type OAuthCallbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	BrowserNonce  string                 `protobuf:"bytes,4,opt,name=browser_nonce,json=browserNonce,proto3" json:"browser_nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthCallbackRequest) Reset() {
+	*x = OAuthCallbackRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthCallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthCallbackRequest) ProtoMessage() {}
+
+func (x *OAuthCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthCallbackRequest.ProtoReflect.Descriptor instead.
+func (*OAuthCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OAuthCallbackRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *OAuthCallbackRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OAuthCallbackRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OAuthCallbackRequest) GetBrowserNonce() string {
+	if x != nil {
+		return x.BrowserNonce
+	}
+	return ""
+}
+
+type OAuthCallbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *user.User             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthCallbackResponse) Reset() {
+	*x = OAuthCallbackResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthCallbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthCallbackResponse) ProtoMessage() {}
+
+func (x *OAuthCallbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthCallbackResponse.ProtoReflect.Descriptor instead.
+func (*OAuthCallbackResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OAuthCallbackResponse) GetData() *user.User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *OAuthCallbackResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+// RequestMagicLink
+type RequestMagicLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestMagicLinkRequest) Reset() {
+	*x = RequestMagicLinkRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestMagicLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMagicLinkRequest) ProtoMessage() {}
+
+func (x *RequestMagicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestMagicLinkRequest.ProtoReflect.Descriptor instead.
+func (*RequestMagicLinkRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RequestMagicLinkRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RequestMagicLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nonce         string                 `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestMagicLinkResponse) Reset() {
+	*x = RequestMagicLinkResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestMagicLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMagicLinkResponse) ProtoMessage() {}
+
+func (x *RequestMagicLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestMagicLinkResponse.ProtoReflect.Descriptor instead.
+func (*RequestMagicLinkResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RequestMagicLinkResponse) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+// ConsumeMagicLink
+type ConsumeMagicLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Nonce         string                 `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeMagicLinkRequest) Reset() {
+	*x = ConsumeMagicLinkRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeMagicLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeMagicLinkRequest) ProtoMessage() {}
+
+func (x *ConsumeMagicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeMagicLinkRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeMagicLinkRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConsumeMagicLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConsumeMagicLinkRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type ConsumeMagicLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *user.User             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeMagicLinkResponse) Reset() {
+	*x = ConsumeMagicLinkResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeMagicLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeMagicLinkResponse) ProtoMessage() {}
+
+func (x *ConsumeMagicLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeMagicLinkResponse.ProtoReflect.Descriptor instead.
+func (*ConsumeMagicLinkResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConsumeMagicLinkResponse) GetData() *user.User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ConsumeMagicLinkResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+// BeginPasskeyLogin
+type BeginPasskeyLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyLoginRequest) Reset() {
+	*x = BeginPasskeyLoginRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyLoginRequest) ProtoMessage() {}
+
+func (x *BeginPasskeyLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyLoginRequest.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyLoginRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{14}
+}
+
+type BeginPasskeyLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CeremonyId    string                 `protobuf:"bytes,1,opt,name=ceremony_id,json=ceremonyId,proto3" json:"ceremony_id,omitempty"`
+	Options       *structpb.Struct       `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyLoginResponse) Reset() {
+	*x = BeginPasskeyLoginResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyLoginResponse) ProtoMessage() {}
+
+func (x *BeginPasskeyLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyLoginResponse.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyLoginResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BeginPasskeyLoginResponse) GetCeremonyId() string {
+	if x != nil {
+		return x.CeremonyId
+	}
+	return ""
+}
+
+func (x *BeginPasskeyLoginResponse) GetOptions() *structpb.Struct {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// FinishPasskeyLogin
+type FinishPasskeyLoginRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CeremonyId string                 `protobuf:"bytes,1,opt,name=ceremony_id,json=ceremonyId,proto3" json:"ceremony_id,omitempty"`
+	// credential is the PublicKeyCredential as encoded by its toJSON().
+	Credential    *structpb.Struct `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyLoginRequest) Reset() {
+	*x = FinishPasskeyLoginRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyLoginRequest) ProtoMessage() {}
+
+func (x *FinishPasskeyLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPasskeyLoginRequest.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyLoginRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FinishPasskeyLoginRequest) GetCeremonyId() string {
+	if x != nil {
+		return x.CeremonyId
+	}
+	return ""
+}
+
+func (x *FinishPasskeyLoginRequest) GetCredential() *structpb.Struct {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+type FinishPasskeyLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *user.User             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyLoginResponse) Reset() {
+	*x = FinishPasskeyLoginResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyLoginResponse) ProtoMessage() {}
+
+func (x *FinishPasskeyLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPasskeyLoginResponse.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyLoginResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FinishPasskeyLoginResponse) GetData() *user.User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FinishPasskeyLoginResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
 var File_auth_v1_auth_proto protoreflect.FileDescriptor
 
 const file_auth_v1_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x12auth/v1/auth.proto\x12\aauth_v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0fuser/user.proto\"R\n" +
+	"\x12auth/v1/auth.proto\x12\aauth_v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0fuser/user.proto\"R\n" +
 	"\fLoginRequest\x12\x1d\n" +
 	"\x05email\x18\x01 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\x12#\n" +
 	"\bpassword\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x06R\bpassword\"R\n" +
@@ -366,12 +974,58 @@ const file_auth_v1_auth_proto_rawDesc = "" +
 	"\x13RefreshTokenRequest\x12#\n" +
 	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"9\n" +
 	"\x14RefreshTokenResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken2\xf7\x02\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\"<\n" +
+	"\x15OAuthAuthorizeRequest\x12#\n" +
+	"\bprovider\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bprovider\"j\n" +
+	"\x16OAuthAuthorizeResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\x12#\n" +
+	"\rbrowser_nonce\x18\x02 \x01(\tR\fbrowserNonce\"\xa5\x01\n" +
+	"\x14OAuthCallbackRequest\x12#\n" +
+	"\bprovider\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bprovider\x12\x1b\n" +
+	"\x04code\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04code\x12\x1d\n" +
+	"\x05state\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05state\x12,\n" +
+	"\rbrowser_nonce\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\fbrowserNonce\"Z\n" +
+	"\x15OAuthCallbackResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04data\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\"8\n" +
+	"\x17RequestMagicLinkRequest\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\"0\n" +
+	"\x18RequestMagicLinkResponse\x12\x14\n" +
+	"\x05nonce\x18\x01 \x01(\tR\x05nonce\"W\n" +
+	"\x17ConsumeMagicLinkRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05token\x12\x1d\n" +
+	"\x05nonce\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05nonce\"]\n" +
+	"\x18ConsumeMagicLinkResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04data\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\"\x1a\n" +
+	"\x18BeginPasskeyLoginRequest\"o\n" +
+	"\x19BeginPasskeyLoginResponse\x12\x1f\n" +
+	"\vceremony_id\x18\x01 \x01(\tR\n" +
+	"ceremonyId\x121\n" +
+	"\aoptions\x18\x02 \x01(\v2\x17.google.protobuf.StructR\aoptions\"\x86\x01\n" +
+	"\x19FinishPasskeyLoginRequest\x12(\n" +
+	"\vceremony_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"ceremonyId\x12?\n" +
+	"\n" +
+	"credential\x18\x02 \x01(\v2\x17.google.protobuf.StructB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"credential\"_\n" +
+	"\x1aFinishPasskeyLoginResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04data\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken2\xe6\b\n" +
 	"\x06AuthV1\x12Q\n" +
 	"\x05Login\x12\x15.auth_v1.LoginRequest\x1a\x16.auth_v1.LoginResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12]\n" +
 	"\bRegister\x12\x18.auth_v1.RegisterRequest\x1a\x19.auth_v1.RegisterResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/register\x12h\n" +
 	"\fRefreshToken\x12\x1c.auth_v1.RefreshTokenRequest\x1a\x1d.auth_v1.RefreshTokenResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/auth/refresh\x12Q\n" +
-	"\x06Logout\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x17\x82\xd3\xe4\x93\x02\x11\"\x0f/v1/auth/logoutBHZFgithub.com/BlazeCoder04/online_store/services/user/pkg/auth/v1;auth_v1b\x06proto3"
+	"\x06Logout\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x17\x82\xd3\xe4\x93\x02\x11\"\x0f/v1/auth/logout\x12t\n" +
+	"\x0eOAuthAuthorize\x12\x1e.auth_v1.OAuthAuthorizeRequest\x1a\x1f.auth_v1.OAuthAuthorizeResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/auth/oauth/{provider}\x12z\n" +
+	"\rOAuthCallback\x12\x1d.auth_v1.OAuthCallbackRequest\x1a\x1e.auth_v1.OAuthCallbackResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/auth/oauth/{provider}/callback\x12w\n" +
+	"\x10RequestMagicLink\x12 .auth_v1.RequestMagicLinkRequest\x1a!.auth_v1.RequestMagicLinkResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/auth/magic-link\x12\x7f\n" +
+	"\x10ConsumeMagicLink\x12 .auth_v1.ConsumeMagicLinkRequest\x1a!.auth_v1.ConsumeMagicLinkResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/auth/magic-link/consume\x12}\n" +
+	"\x11BeginPasskeyLogin\x12!.auth_v1.BeginPasskeyLoginRequest\x1a\".auth_v1.BeginPasskeyLoginResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/auth/passkey/begin\x12\x81\x01\n" +
+	"\x12FinishPasskeyLogin\x12\".auth_v1.FinishPasskeyLoginRequest\x1a#.auth_v1.FinishPasskeyLoginResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/passkey/finishBHZFgithub.com/BlazeCoder04/online_store/services/user/pkg/auth/v1;auth_v1b\x06proto3"
 
 var (
 	file_auth_v1_auth_proto_rawDescOnce sync.Once
@@ -385,33 +1039,63 @@ func file_auth_v1_auth_proto_rawDescGZIP() []byte {
 	return file_auth_v1_auth_proto_rawDescData
 }
 
-var file_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_auth_v1_auth_proto_goTypes = []any{
-	(*LoginRequest)(nil),         // 0: auth_v1.LoginRequest
-	(*LoginResponse)(nil),        // 1: auth_v1.LoginResponse
-	(*RegisterRequest)(nil),      // 2: auth_v1.RegisterRequest
-	(*RegisterResponse)(nil),     // 3: auth_v1.RegisterResponse
-	(*RefreshTokenRequest)(nil),  // 4: auth_v1.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil), // 5: auth_v1.RefreshTokenResponse
-	(*user.User)(nil),            // 6: user.User
-	(*emptypb.Empty)(nil),        // 7: google.protobuf.Empty
+	(*LoginRequest)(nil),               // 0: auth_v1.LoginRequest
+	(*LoginResponse)(nil),              // 1: auth_v1.LoginResponse
+	(*RegisterRequest)(nil),            // 2: auth_v1.RegisterRequest
+	(*RegisterResponse)(nil),           // 3: auth_v1.RegisterResponse
+	(*RefreshTokenRequest)(nil),        // 4: auth_v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),       // 5: auth_v1.RefreshTokenResponse
+	(*OAuthAuthorizeRequest)(nil),      // 6: auth_v1.OAuthAuthorizeRequest
+	(*OAuthAuthorizeResponse)(nil),     // 7: auth_v1.OAuthAuthorizeResponse
+	(*OAuthCallbackRequest)(nil),       // 8: auth_v1.OAuthCallbackRequest
+	(*OAuthCallbackResponse)(nil),      // 9: auth_v1.OAuthCallbackResponse
+	(*RequestMagicLinkRequest)(nil),    // 10: auth_v1.RequestMagicLinkRequest
+	(*RequestMagicLinkResponse)(nil),   // 11: auth_v1.RequestMagicLinkResponse
+	(*ConsumeMagicLinkRequest)(nil),    // 12: auth_v1.ConsumeMagicLinkRequest
+	(*ConsumeMagicLinkResponse)(nil),   // 13: auth_v1.ConsumeMagicLinkResponse
+	(*BeginPasskeyLoginRequest)(nil),   // 14: auth_v1.BeginPasskeyLoginRequest
+	(*BeginPasskeyLoginResponse)(nil),  // 15: auth_v1.BeginPasskeyLoginResponse
+	(*FinishPasskeyLoginRequest)(nil),  // 16: auth_v1.FinishPasskeyLoginRequest
+	(*FinishPasskeyLoginResponse)(nil), // 17: auth_v1.FinishPasskeyLoginResponse
+	(*user.User)(nil),                  // 18: user.User
+	(*structpb.Struct)(nil),            // 19: google.protobuf.Struct
+	(*emptypb.Empty)(nil),              // 20: google.protobuf.Empty
 }
 var file_auth_v1_auth_proto_depIdxs = []int32{
-	6, // 0: auth_v1.LoginResponse.data:type_name -> user.User
-	6, // 1: auth_v1.RegisterResponse.data:type_name -> user.User
-	0, // 2: auth_v1.AuthV1.Login:input_type -> auth_v1.LoginRequest
-	2, // 3: auth_v1.AuthV1.Register:input_type -> auth_v1.RegisterRequest
-	4, // 4: auth_v1.AuthV1.RefreshToken:input_type -> auth_v1.RefreshTokenRequest
-	7, // 5: auth_v1.AuthV1.Logout:input_type -> google.protobuf.Empty
-	1, // 6: auth_v1.AuthV1.Login:output_type -> auth_v1.LoginResponse
-	3, // 7: auth_v1.AuthV1.Register:output_type -> auth_v1.RegisterResponse
-	5, // 8: auth_v1.AuthV1.RefreshToken:output_type -> auth_v1.RefreshTokenResponse
-	7, // 9: auth_v1.AuthV1.Logout:output_type -> google.protobuf.Empty
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	18, // 0: auth_v1.LoginResponse.data:type_name -> user.User
+	18, // 1: auth_v1.RegisterResponse.data:type_name -> user.User
+	18, // 2: auth_v1.OAuthCallbackResponse.data:type_name -> user.User
+	18, // 3: auth_v1.ConsumeMagicLinkResponse.data:type_name -> user.User
+	19, // 4: auth_v1.BeginPasskeyLoginResponse.options:type_name -> google.protobuf.Struct
+	19, // 5: auth_v1.FinishPasskeyLoginRequest.credential:type_name -> google.protobuf.Struct
+	18, // 6: auth_v1.FinishPasskeyLoginResponse.data:type_name -> user.User
+	0,  // 7: auth_v1.AuthV1.Login:input_type -> auth_v1.LoginRequest
+	2,  // 8: auth_v1.AuthV1.Register:input_type -> auth_v1.RegisterRequest
+	4,  // 9: auth_v1.AuthV1.RefreshToken:input_type -> auth_v1.RefreshTokenRequest
+	20, // 10: auth_v1.AuthV1.Logout:input_type -> google.protobuf.Empty
+	6,  // 11: auth_v1.AuthV1.OAuthAuthorize:input_type -> auth_v1.OAuthAuthorizeRequest
+	8,  // 12: auth_v1.AuthV1.OAuthCallback:input_type -> auth_v1.OAuthCallbackRequest
+	10, // 13: auth_v1.AuthV1.RequestMagicLink:input_type -> auth_v1.RequestMagicLinkRequest
+	12, // 14: auth_v1.AuthV1.ConsumeMagicLink:input_type -> auth_v1.ConsumeMagicLinkRequest
+	14, // 15: auth_v1.AuthV1.BeginPasskeyLogin:input_type -> auth_v1.BeginPasskeyLoginRequest
+	16, // 16: auth_v1.AuthV1.FinishPasskeyLogin:input_type -> auth_v1.FinishPasskeyLoginRequest
+	1,  // 17: auth_v1.AuthV1.Login:output_type -> auth_v1.LoginResponse
+	3,  // 18: auth_v1.AuthV1.Register:output_type -> auth_v1.RegisterResponse
+	5,  // 19: auth_v1.AuthV1.RefreshToken:output_type -> auth_v1.RefreshTokenResponse
+	20, // 20: auth_v1.AuthV1.Logout:output_type -> google.protobuf.Empty
+	7,  // 21: auth_v1.AuthV1.OAuthAuthorize:output_type -> auth_v1.OAuthAuthorizeResponse
+	9,  // 22: auth_v1.AuthV1.OAuthCallback:output_type -> auth_v1.OAuthCallbackResponse
+	11, // 23: auth_v1.AuthV1.RequestMagicLink:output_type -> auth_v1.RequestMagicLinkResponse
+	13, // 24: auth_v1.AuthV1.ConsumeMagicLink:output_type -> auth_v1.ConsumeMagicLinkResponse
+	15, // 25: auth_v1.AuthV1.BeginPasskeyLogin:output_type -> auth_v1.BeginPasskeyLoginResponse
+	17, // 26: auth_v1.AuthV1.FinishPasskeyLogin:output_type -> auth_v1.FinishPasskeyLoginResponse
+	17, // [17:27] is the sub-list for method output_type
+	7,  // [7:17] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_auth_v1_auth_proto_init() }
@@ -425,7 +1109,7 @@ func file_auth_v1_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_auth_proto_rawDesc), len(file_auth_v1_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -715,3 +715,1404 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = RefreshTokenResponseValidationError{}
+
+// Validate checks the field values on OAuthAuthorizeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OAuthAuthorizeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OAuthAuthorizeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OAuthAuthorizeRequestMultiError, or nil if none found.
+func (m *OAuthAuthorizeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OAuthAuthorizeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Provider
+
+	if len(errors) > 0 {
+		return OAuthAuthorizeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OAuthAuthorizeRequestMultiError is an error wrapping multiple validation
+// errors returned by OAuthAuthorizeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type OAuthAuthorizeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OAuthAuthorizeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OAuthAuthorizeRequestMultiError) AllErrors() []error { return m }
+
+// OAuthAuthorizeRequestValidationError is the validation error returned by
+// OAuthAuthorizeRequest.Validate if the designated constraints aren't met.
+type OAuthAuthorizeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OAuthAuthorizeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OAuthAuthorizeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OAuthAuthorizeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OAuthAuthorizeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OAuthAuthorizeRequestValidationError) ErrorName() string {
+	return "OAuthAuthorizeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OAuthAuthorizeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOAuthAuthorizeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OAuthAuthorizeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OAuthAuthorizeRequestValidationError{}
+
+// Validate checks the field values on OAuthAuthorizeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OAuthAuthorizeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OAuthAuthorizeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OAuthAuthorizeResponseMultiError, or nil if none found.
+func (m *OAuthAuthorizeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OAuthAuthorizeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AuthorizationUrl
+
+	// no validation rules for BrowserNonce
+
+	if len(errors) > 0 {
+		return OAuthAuthorizeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OAuthAuthorizeResponseMultiError is an error wrapping multiple validation
+// errors returned by OAuthAuthorizeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type OAuthAuthorizeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OAuthAuthorizeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OAuthAuthorizeResponseMultiError) AllErrors() []error { return m }
+
+// OAuthAuthorizeResponseValidationError is the validation error returned by
+// OAuthAuthorizeResponse.Validate if the designated constraints aren't met.
+type OAuthAuthorizeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OAuthAuthorizeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OAuthAuthorizeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OAuthAuthorizeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OAuthAuthorizeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OAuthAuthorizeResponseValidationError) ErrorName() string {
+	return "OAuthAuthorizeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OAuthAuthorizeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOAuthAuthorizeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OAuthAuthorizeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OAuthAuthorizeResponseValidationError{}
+
+// Validate checks the field values on OAuthCallbackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OAuthCallbackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OAuthCallbackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OAuthCallbackRequestMultiError, or nil if none found.
+func (m *OAuthCallbackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OAuthCallbackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Provider
+
+	// no validation rules for Code
+
+	// no validation rules for State
+
+	// no validation rules for BrowserNonce
+
+	if len(errors) > 0 {
+		return OAuthCallbackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OAuthCallbackRequestMultiError is an error wrapping multiple validation
+// errors returned by OAuthCallbackRequest.ValidateAll() if the designated
+// constraints aren't met.
+type OAuthCallbackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OAuthCallbackRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OAuthCallbackRequestMultiError) AllErrors() []error { return m }
+
+// OAuthCallbackRequestValidationError is the validation error returned by
+// OAuthCallbackRequest.Validate if the designated constraints aren't met.
+type OAuthCallbackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OAuthCallbackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OAuthCallbackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OAuthCallbackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OAuthCallbackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OAuthCallbackRequestValidationError) ErrorName() string {
+	return "OAuthCallbackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OAuthCallbackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOAuthCallbackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OAuthCallbackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OAuthCallbackRequestValidationError{}
+
+// Validate checks the field values on OAuthCallbackResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OAuthCallbackResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OAuthCallbackResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OAuthCallbackResponseMultiError, or nil if none found.
+func (m *OAuthCallbackResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OAuthCallbackResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OAuthCallbackResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OAuthCallbackResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OAuthCallbackResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AccessToken
+
+	if len(errors) > 0 {
+		return OAuthCallbackResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OAuthCallbackResponseMultiError is an error wrapping multiple validation
+// errors returned by OAuthCallbackResponse.ValidateAll() if the designated
+// constraints aren't met.
+type OAuthCallbackResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OAuthCallbackResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OAuthCallbackResponseMultiError) AllErrors() []error { return m }
+
+// OAuthCallbackResponseValidationError is the validation error returned by
+// OAuthCallbackResponse.Validate if the designated constraints aren't met.
+type OAuthCallbackResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OAuthCallbackResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OAuthCallbackResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OAuthCallbackResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OAuthCallbackResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OAuthCallbackResponseValidationError) ErrorName() string {
+	return "OAuthCallbackResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OAuthCallbackResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOAuthCallbackResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OAuthCallbackResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OAuthCallbackResponseValidationError{}
+
+// Validate checks the field values on RequestMagicLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestMagicLinkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestMagicLinkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestMagicLinkRequestMultiError, or nil if none found.
+func (m *RequestMagicLinkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestMagicLinkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	if len(errors) > 0 {
+		return RequestMagicLinkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestMagicLinkRequestMultiError is an error wrapping multiple validation
+// errors returned by RequestMagicLinkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RequestMagicLinkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestMagicLinkRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestMagicLinkRequestMultiError) AllErrors() []error { return m }
+
+// RequestMagicLinkRequestValidationError is the validation error returned by
+// RequestMagicLinkRequest.Validate if the designated constraints aren't met.
+type RequestMagicLinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestMagicLinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestMagicLinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestMagicLinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestMagicLinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestMagicLinkRequestValidationError) ErrorName() string {
+	return "RequestMagicLinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestMagicLinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestMagicLinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestMagicLinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestMagicLinkRequestValidationError{}
+
+// Validate checks the field values on RequestMagicLinkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestMagicLinkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestMagicLinkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestMagicLinkResponseMultiError, or nil if none found.
+func (m *RequestMagicLinkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestMagicLinkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Nonce
+
+	if len(errors) > 0 {
+		return RequestMagicLinkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestMagicLinkResponseMultiError is an error wrapping multiple validation
+// errors returned by RequestMagicLinkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RequestMagicLinkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestMagicLinkResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestMagicLinkResponseMultiError) AllErrors() []error { return m }
+
+// RequestMagicLinkResponseValidationError is the validation error returned by
+// RequestMagicLinkResponse.Validate if the designated constraints aren't met.
+type RequestMagicLinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestMagicLinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestMagicLinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestMagicLinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestMagicLinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestMagicLinkResponseValidationError) ErrorName() string {
+	return "RequestMagicLinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestMagicLinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestMagicLinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestMagicLinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestMagicLinkResponseValidationError{}
+
+// Validate checks the field values on ConsumeMagicLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConsumeMagicLinkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsumeMagicLinkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsumeMagicLinkRequestMultiError, or nil if none found.
+func (m *ConsumeMagicLinkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsumeMagicLinkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for Nonce
+
+	if len(errors) > 0 {
+		return ConsumeMagicLinkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsumeMagicLinkRequestMultiError is an error wrapping multiple validation
+// errors returned by ConsumeMagicLinkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ConsumeMagicLinkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsumeMagicLinkRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsumeMagicLinkRequestMultiError) AllErrors() []error { return m }
+
+// ConsumeMagicLinkRequestValidationError is the validation error returned by
+// ConsumeMagicLinkRequest.Validate if the designated constraints aren't met.
+type ConsumeMagicLinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsumeMagicLinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsumeMagicLinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsumeMagicLinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsumeMagicLinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsumeMagicLinkRequestValidationError) ErrorName() string {
+	return "ConsumeMagicLinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsumeMagicLinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsumeMagicLinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsumeMagicLinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsumeMagicLinkRequestValidationError{}
+
+// Validate checks the field values on ConsumeMagicLinkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConsumeMagicLinkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsumeMagicLinkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsumeMagicLinkResponseMultiError, or nil if none found.
+func (m *ConsumeMagicLinkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsumeMagicLinkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConsumeMagicLinkResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConsumeMagicLinkResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConsumeMagicLinkResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AccessToken
+
+	if len(errors) > 0 {
+		return ConsumeMagicLinkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsumeMagicLinkResponseMultiError is an error wrapping multiple validation
+// errors returned by ConsumeMagicLinkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ConsumeMagicLinkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsumeMagicLinkResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsumeMagicLinkResponseMultiError) AllErrors() []error { return m }
+
+// ConsumeMagicLinkResponseValidationError is the validation error returned by
+// ConsumeMagicLinkResponse.Validate if the designated constraints aren't met.
+type ConsumeMagicLinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsumeMagicLinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsumeMagicLinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsumeMagicLinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsumeMagicLinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsumeMagicLinkResponseValidationError) ErrorName() string {
+	return "ConsumeMagicLinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsumeMagicLinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsumeMagicLinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsumeMagicLinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsumeMagicLinkResponseValidationError{}
+
+// Validate checks the field values on BeginPasskeyLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BeginPasskeyLoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BeginPasskeyLoginRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BeginPasskeyLoginRequestMultiError, or nil if none found.
+func (m *BeginPasskeyLoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BeginPasskeyLoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BeginPasskeyLoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BeginPasskeyLoginRequestMultiError is an error wrapping multiple validation
+// errors returned by BeginPasskeyLoginRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BeginPasskeyLoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BeginPasskeyLoginRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BeginPasskeyLoginRequestMultiError) AllErrors() []error { return m }
+
+// BeginPasskeyLoginRequestValidationError is the validation error returned by
+// BeginPasskeyLoginRequest.Validate if the designated constraints aren't met.
+type BeginPasskeyLoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BeginPasskeyLoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BeginPasskeyLoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BeginPasskeyLoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BeginPasskeyLoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BeginPasskeyLoginRequestValidationError) ErrorName() string {
+	return "BeginPasskeyLoginRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BeginPasskeyLoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBeginPasskeyLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BeginPasskeyLoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BeginPasskeyLoginRequestValidationError{}
+
+// Validate checks the field values on BeginPasskeyLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BeginPasskeyLoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BeginPasskeyLoginResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BeginPasskeyLoginResponseMultiError, or nil if none found.
+func (m *BeginPasskeyLoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BeginPasskeyLoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CeremonyId
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BeginPasskeyLoginResponseValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BeginPasskeyLoginResponseValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BeginPasskeyLoginResponseValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BeginPasskeyLoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BeginPasskeyLoginResponseMultiError is an error wrapping multiple validation
+// errors returned by BeginPasskeyLoginResponse.ValidateAll() if the
+// designated constraints aren't met.
+type BeginPasskeyLoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BeginPasskeyLoginResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BeginPasskeyLoginResponseMultiError) AllErrors() []error { return m }
+
+// BeginPasskeyLoginResponseValidationError is the validation error returned by
+// BeginPasskeyLoginResponse.Validate if the designated constraints aren't met.
+type BeginPasskeyLoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BeginPasskeyLoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BeginPasskeyLoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BeginPasskeyLoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BeginPasskeyLoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BeginPasskeyLoginResponseValidationError) ErrorName() string {
+	return "BeginPasskeyLoginResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BeginPasskeyLoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBeginPasskeyLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BeginPasskeyLoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BeginPasskeyLoginResponseValidationError{}
+
+// Validate checks the field values on FinishPasskeyLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinishPasskeyLoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishPasskeyLoginRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FinishPasskeyLoginRequestMultiError, or nil if none found.
+func (m *FinishPasskeyLoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishPasskeyLoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CeremonyId
+
+	if all {
+		switch v := interface{}(m.GetCredential()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FinishPasskeyLoginRequestValidationError{
+					field:  "Credential",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FinishPasskeyLoginRequestValidationError{
+					field:  "Credential",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCredential()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FinishPasskeyLoginRequestValidationError{
+				field:  "Credential",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FinishPasskeyLoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishPasskeyLoginRequestMultiError is an error wrapping multiple validation
+// errors returned by FinishPasskeyLoginRequest.ValidateAll() if the
+// designated constraints aren't met.
+type FinishPasskeyLoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishPasskeyLoginRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishPasskeyLoginRequestMultiError) AllErrors() []error { return m }
+
+// FinishPasskeyLoginRequestValidationError is the validation error returned by
+// FinishPasskeyLoginRequest.Validate if the designated constraints aren't met.
+type FinishPasskeyLoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishPasskeyLoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishPasskeyLoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishPasskeyLoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishPasskeyLoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishPasskeyLoginRequestValidationError) ErrorName() string {
+	return "FinishPasskeyLoginRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishPasskeyLoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishPasskeyLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishPasskeyLoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishPasskeyLoginRequestValidationError{}
+
+// Validate checks the field values on FinishPasskeyLoginResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinishPasskeyLoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishPasskeyLoginResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FinishPasskeyLoginResponseMultiError, or nil if none found.
+func (m *FinishPasskeyLoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishPasskeyLoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FinishPasskeyLoginResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FinishPasskeyLoginResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FinishPasskeyLoginResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AccessToken
+
+	if len(errors) > 0 {
+		return FinishPasskeyLoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishPasskeyLoginResponseMultiError is an error wrapping multiple
+// validation errors returned by FinishPasskeyLoginResponse.ValidateAll() if
+// the designated constraints aren't met.
+type FinishPasskeyLoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishPasskeyLoginResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishPasskeyLoginResponseMultiError) AllErrors() []error { return m }
+
+// FinishPasskeyLoginResponseValidationError is the validation error returned
+// by FinishPasskeyLoginResponse.Validate if the designated constraints aren't met.
+type FinishPasskeyLoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishPasskeyLoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishPasskeyLoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishPasskeyLoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishPasskeyLoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishPasskeyLoginResponseValidationError) ErrorName() string {
+	return "FinishPasskeyLoginResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishPasskeyLoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishPasskeyLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishPasskeyLoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishPasskeyLoginResponseValidationError{}
@@ -0,0 +1,807 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: oauth/v1/oauth.proto
+
+package oauth_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AuthorizeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizeRequestMultiError, or nil if none found.
+func (m *AuthorizeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResponseType
+
+	// no validation rules for ClientId
+
+	// no validation rules for RedirectUri
+
+	// no validation rules for Scope
+
+	// no validation rules for State
+
+	// no validation rules for CodeChallenge
+
+	// no validation rules for CodeChallengeMethod
+
+	if len(errors) > 0 {
+		return AuthorizeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeRequestMultiError is an error wrapping multiple validation errors
+// returned by AuthorizeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorizeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeRequestMultiError) AllErrors() []error { return m }
+
+// AuthorizeRequestValidationError is the validation error returned by
+// AuthorizeRequest.Validate if the designated constraints aren't met.
+type AuthorizeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeRequestValidationError) ErrorName() string { return "AuthorizeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorizeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeRequestValidationError{}
+
+// Validate checks the field values on AuthorizeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizeResponseMultiError, or nil if none found.
+func (m *AuthorizeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RedirectUrl
+
+	if len(errors) > 0 {
+		return AuthorizeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeResponseMultiError is an error wrapping multiple validation errors
+// returned by AuthorizeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorizeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeResponseMultiError) AllErrors() []error { return m }
+
+// AuthorizeResponseValidationError is the validation error returned by
+// AuthorizeResponse.Validate if the designated constraints aren't met.
+type AuthorizeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeResponseValidationError) ErrorName() string {
+	return "AuthorizeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorizeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeResponseValidationError{}
+
+// Validate checks the field values on TokenRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TokenRequestMultiError, or
+// nil if none found.
+func (m *TokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GrantType
+
+	// no validation rules for ClientId
+
+	// no validation rules for ClientSecret
+
+	// no validation rules for Code
+
+	// no validation rules for RedirectUri
+
+	// no validation rules for CodeVerifier
+
+	// no validation rules for RefreshToken
+
+	// no validation rules for Scope
+
+	if len(errors) > 0 {
+		return TokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenRequestMultiError is an error wrapping multiple validation errors
+// returned by TokenRequest.ValidateAll() if the designated constraints aren't met.
+type TokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenRequestMultiError) AllErrors() []error { return m }
+
+// TokenRequestValidationError is the validation error returned by
+// TokenRequest.Validate if the designated constraints aren't met.
+type TokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenRequestValidationError) ErrorName() string { return "TokenRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenRequestValidationError{}
+
+// Validate checks the field values on TokenResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TokenResponseMultiError, or
+// nil if none found.
+func (m *TokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccessToken
+
+	// no validation rules for TokenType
+
+	// no validation rules for ExpiresIn
+
+	// no validation rules for RefreshToken
+
+	// no validation rules for Scope
+
+	if len(errors) > 0 {
+		return TokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenResponseMultiError is an error wrapping multiple validation errors
+// returned by TokenResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenResponseMultiError) AllErrors() []error { return m }
+
+// TokenResponseValidationError is the validation error returned by
+// TokenResponse.Validate if the designated constraints aren't met.
+type TokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenResponseValidationError) ErrorName() string { return "TokenResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenResponseValidationError{}
+
+// Validate checks the field values on RevokeRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RevokeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RevokeRequestMultiError, or
+// nil if none found.
+func (m *RevokeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for ClientId
+
+	// no validation rules for ClientSecret
+
+	if len(errors) > 0 {
+		return RevokeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeRequestMultiError is an error wrapping multiple validation errors
+// returned by RevokeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeRequestMultiError) AllErrors() []error { return m }
+
+// RevokeRequestValidationError is the validation error returned by
+// RevokeRequest.Validate if the designated constraints aren't met.
+type RevokeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeRequestValidationError) ErrorName() string { return "RevokeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevokeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeRequestValidationError{}
+
+// Validate checks the field values on IntrospectRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IntrospectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IntrospectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IntrospectRequestMultiError, or nil if none found.
+func (m *IntrospectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IntrospectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for ClientId
+
+	// no validation rules for ClientSecret
+
+	if len(errors) > 0 {
+		return IntrospectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IntrospectRequestMultiError is an error wrapping multiple validation errors
+// returned by IntrospectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IntrospectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IntrospectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IntrospectRequestMultiError) AllErrors() []error { return m }
+
+// IntrospectRequestValidationError is the validation error returned by
+// IntrospectRequest.Validate if the designated constraints aren't met.
+type IntrospectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IntrospectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IntrospectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IntrospectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IntrospectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IntrospectRequestValidationError) ErrorName() string {
+	return "IntrospectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IntrospectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIntrospectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IntrospectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IntrospectRequestValidationError{}
+
+// Validate checks the field values on IntrospectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IntrospectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IntrospectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IntrospectResponseMultiError, or nil if none found.
+func (m *IntrospectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IntrospectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Active
+
+	// no validation rules for TokenType
+
+	// no validation rules for ClientId
+
+	// no validation rules for Sub
+
+	// no validation rules for Scope
+
+	// no validation rules for Exp
+
+	if len(errors) > 0 {
+		return IntrospectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IntrospectResponseMultiError is an error wrapping multiple validation errors
+// returned by IntrospectResponse.ValidateAll() if the designated constraints
+// aren't met.
+type IntrospectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IntrospectResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IntrospectResponseMultiError) AllErrors() []error { return m }
+
+// IntrospectResponseValidationError is the validation error returned by
+// IntrospectResponse.Validate if the designated constraints aren't met.
+type IntrospectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IntrospectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IntrospectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IntrospectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IntrospectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IntrospectResponseValidationError) ErrorName() string {
+	return "IntrospectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IntrospectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIntrospectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IntrospectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IntrospectResponseValidationError{}
@@ -0,0 +1,250 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: oauth/v1/oauth.proto
+
+package oauth_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OAuthV1_Authorize_FullMethodName  = "/oauth_v1.OAuthV1/Authorize"
+	OAuthV1_Token_FullMethodName      = "/oauth_v1.OAuthV1/Token"
+	OAuthV1_Revoke_FullMethodName     = "/oauth_v1.OAuthV1/Revoke"
+	OAuthV1_Introspect_FullMethodName = "/oauth_v1.OAuthV1/Introspect"
+)
+
+// OAuthV1Client is the client API for OAuthV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// OAuthV1 is an OAuth 2.0 authorization server for registered clients, such
+// as the mobile app, the seller portal and partner integrations.
+type OAuthV1Client interface {
+	// Authorize grants the client an authorization code on behalf of the user
+	// whose access token is sent. Only the S256 PKCE method is supported.
+	Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error)
+	// Token serves the authorization_code, refresh_token and
+	// client_credentials grants.
+	Token(ctx context.Context, in *TokenRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Introspect(ctx context.Context, in *IntrospectRequest, opts ...grpc.CallOption) (*IntrospectResponse, error)
+}
+
+type oAuthV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOAuthV1Client(cc grpc.ClientConnInterface) OAuthV1Client {
+	return &oAuthV1Client{cc}
+}
+
+func (c *oAuthV1Client) Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizeResponse)
+	err := c.cc.Invoke(ctx, OAuthV1_Authorize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthV1Client) Token(ctx context.Context, in *TokenRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, OAuthV1_Token_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthV1Client) Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OAuthV1_Revoke_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthV1Client) Introspect(ctx context.Context, in *IntrospectRequest, opts ...grpc.CallOption) (*IntrospectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntrospectResponse)
+	err := c.cc.Invoke(ctx, OAuthV1_Introspect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OAuthV1Server is the server API for OAuthV1 service.
+// All implementations must embed UnimplementedOAuthV1Server
+// for forward compatibility.
+//
+// OAuthV1 is an OAuth 2.0 authorization server for registered clients, such
+// as the mobile app, the seller portal and partner integrations.
+type OAuthV1Server interface {
+	// Authorize grants the client an authorization code on behalf of the user
+	// whose access token is sent. Only the S256 PKCE method is supported.
+	Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error)
+	// Token serves the authorization_code, refresh_token and
+	// client_credentials grants.
+	Token(context.Context, *TokenRequest) (*TokenResponse, error)
+	Revoke(context.Context, *RevokeRequest) (*emptypb.Empty, error)
+	Introspect(context.Context, *IntrospectRequest) (*IntrospectResponse, error)
+	mustEmbedUnimplementedOAuthV1Server()
+}
+
+// UnimplementedOAuthV1Server must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOAuthV1Server struct{}
+
+func (UnimplementedOAuthV1Server) Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
+}
+func (UnimplementedOAuthV1Server) Token(context.Context, *TokenRequest) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Token not implemented")
+}
+func (UnimplementedOAuthV1Server) Revoke(context.Context, *RevokeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
+}
+func (UnimplementedOAuthV1Server) Introspect(context.Context, *IntrospectRequest) (*IntrospectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Introspect not implemented")
+}
+func (UnimplementedOAuthV1Server) mustEmbedUnimplementedOAuthV1Server() {}
+func (UnimplementedOAuthV1Server) testEmbeddedByValue()                 {}
+
+// UnsafeOAuthV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OAuthV1Server will
+// result in compilation errors.
+type UnsafeOAuthV1Server interface {
+	mustEmbedUnimplementedOAuthV1Server()
+}
+
+func RegisterOAuthV1Server(s grpc.ServiceRegistrar, srv OAuthV1Server) {
+	// If the following call pancis, it indicates UnimplementedOAuthV1Server was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OAuthV1_ServiceDesc, srv)
+}
+
+func _OAuthV1_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthV1Server).Authorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthV1_Authorize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthV1Server).Authorize(ctx, req.(*AuthorizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthV1_Token_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthV1Server).Token(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthV1_Token_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthV1Server).Token(ctx, req.(*TokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthV1_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthV1Server).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthV1_Revoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthV1Server).Revoke(ctx, req.(*RevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthV1_Introspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntrospectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthV1Server).Introspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthV1_Introspect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthV1Server).Introspect(ctx, req.(*IntrospectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OAuthV1_ServiceDesc is the grpc.ServiceDesc for OAuthV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OAuthV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "oauth_v1.OAuthV1",
+	HandlerType: (*OAuthV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Authorize",
+			Handler:    _OAuthV1_Authorize_Handler,
+		},
+		{
+			MethodName: "Token",
+			Handler:    _OAuthV1_Token_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _OAuthV1_Revoke_Handler,
+		},
+		{
+			MethodName: "Introspect",
+			Handler:    _OAuthV1_Introspect_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "oauth/v1/oauth.proto",
+}
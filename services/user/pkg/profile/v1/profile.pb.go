@@ -13,7 +13,8 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -297,12 +298,2083 @@ func (x *DeleteRequest) GetPassword() string {
 	return ""
 }
 
+// SetPhone
+type SetPhoneRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// phone in international format, e.g. +14155552671.
+	Phone         string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPhoneRequest) Reset() {
+	*x = SetPhoneRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPhoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPhoneRequest) ProtoMessage() {}
+
+func (x *SetPhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPhoneRequest.ProtoReflect.Descriptor instead.
+func (*SetPhoneRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetPhoneRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetPhoneRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type SetPhoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *user.User             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPhoneResponse) Reset() {
+	*x = SetPhoneResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPhoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPhoneResponse) ProtoMessage() {}
+
+func (x *SetPhoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPhoneResponse.ProtoReflect.Descriptor instead.
+func (*SetPhoneResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetPhoneResponse) GetData() *user.User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// VerifyPhone
+type VerifyPhoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPhoneRequest) Reset() {
+	*x = VerifyPhoneRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPhoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPhoneRequest) ProtoMessage() {}
+
+func (x *VerifyPhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPhoneRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPhoneRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VerifyPhoneRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VerifyPhoneRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyPhoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *user.User             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPhoneResponse) Reset() {
+	*x = VerifyPhoneResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPhoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPhoneResponse) ProtoMessage() {}
+
+func (x *VerifyPhoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPhoneResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPhoneResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VerifyPhoneResponse) GetData() *user.User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// UploadAvatar
+type UploadAvatarRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadAvatarRequest_Info
+	//	*UploadAvatarRequest_Chunk
+	Data          isUploadAvatarRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAvatarRequest) Reset() {
+	*x = UploadAvatarRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarRequest) ProtoMessage() {}
+
+func (x *UploadAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarRequest.ProtoReflect.Descriptor instead.
+func (*UploadAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UploadAvatarRequest) GetData() isUploadAvatarRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadAvatarRequest) GetInfo() *AvatarInfo {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAvatarRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadAvatarRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAvatarRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAvatarRequest_Data interface {
+	isUploadAvatarRequest_Data()
+}
+
+type UploadAvatarRequest_Info struct {
+	Info *AvatarInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadAvatarRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAvatarRequest_Info) isUploadAvatarRequest_Data() {}
+
+func (*UploadAvatarRequest_Chunk) isUploadAvatarRequest_Data() {}
+
+type AvatarInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvatarInfo) Reset() {
+	*x = AvatarInfo{}
+	mi := &file_profile_v1_profile_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvatarInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvatarInfo) ProtoMessage() {}
+
+func (x *AvatarInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvatarInfo.ProtoReflect.Descriptor instead.
+func (*AvatarInfo) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AvatarInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AvatarInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type UploadAvatarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *user.User             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAvatarResponse) Reset() {
+	*x = UploadAvatarResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAvatarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarResponse) ProtoMessage() {}
+
+func (x *UploadAvatarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarResponse.ProtoReflect.Descriptor instead.
+func (*UploadAvatarResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UploadAvatarResponse) GetData() *user.User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Address struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Label           string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Recipient       string                 `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Line1           string                 `protobuf:"bytes,5,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2           string                 `protobuf:"bytes,6,opt,name=line2,proto3" json:"line2,omitempty"`
+	City            string                 `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`
+	Region          string                 `protobuf:"bytes,8,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode      string                 `protobuf:"bytes,9,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	CountryCode     string                 `protobuf:"bytes,10,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Phone           string                 `protobuf:"bytes,11,opt,name=phone,proto3" json:"phone,omitempty"`
+	DefaultShipping bool                   `protobuf:"varint,12,opt,name=default_shipping,json=defaultShipping,proto3" json:"default_shipping,omitempty"`
+	DefaultBilling  bool                   `protobuf:"varint,13,opt,name=default_billing,json=defaultBilling,proto3" json:"default_billing,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_profile_v1_profile_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Address) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Address) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Address) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Address) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *Address) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *Address) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *Address) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Address) GetDefaultShipping() bool {
+	if x != nil {
+		return x.DefaultShipping
+	}
+	return false
+}
+
+func (x *Address) GetDefaultBilling() bool {
+	if x != nil {
+		return x.DefaultBilling
+	}
+	return false
+}
+
+func (x *Address) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Address) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Passkey leaves out the public key, which is of no use to the client.
+type Passkey struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Transports []string               `protobuf:"bytes,3,rep,name=transports,proto3" json:"transports,omitempty"`
+	// aaguid identifies the authenticator model, when it tells.
+	Aaguid string `protobuf:"bytes,4,opt,name=aaguid,proto3" json:"aaguid,omitempty"`
+	// backup_eligible passkeys are synced between devices, and backed up when
+	// backup_state is set.
+	BackupEligible bool                   `protobuf:"varint,5,opt,name=backup_eligible,json=backupEligible,proto3" json:"backup_eligible,omitempty"`
+	BackupState    bool                   `protobuf:"varint,6,opt,name=backup_state,json=backupState,proto3" json:"backup_state,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Passkey) Reset() {
+	*x = Passkey{}
+	mi := &file_profile_v1_profile_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Passkey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Passkey) ProtoMessage() {}
+
+func (x *Passkey) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Passkey.ProtoReflect.Descriptor instead.
+func (*Passkey) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Passkey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Passkey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Passkey) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+func (x *Passkey) GetAaguid() string {
+	if x != nil {
+		return x.Aaguid
+	}
+	return ""
+}
+
+func (x *Passkey) GetBackupEligible() bool {
+	if x != nil {
+		return x.BackupEligible
+	}
+	return false
+}
+
+func (x *Passkey) GetBackupState() bool {
+	if x != nil {
+		return x.BackupState
+	}
+	return false
+}
+
+func (x *Passkey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Passkey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+// ApiKey leaves out the hash of the secret; prefix is the start of the key
+// and tells keys apart.
+type ApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	Revoked       bool                   `protobuf:"varint,7,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_profile_v1_profile_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ApiKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *ApiKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AddressInput is the writable part of an address. Postal codes and regions
+// are checked against the rules of the country they belong to.
+type AddressInput struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Label      string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Recipient  string                 `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Line1      string                 `protobuf:"bytes,3,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2      string                 `protobuf:"bytes,4,opt,name=line2,proto3" json:"line2,omitempty"`
+	City       string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	Region     string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	// ISO 3166-1 alpha-2, e.g. US.
+	CountryCode string `protobuf:"bytes,8,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	// E.164, e.g. +14155550100.
+	Phone           string `protobuf:"bytes,9,opt,name=phone,proto3" json:"phone,omitempty"`
+	DefaultShipping bool   `protobuf:"varint,10,opt,name=default_shipping,json=defaultShipping,proto3" json:"default_shipping,omitempty"`
+	DefaultBilling  bool   `protobuf:"varint,11,opt,name=default_billing,json=defaultBilling,proto3" json:"default_billing,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AddressInput) Reset() {
+	*x = AddressInput{}
+	mi := &file_profile_v1_profile_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressInput) ProtoMessage() {}
+
+func (x *AddressInput) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressInput.ProtoReflect.Descriptor instead.
+func (*AddressInput) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddressInput) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AddressInput) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *AddressInput) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *AddressInput) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *AddressInput) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *AddressInput) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *AddressInput) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *AddressInput) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *AddressInput) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *AddressInput) GetDefaultShipping() bool {
+	if x != nil {
+		return x.DefaultShipping
+	}
+	return false
+}
+
+func (x *AddressInput) GetDefaultBilling() bool {
+	if x != nil {
+		return x.DefaultBilling
+	}
+	return false
+}
+
+// ListAddresses
+type ListAddressesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressesRequest) Reset() {
+	*x = ListAddressesRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressesRequest) ProtoMessage() {}
+
+func (x *ListAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressesRequest.ProtoReflect.Descriptor instead.
+func (*ListAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListAddressesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListAddressesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Address             `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressesResponse) Reset() {
+	*x = ListAddressesResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressesResponse) ProtoMessage() {}
+
+func (x *ListAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressesResponse.ProtoReflect.Descriptor instead.
+func (*ListAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListAddressesResponse) GetData() []*Address {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetAddress
+type GetAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AddressId     string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressRequest) Reset() {
+	*x = GetAddressRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressRequest) ProtoMessage() {}
+
+func (x *GetAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAddressRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAddressRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+type GetAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Address               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressResponse) Reset() {
+	*x = GetAddressResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressResponse) ProtoMessage() {}
+
+func (x *GetAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressResponse.ProtoReflect.Descriptor instead.
+func (*GetAddressResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAddressResponse) GetData() *Address {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// CreateAddress
+type CreateAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address       *AddressInput          `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAddressRequest) Reset() {
+	*x = CreateAddressRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAddressRequest) ProtoMessage() {}
+
+func (x *CreateAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAddressRequest.ProtoReflect.Descriptor instead.
+func (*CreateAddressRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateAddressRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetAddress() *AddressInput {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type CreateAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Address               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAddressResponse) Reset() {
+	*x = CreateAddressResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAddressResponse) ProtoMessage() {}
+
+func (x *CreateAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAddressResponse.ProtoReflect.Descriptor instead.
+func (*CreateAddressResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateAddressResponse) GetData() *Address {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// UpdateAddress replaces every field of the address.
+type UpdateAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AddressId     string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	Address       *AddressInput          `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAddressRequest) Reset() {
+	*x = UpdateAddressRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAddressRequest) ProtoMessage() {}
+
+func (x *UpdateAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAddressRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAddressRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateAddressRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetAddress() *AddressInput {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type UpdateAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Address               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAddressResponse) Reset() {
+	*x = UpdateAddressResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAddressResponse) ProtoMessage() {}
+
+func (x *UpdateAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAddressResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAddressResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateAddressResponse) GetData() *Address {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DeleteAddress
+type DeleteAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AddressId     string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAddressRequest) Reset() {
+	*x = DeleteAddressRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressRequest) ProtoMessage() {}
+
+func (x *DeleteAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAddressRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteAddressRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteAddressRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+// BeginPasskeyRegistration
+type BeginPasskeyRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyRegistrationRequest) Reset() {
+	*x = BeginPasskeyRegistrationRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyRegistrationRequest) ProtoMessage() {}
+
+func (x *BeginPasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BeginPasskeyRegistrationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BeginPasskeyRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CeremonyId    string                 `protobuf:"bytes,1,opt,name=ceremony_id,json=ceremonyId,proto3" json:"ceremony_id,omitempty"`
+	Options       *structpb.Struct       `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyRegistrationResponse) Reset() {
+	*x = BeginPasskeyRegistrationResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyRegistrationResponse) ProtoMessage() {}
+
+func (x *BeginPasskeyRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BeginPasskeyRegistrationResponse) GetCeremonyId() string {
+	if x != nil {
+		return x.CeremonyId
+	}
+	return ""
+}
+
+func (x *BeginPasskeyRegistrationResponse) GetOptions() *structpb.Struct {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// FinishPasskeyRegistration
+type FinishPasskeyRegistrationRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CeremonyId string                 `protobuf:"bytes,2,opt,name=ceremony_id,json=ceremonyId,proto3" json:"ceremony_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// credential is the PublicKeyCredential as encoded by its toJSON().
+	Credential    *structpb.Struct `protobuf:"bytes,4,opt,name=credential,proto3" json:"credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyRegistrationRequest) Reset() {
+	*x = FinishPasskeyRegistrationRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyRegistrationRequest) ProtoMessage() {}
+
+func (x *FinishPasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPasskeyRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetCeremonyId() string {
+	if x != nil {
+		return x.CeremonyId
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetCredential() *structpb.Struct {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+type FinishPasskeyRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Passkey               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyRegistrationResponse) Reset() {
+	*x = FinishPasskeyRegistrationResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyRegistrationResponse) ProtoMessage() {}
+
+func (x *FinishPasskeyRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPasskeyRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FinishPasskeyRegistrationResponse) GetData() *Passkey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ListPasskeys
+type ListPasskeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPasskeysRequest) Reset() {
+	*x = ListPasskeysRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPasskeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPasskeysRequest) ProtoMessage() {}
+
+func (x *ListPasskeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPasskeysRequest.ProtoReflect.Descriptor instead.
+func (*ListPasskeysRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListPasskeysRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListPasskeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Passkey             `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPasskeysResponse) Reset() {
+	*x = ListPasskeysResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPasskeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPasskeysResponse) ProtoMessage() {}
+
+func (x *ListPasskeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPasskeysResponse.ProtoReflect.Descriptor instead.
+func (*ListPasskeysResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListPasskeysResponse) GetData() []*Passkey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// RenamePasskey
+type RenamePasskeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PasskeyId     string                 `protobuf:"bytes,2,opt,name=passkey_id,json=passkeyId,proto3" json:"passkey_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenamePasskeyRequest) Reset() {
+	*x = RenamePasskeyRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenamePasskeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenamePasskeyRequest) ProtoMessage() {}
+
+func (x *RenamePasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenamePasskeyRequest.ProtoReflect.Descriptor instead.
+func (*RenamePasskeyRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RenamePasskeyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RenamePasskeyRequest) GetPasskeyId() string {
+	if x != nil {
+		return x.PasskeyId
+	}
+	return ""
+}
+
+func (x *RenamePasskeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenamePasskeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Passkey               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenamePasskeyResponse) Reset() {
+	*x = RenamePasskeyResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenamePasskeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenamePasskeyResponse) ProtoMessage() {}
+
+func (x *RenamePasskeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenamePasskeyResponse.ProtoReflect.Descriptor instead.
+func (*RenamePasskeyResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RenamePasskeyResponse) GetData() *Passkey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DeletePasskey
+type DeletePasskeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PasskeyId     string                 `protobuf:"bytes,2,opt,name=passkey_id,json=passkeyId,proto3" json:"passkey_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePasskeyRequest) Reset() {
+	*x = DeletePasskeyRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePasskeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePasskeyRequest) ProtoMessage() {}
+
+func (x *DeletePasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePasskeyRequest.ProtoReflect.Descriptor instead.
+func (*DeletePasskeyRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeletePasskeyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeletePasskeyRequest) GetPasskeyId() string {
+	if x != nil {
+		return x.PasskeyId
+	}
+	return ""
+}
+
+// CreateApiKey
+type CreateApiKeyRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// expires_at is optional, a key without one does not expire.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateApiKeyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateApiKeyRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ApiKey                `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateApiKeyResponse) GetData() *ApiKey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// ListApiKeys
+type ListApiKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysRequest) ProtoMessage() {}
+
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListApiKeysRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*ApiKey              `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListApiKeysResponse) GetData() []*ApiKey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// RevokeApiKey
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ApiKeyId      string                 `protobuf:"bytes,2,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RevokeApiKeyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeApiKeyRequest) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+type RevokeApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ApiKey                `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResponse) ProtoMessage() {}
+
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RevokeApiKeyResponse) GetData() *ApiKey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 var File_profile_v1_profile_proto protoreflect.FileDescriptor
 
 const file_profile_v1_profile_proto_rawDesc = "" +
 	"\n" +
 	"\x18profile/v1/profile.proto\x12\n" +
-	"profile_v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0fuser/user.proto\"/\n" +
+	"profile_v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0fuser/user.proto\"/\n" +
 	"\n" +
 	"GetRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"-\n" +
@@ -326,11 +2398,206 @@ const file_profile_v1_profile_proto_rawDesc = "" +
 	".user.UserR\x04data\"W\n" +
 	"\rDeleteRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12#\n" +
-	"\bpassword\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x06R\bpassword2\xa7\x02\n" +
+	"\bpassword\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x06R\bpassword\"g\n" +
+	"\x0fSetPhoneRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x121\n" +
+	"\x05phone\x18\x02 \x01(\tB\x1b\xbaH\x18r\x162\x14^\\+[1-9][0-9]{6,14}$R\x05phone\"2\n" +
+	"\x10SetPhoneResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04data\"^\n" +
+	"\x12VerifyPhoneRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\x04code\x18\x02 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[0-9]{6}$R\x04code\"5\n" +
+	"\x13VerifyPhoneResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04data\"u\n" +
+	"\x13UploadAvatarRequest\x12,\n" +
+	"\x04info\x18\x01 \x01(\v2\x16.profile_v1.AvatarInfoH\x00R\x04info\x12!\n" +
+	"\x05chunk\x18\x02 \x01(\fB\t\xbaH\x06z\x04\x18\x80\x80@H\x00R\x05chunkB\r\n" +
+	"\x04data\x12\x05\xbaH\x02\b\x01\"|\n" +
+	"\n" +
+	"AvatarInfo\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12K\n" +
+	"\fcontent_type\x18\x02 \x01(\tB(\xbaH%r#R\n" +
+	"image/jpegR\timage/pngR\n" +
+	"image/webpR\vcontentType\"6\n" +
+	"\x14UploadAvatarResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04data\"\xe2\x03\n" +
+	"\aAddress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x1c\n" +
+	"\trecipient\x18\x04 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05line1\x18\x05 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x06 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\a \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\b \x01(\tR\x06region\x12\x1f\n" +
+	"\vpostal_code\x18\t \x01(\tR\n" +
+	"postalCode\x12!\n" +
+	"\fcountry_code\x18\n" +
+	" \x01(\tR\vcountryCode\x12\x14\n" +
+	"\x05phone\x18\v \x01(\tR\x05phone\x12)\n" +
+	"\x10default_shipping\x18\f \x01(\bR\x0fdefaultShipping\x12'\n" +
+	"\x0fdefault_billing\x18\r \x01(\bR\x0edefaultBilling\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xaa\x02\n" +
+	"\aPasskey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"transports\x18\x03 \x03(\tR\n" +
+	"transports\x12\x16\n" +
+	"\x06aaguid\x18\x04 \x01(\tR\x06aaguid\x12'\n" +
+	"\x0fbackup_eligible\x18\x05 \x01(\bR\x0ebackupEligible\x12!\n" +
+	"\fbackup_state\x18\x06 \x01(\bR\vbackupState\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"\xaa\x02\n" +
+	"\x06ApiKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x12\x18\n" +
+	"\arevoked\x18\a \x01(\bR\arevoked\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xea\x14\n" +
+	"\fAddressInput\x12\x1d\n" +
+	"\x05label\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18 R\x05label\x12(\n" +
+	"\trecipient\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x01R\trecipient\x12 \n" +
+	"\x05line1\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x02R\x05line1\x12\x1e\n" +
+	"\x05line2\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x02R\x05line2\x12\x1e\n" +
+	"\x04city\x18\x05 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x01R\x04city\x12 \n" +
+	"\x06region\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x01R\x06region\x12(\n" +
+	"\vpostal_code\x18\a \x01(\tB\a\xbaH\x04r\x02\x18\x10R\n" +
+	"postalCode\x124\n" +
+	"\fcountry_code\x18\b \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[A-Z]{2}$R\vcountryCode\x124\n" +
+	"\x05phone\x18\t \x01(\tB\x1e\xbaH\x1b\xd8\x01\x01r\x162\x14^\\+[1-9][0-9]{6,14}$R\x05phone\x12)\n" +
+	"\x10default_shipping\x18\n" +
+	" \x01(\bR\x0fdefaultShipping\x12'\n" +
+	"\x0fdefault_billing\x18\v \x01(\bR\x0edefaultBilling:\xa2\x11\xbaH\x9e\x11\x1a\xd5\x03\n" +
+	"\x1caddress.postal_code.required\x12'postal code is required in this country\x1a\x8b\x03this.postal_code != '' || this.country_code in ['AE', 'AG', 'AO', 'AW', 'BF', 'BI', 'BJ', 'BS', 'BW', 'BZ', 'CD', 'CF', 'CG', 'CI', 'CM', 'DJ', 'DM', 'ER', 'FJ', 'GA', 'GD', 'GH', 'GM', 'GQ', 'GY', 'HK', 'IE', 'JM', 'KI', 'KM', 'KN', 'KP', 'LY', 'ML', 'MO', 'MR', 'MW', 'NR', 'NU', 'QA', 'RW', 'SB', 'SC', 'SL', 'SR', 'ST', 'SY', 'TD', 'TF', 'TG', 'TK', 'TL', 'TO', 'TV', 'UG', 'VU', 'YE', 'ZW']\x1a\xa5\x01\n" +
+	"\x16address.postal_code.us\x12:postal code must be a ZIP code such as 12345 or 12345-6789\x1aOthis.country_code != 'US' || this.postal_code.matches('^[0-9]{5}(-[0-9]{4})?$')\x1a\xa2\x01\n" +
+	"\x16address.postal_code.ca\x12\"postal code must look like A1A 1A1\x1adthis.country_code != 'CA' || this.postal_code.matches('^[A-Za-z][0-9][A-Za-z] ?[0-9][A-Za-z][0-9]$')\x1a\xb9\x01\n" +
+	"\x16address.postal_code.gb\x122postal code must be a UK postcode such as SW1A 1AA\x1akthis.country_code != 'GB' || this.postal_code.matches('^[A-Za-z]{1,2}[0-9][A-Za-z0-9]? ?[0-9][A-Za-z]{2}$')\x1a\xa3\x01\n" +
+	"\x1faddress.postal_code.five_digits\x12\x1epostal code must have 5 digits\x1a`!(this.country_code in ['DE', 'ES', 'FR', 'IT', 'MX']) || this.postal_code.matches('^[0-9]{5}$')\x1a\xa2\x01\n" +
+	"\x1eaddress.postal_code.six_digits\x12\x1epostal code must have 6 digits\x1a`!(this.country_code in ['BY', 'CN', 'IN', 'KZ', 'RU']) || this.postal_code.matches('^[0-9]{6}$')\x1a\xa9\x01\n" +
+	"\x1faddress.postal_code.four_digits\x12\x1epostal code must have 4 digits\x1af!(this.country_code in ['AT', 'AU', 'BE', 'CH', 'DK', 'NO']) || this.postal_code.matches('^[0-9]{4}$')\x1a\x8e\x01\n" +
+	"\x16address.postal_code.nl\x12\"postal code must look like 1234 AB\x1aPthis.country_code != 'NL' || this.postal_code.matches('^[0-9]{4} ?[A-Za-z]{2}$')\x1a\x8c\x01\n" +
+	"\x16address.postal_code.jp\x12#postal code must look like 123-4567\x1aMthis.country_code != 'JP' || this.postal_code.matches('^[0-9]{3}-?[0-9]{4}$')\x1a\x8d\x01\n" +
+	"\x16address.postal_code.br\x12$postal code must look like 12345-678\x1aMthis.country_code != 'BR' || this.postal_code.matches('^[0-9]{5}-?[0-9]{3}$')\x1a\x96\x01\n" +
+	"\x17address.region.required\x12\"region is required in this country\x1aW!(this.country_code in ['AU', 'BR', 'CA', 'CN', 'IN', 'MX', 'US']) || this.region != ''\x1a{\n" +
+	"\x11address.region.us\x12&region must be a two-letter state code\x1a>this.country_code != 'US' || this.region.matches('^[A-Z]{2}$')\"9\n" +
+	"\x14ListAddressesRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"@\n" +
+	"\x15ListAddressesResponse\x12'\n" +
+	"\x04data\x18\x01 \x03(\v2\x13.profile_v1.AddressR\x04data\"_\n" +
+	"\x11GetAddressRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12'\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\taddressId\"=\n" +
+	"\x12GetAddressResponse\x12'\n" +
+	"\x04data\x18\x01 \x01(\v2\x13.profile_v1.AddressR\x04data\"u\n" +
+	"\x14CreateAddressRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12:\n" +
+	"\aaddress\x18\x02 \x01(\v2\x18.profile_v1.AddressInputB\x06\xbaH\x03\xc8\x01\x01R\aaddress\"@\n" +
+	"\x15CreateAddressResponse\x12'\n" +
+	"\x04data\x18\x01 \x01(\v2\x13.profile_v1.AddressR\x04data\"\x9e\x01\n" +
+	"\x14UpdateAddressRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12'\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\taddressId\x12:\n" +
+	"\aaddress\x18\x03 \x01(\v2\x18.profile_v1.AddressInputB\x06\xbaH\x03\xc8\x01\x01R\aaddress\"@\n" +
+	"\x15UpdateAddressResponse\x12'\n" +
+	"\x04data\x18\x01 \x01(\v2\x13.profile_v1.AddressR\x04data\"b\n" +
+	"\x14DeleteAddressRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12'\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\taddressId\"D\n" +
+	"\x1fBeginPasskeyRegistrationRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"v\n" +
+	" BeginPasskeyRegistrationResponse\x12\x1f\n" +
+	"\vceremony_id\x18\x01 \x01(\tR\n" +
+	"ceremonyId\x121\n" +
+	"\aoptions\x18\x02 \x01(\v2\x17.google.protobuf.StructR\aoptions\"\xcf\x01\n" +
+	" FinishPasskeyRegistrationRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12(\n" +
+	"\vceremony_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"ceremonyId\x12\x1d\n" +
+	"\x04name\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x04name\x12?\n" +
+	"\n" +
+	"credential\x18\x04 \x01(\v2\x17.google.protobuf.StructB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"credential\"L\n" +
+	"!FinishPasskeyRegistrationResponse\x12'\n" +
+	"\x04data\x18\x01 \x01(\v2\x13.profile_v1.PasskeyR\x04data\"8\n" +
+	"\x13ListPasskeysRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"?\n" +
+	"\x14ListPasskeysResponse\x12'\n" +
+	"\x04data\x18\x01 \x03(\v2\x13.profile_v1.PasskeyR\x04data\"\x81\x01\n" +
+	"\x14RenamePasskeyRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12'\n" +
+	"\n" +
+	"passkey_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tpasskeyId\x12\x1d\n" +
+	"\x04name\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x04name\"@\n" +
+	"\x15RenamePasskeyResponse\x12'\n" +
+	"\x04data\x18\x01 \x01(\v2\x13.profile_v1.PasskeyR\x04data\"b\n" +
+	"\x14DeletePasskeyRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12'\n" +
+	"\n" +
+	"passkey_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tpasskeyId\"\xb6\x01\n" +
+	"\x13CreateApiKeyRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x04name\x12\"\n" +
+	"\x06scopes\x18\x03 \x03(\tB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x18\x01R\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"P\n" +
+	"\x14CreateApiKeyResponse\x12&\n" +
+	"\x04data\x18\x01 \x01(\v2\x12.profile_v1.ApiKeyR\x04data\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"7\n" +
+	"\x12ListApiKeysRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"=\n" +
+	"\x13ListApiKeysResponse\x12&\n" +
+	"\x04data\x18\x01 \x03(\v2\x12.profile_v1.ApiKeyR\x04data\"`\n" +
+	"\x13RevokeApiKeyRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\n" +
+	"api_key_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bapiKeyId\">\n" +
+	"\x14RevokeApiKeyResponse\x12&\n" +
+	"\x04data\x18\x01 \x01(\v2\x12.profile_v1.ApiKeyR\x04data2\xff\x12\n" +
 	"\tProfileV1\x12V\n" +
 	"\x03Get\x12\x16.profile_v1.GetRequest\x1a\x17.profile_v1.GetResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/profiles/{user_id}\x12b\n" +
 	"\x06Update\x12\x19.profile_v1.UpdateRequest\x1a\x1a.profile_v1.UpdateResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/v1/profiles/{user_id}\x12^\n" +
-	"\x06Delete\x12\x19.profile_v1.DeleteRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x01**\x16/v1/profiles/{user_id}BNZLgithub.com/BlazeCoder04/online_store/services/user/pkg/profile/v1;profile_v1b\x06proto3"
+	"\x06Delete\x12\x19.profile_v1.DeleteRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x01**\x16/v1/profiles/{user_id}\x12n\n" +
+	"\bSetPhone\x12\x1b.profile_v1.SetPhoneRequest\x1a\x1c.profile_v1.SetPhoneResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/v1/profiles/{user_id}/phone\x12~\n" +
+	"\vVerifyPhone\x12\x1e.profile_v1.VerifyPhoneRequest\x1a\x1f.profile_v1.VerifyPhoneResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/profiles/{user_id}/phone/verify\x12S\n" +
+	"\fUploadAvatar\x12\x1f.profile_v1.UploadAvatarRequest\x1a .profile_v1.UploadAvatarResponse(\x01\x12~\n" +
+	"\rListAddresses\x12 .profile_v1.ListAddressesRequest\x1a!.profile_v1.ListAddressesResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/profiles/{user_id}/addresses\x12\x82\x01\n" +
+	"\n" +
+	"GetAddress\x12\x1d.profile_v1.GetAddressRequest\x1a\x1e.profile_v1.GetAddressResponse\"5\x82\xd3\xe4\x93\x02/\x12-/v1/profiles/{user_id}/addresses/{address_id}\x12\x87\x01\n" +
+	"\rCreateAddress\x12 .profile_v1.CreateAddressRequest\x1a!.profile_v1.CreateAddressResponse\"1\x82\xd3\xe4\x93\x02+:\aaddress\" /v1/profiles/{user_id}/addresses\x12\x94\x01\n" +
+	"\rUpdateAddress\x12 .profile_v1.UpdateAddressRequest\x1a!.profile_v1.UpdateAddressResponse\">\x82\xd3\xe4\x93\x028:\aaddress\x1a-/v1/profiles/{user_id}/addresses/{address_id}\x12\x80\x01\n" +
+	"\rDeleteAddress\x12 .profile_v1.DeleteAddressRequest\x1a\x16.google.protobuf.Empty\"5\x82\xd3\xe4\x93\x02/*-/v1/profiles/{user_id}/addresses/{address_id}\x12\xa7\x01\n" +
+	"\x18BeginPasskeyRegistration\x12+.profile_v1.BeginPasskeyRegistrationRequest\x1a,.profile_v1.BeginPasskeyRegistrationResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/profiles/{user_id}/passkeys/begin\x12\xa4\x01\n" +
+	"\x19FinishPasskeyRegistration\x12,.profile_v1.FinishPasskeyRegistrationRequest\x1a-.profile_v1.FinishPasskeyRegistrationResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/profiles/{user_id}/passkeys\x12z\n" +
+	"\fListPasskeys\x12\x1f.profile_v1.ListPasskeysRequest\x1a .profile_v1.ListPasskeysResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/profiles/{user_id}/passkeys\x12\x8d\x01\n" +
+	"\rRenamePasskey\x12 .profile_v1.RenamePasskeyRequest\x1a!.profile_v1.RenamePasskeyResponse\"7\x82\xd3\xe4\x93\x021:\x01*2,/v1/profiles/{user_id}/passkeys/{passkey_id}\x12\x7f\n" +
+	"\rDeletePasskey\x12 .profile_v1.DeletePasskeyRequest\x1a\x16.google.protobuf.Empty\"4\x82\xd3\xe4\x93\x02.*,/v1/profiles/{user_id}/passkeys/{passkey_id}\x12}\n" +
+	"\fCreateApiKey\x12\x1f.profile_v1.CreateApiKeyRequest\x1a .profile_v1.CreateApiKeyResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/profiles/{user_id}/api-keys\x12w\n" +
+	"\vListApiKeys\x12\x1e.profile_v1.ListApiKeysRequest\x1a\x1f.profile_v1.ListApiKeysResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/profiles/{user_id}/api-keys\x12\x91\x01\n" +
+	"\fRevokeApiKey\x12\x1f.profile_v1.RevokeApiKeyRequest\x1a .profile_v1.RevokeApiKeyResponse\">\x82\xd3\xe4\x93\x028:\x01*\"3/v1/profiles/{user_id}/api-keys/{api_key_id}/revokeBNZLgithub.com/BlazeCoder04/online_store/services/user/pkg/profile/v1;profile_v1b\x06proto3"
 
 var (
 	file_profile_v1_profile_proto_rawDescOnce sync.Once
@@ -344,30 +2611,125 @@ func file_profile_v1_profile_proto_rawDescGZIP() []byte {
 	return file_profile_v1_profile_proto_rawDescData
 }
 
-var file_profile_v1_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_profile_v1_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_profile_v1_profile_proto_goTypes = []any{
-	(*GetRequest)(nil),     // 0: profile_v1.GetRequest
-	(*GetResponse)(nil),    // 1: profile_v1.GetResponse
-	(*UpdateRequest)(nil),  // 2: profile_v1.UpdateRequest
-	(*UpdateResponse)(nil), // 3: profile_v1.UpdateResponse
-	(*DeleteRequest)(nil),  // 4: profile_v1.DeleteRequest
-	(*user.User)(nil),      // 5: user.User
-	(*emptypb.Empty)(nil),  // 6: google.protobuf.Empty
+	(*GetRequest)(nil),                        // 0: profile_v1.GetRequest
+	(*GetResponse)(nil),                       // 1: profile_v1.GetResponse
+	(*UpdateRequest)(nil),                     // 2: profile_v1.UpdateRequest
+	(*UpdateResponse)(nil),                    // 3: profile_v1.UpdateResponse
+	(*DeleteRequest)(nil),                     // 4: profile_v1.DeleteRequest
+	(*SetPhoneRequest)(nil),                   // 5: profile_v1.SetPhoneRequest
+	(*SetPhoneResponse)(nil),                  // 6: profile_v1.SetPhoneResponse
+	(*VerifyPhoneRequest)(nil),                // 7: profile_v1.VerifyPhoneRequest
+	(*VerifyPhoneResponse)(nil),               // 8: profile_v1.VerifyPhoneResponse
+	(*UploadAvatarRequest)(nil),               // 9: profile_v1.UploadAvatarRequest
+	(*AvatarInfo)(nil),                        // 10: profile_v1.AvatarInfo
+	(*UploadAvatarResponse)(nil),              // 11: profile_v1.UploadAvatarResponse
+	(*Address)(nil),                           // 12: profile_v1.Address
+	(*Passkey)(nil),                           // 13: profile_v1.Passkey
+	(*ApiKey)(nil),                            // 14: profile_v1.ApiKey
+	(*AddressInput)(nil),                      // 15: profile_v1.AddressInput
+	(*ListAddressesRequest)(nil),              // 16: profile_v1.ListAddressesRequest
+	(*ListAddressesResponse)(nil),             // 17: profile_v1.ListAddressesResponse
+	(*GetAddressRequest)(nil),                 // 18: profile_v1.GetAddressRequest
+	(*GetAddressResponse)(nil),                // 19: profile_v1.GetAddressResponse
+	(*CreateAddressRequest)(nil),              // 20: profile_v1.CreateAddressRequest
+	(*CreateAddressResponse)(nil),             // 21: profile_v1.CreateAddressResponse
+	(*UpdateAddressRequest)(nil),              // 22: profile_v1.UpdateAddressRequest
+	(*UpdateAddressResponse)(nil),             // 23: profile_v1.UpdateAddressResponse
+	(*DeleteAddressRequest)(nil),              // 24: profile_v1.DeleteAddressRequest
+	(*BeginPasskeyRegistrationRequest)(nil),   // 25: profile_v1.BeginPasskeyRegistrationRequest
+	(*BeginPasskeyRegistrationResponse)(nil),  // 26: profile_v1.BeginPasskeyRegistrationResponse
+	(*FinishPasskeyRegistrationRequest)(nil),  // 27: profile_v1.FinishPasskeyRegistrationRequest
+	(*FinishPasskeyRegistrationResponse)(nil), // 28: profile_v1.FinishPasskeyRegistrationResponse
+	(*ListPasskeysRequest)(nil),               // 29: profile_v1.ListPasskeysRequest
+	(*ListPasskeysResponse)(nil),              // 30: profile_v1.ListPasskeysResponse
+	(*RenamePasskeyRequest)(nil),              // 31: profile_v1.RenamePasskeyRequest
+	(*RenamePasskeyResponse)(nil),             // 32: profile_v1.RenamePasskeyResponse
+	(*DeletePasskeyRequest)(nil),              // 33: profile_v1.DeletePasskeyRequest
+	(*CreateApiKeyRequest)(nil),               // 34: profile_v1.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),              // 35: profile_v1.CreateApiKeyResponse
+	(*ListApiKeysRequest)(nil),                // 36: profile_v1.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),               // 37: profile_v1.ListApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),               // 38: profile_v1.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),              // 39: profile_v1.RevokeApiKeyResponse
+	(*user.User)(nil),                         // 40: user.User
+	(*timestamppb.Timestamp)(nil),             // 41: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                   // 42: google.protobuf.Struct
+	(*emptypb.Empty)(nil),                     // 43: google.protobuf.Empty
 }
 var file_profile_v1_profile_proto_depIdxs = []int32{
-	5, // 0: profile_v1.GetResponse.data:type_name -> user.User
-	5, // 1: profile_v1.UpdateResponse.data:type_name -> user.User
-	0, // 2: profile_v1.ProfileV1.Get:input_type -> profile_v1.GetRequest
-	2, // 3: profile_v1.ProfileV1.Update:input_type -> profile_v1.UpdateRequest
-	4, // 4: profile_v1.ProfileV1.Delete:input_type -> profile_v1.DeleteRequest
-	1, // 5: profile_v1.ProfileV1.Get:output_type -> profile_v1.GetResponse
-	3, // 6: profile_v1.ProfileV1.Update:output_type -> profile_v1.UpdateResponse
-	6, // 7: profile_v1.ProfileV1.Delete:output_type -> google.protobuf.Empty
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	40, // 0: profile_v1.GetResponse.data:type_name -> user.User
+	40, // 1: profile_v1.UpdateResponse.data:type_name -> user.User
+	40, // 2: profile_v1.SetPhoneResponse.data:type_name -> user.User
+	40, // 3: profile_v1.VerifyPhoneResponse.data:type_name -> user.User
+	10, // 4: profile_v1.UploadAvatarRequest.info:type_name -> profile_v1.AvatarInfo
+	40, // 5: profile_v1.UploadAvatarResponse.data:type_name -> user.User
+	41, // 6: profile_v1.Address.created_at:type_name -> google.protobuf.Timestamp
+	41, // 7: profile_v1.Address.updated_at:type_name -> google.protobuf.Timestamp
+	41, // 8: profile_v1.Passkey.created_at:type_name -> google.protobuf.Timestamp
+	41, // 9: profile_v1.Passkey.last_used_at:type_name -> google.protobuf.Timestamp
+	41, // 10: profile_v1.ApiKey.expires_at:type_name -> google.protobuf.Timestamp
+	41, // 11: profile_v1.ApiKey.last_used_at:type_name -> google.protobuf.Timestamp
+	41, // 12: profile_v1.ApiKey.created_at:type_name -> google.protobuf.Timestamp
+	12, // 13: profile_v1.ListAddressesResponse.data:type_name -> profile_v1.Address
+	12, // 14: profile_v1.GetAddressResponse.data:type_name -> profile_v1.Address
+	15, // 15: profile_v1.CreateAddressRequest.address:type_name -> profile_v1.AddressInput
+	12, // 16: profile_v1.CreateAddressResponse.data:type_name -> profile_v1.Address
+	15, // 17: profile_v1.UpdateAddressRequest.address:type_name -> profile_v1.AddressInput
+	12, // 18: profile_v1.UpdateAddressResponse.data:type_name -> profile_v1.Address
+	42, // 19: profile_v1.BeginPasskeyRegistrationResponse.options:type_name -> google.protobuf.Struct
+	42, // 20: profile_v1.FinishPasskeyRegistrationRequest.credential:type_name -> google.protobuf.Struct
+	13, // 21: profile_v1.FinishPasskeyRegistrationResponse.data:type_name -> profile_v1.Passkey
+	13, // 22: profile_v1.ListPasskeysResponse.data:type_name -> profile_v1.Passkey
+	13, // 23: profile_v1.RenamePasskeyResponse.data:type_name -> profile_v1.Passkey
+	41, // 24: profile_v1.CreateApiKeyRequest.expires_at:type_name -> google.protobuf.Timestamp
+	14, // 25: profile_v1.CreateApiKeyResponse.data:type_name -> profile_v1.ApiKey
+	14, // 26: profile_v1.ListApiKeysResponse.data:type_name -> profile_v1.ApiKey
+	14, // 27: profile_v1.RevokeApiKeyResponse.data:type_name -> profile_v1.ApiKey
+	0,  // 28: profile_v1.ProfileV1.Get:input_type -> profile_v1.GetRequest
+	2,  // 29: profile_v1.ProfileV1.Update:input_type -> profile_v1.UpdateRequest
+	4,  // 30: profile_v1.ProfileV1.Delete:input_type -> profile_v1.DeleteRequest
+	5,  // 31: profile_v1.ProfileV1.SetPhone:input_type -> profile_v1.SetPhoneRequest
+	7,  // 32: profile_v1.ProfileV1.VerifyPhone:input_type -> profile_v1.VerifyPhoneRequest
+	9,  // 33: profile_v1.ProfileV1.UploadAvatar:input_type -> profile_v1.UploadAvatarRequest
+	16, // 34: profile_v1.ProfileV1.ListAddresses:input_type -> profile_v1.ListAddressesRequest
+	18, // 35: profile_v1.ProfileV1.GetAddress:input_type -> profile_v1.GetAddressRequest
+	20, // 36: profile_v1.ProfileV1.CreateAddress:input_type -> profile_v1.CreateAddressRequest
+	22, // 37: profile_v1.ProfileV1.UpdateAddress:input_type -> profile_v1.UpdateAddressRequest
+	24, // 38: profile_v1.ProfileV1.DeleteAddress:input_type -> profile_v1.DeleteAddressRequest
+	25, // 39: profile_v1.ProfileV1.BeginPasskeyRegistration:input_type -> profile_v1.BeginPasskeyRegistrationRequest
+	27, // 40: profile_v1.ProfileV1.FinishPasskeyRegistration:input_type -> profile_v1.FinishPasskeyRegistrationRequest
+	29, // 41: profile_v1.ProfileV1.ListPasskeys:input_type -> profile_v1.ListPasskeysRequest
+	31, // 42: profile_v1.ProfileV1.RenamePasskey:input_type -> profile_v1.RenamePasskeyRequest
+	33, // 43: profile_v1.ProfileV1.DeletePasskey:input_type -> profile_v1.DeletePasskeyRequest
+	34, // 44: profile_v1.ProfileV1.CreateApiKey:input_type -> profile_v1.CreateApiKeyRequest
+	36, // 45: profile_v1.ProfileV1.ListApiKeys:input_type -> profile_v1.ListApiKeysRequest
+	38, // 46: profile_v1.ProfileV1.RevokeApiKey:input_type -> profile_v1.RevokeApiKeyRequest
+	1,  // 47: profile_v1.ProfileV1.Get:output_type -> profile_v1.GetResponse
+	3,  // 48: profile_v1.ProfileV1.Update:output_type -> profile_v1.UpdateResponse
+	43, // 49: profile_v1.ProfileV1.Delete:output_type -> google.protobuf.Empty
+	6,  // 50: profile_v1.ProfileV1.SetPhone:output_type -> profile_v1.SetPhoneResponse
+	8,  // 51: profile_v1.ProfileV1.VerifyPhone:output_type -> profile_v1.VerifyPhoneResponse
+	11, // 52: profile_v1.ProfileV1.UploadAvatar:output_type -> profile_v1.UploadAvatarResponse
+	17, // 53: profile_v1.ProfileV1.ListAddresses:output_type -> profile_v1.ListAddressesResponse
+	19, // 54: profile_v1.ProfileV1.GetAddress:output_type -> profile_v1.GetAddressResponse
+	21, // 55: profile_v1.ProfileV1.CreateAddress:output_type -> profile_v1.CreateAddressResponse
+	23, // 56: profile_v1.ProfileV1.UpdateAddress:output_type -> profile_v1.UpdateAddressResponse
+	43, // 57: profile_v1.ProfileV1.DeleteAddress:output_type -> google.protobuf.Empty
+	26, // 58: profile_v1.ProfileV1.BeginPasskeyRegistration:output_type -> profile_v1.BeginPasskeyRegistrationResponse
+	28, // 59: profile_v1.ProfileV1.FinishPasskeyRegistration:output_type -> profile_v1.FinishPasskeyRegistrationResponse
+	30, // 60: profile_v1.ProfileV1.ListPasskeys:output_type -> profile_v1.ListPasskeysResponse
+	32, // 61: profile_v1.ProfileV1.RenamePasskey:output_type -> profile_v1.RenamePasskeyResponse
+	43, // 62: profile_v1.ProfileV1.DeletePasskey:output_type -> google.protobuf.Empty
+	35, // 63: profile_v1.ProfileV1.CreateApiKey:output_type -> profile_v1.CreateApiKeyResponse
+	37, // 64: profile_v1.ProfileV1.ListApiKeys:output_type -> profile_v1.ListApiKeysResponse
+	39, // 65: profile_v1.ProfileV1.RevokeApiKey:output_type -> profile_v1.RevokeApiKeyResponse
+	47, // [47:66] is the sub-list for method output_type
+	28, // [28:47] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_profile_v1_profile_proto_init() }
@@ -376,13 +2738,17 @@ func file_profile_v1_profile_proto_init() {
 		return
 	}
 	file_profile_v1_profile_proto_msgTypes[2].OneofWrappers = []any{}
+	file_profile_v1_profile_proto_msgTypes[9].OneofWrappers = []any{
+		(*UploadAvatarRequest_Info)(nil),
+		(*UploadAvatarRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_profile_v1_profile_proto_rawDesc), len(file_profile_v1_profile_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -616,3 +616,4487 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DeleteRequestValidationError{}
+
+// Validate checks the field values on SetPhoneRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetPhoneRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetPhoneRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetPhoneRequestMultiError, or nil if none found.
+func (m *SetPhoneRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetPhoneRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Phone
+
+	if len(errors) > 0 {
+		return SetPhoneRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetPhoneRequestMultiError is an error wrapping multiple validation errors
+// returned by SetPhoneRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetPhoneRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetPhoneRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetPhoneRequestMultiError) AllErrors() []error { return m }
+
+// SetPhoneRequestValidationError is the validation error returned by
+// SetPhoneRequest.Validate if the designated constraints aren't met.
+type SetPhoneRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetPhoneRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetPhoneRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetPhoneRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetPhoneRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetPhoneRequestValidationError) ErrorName() string { return "SetPhoneRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetPhoneRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetPhoneRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetPhoneRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetPhoneRequestValidationError{}
+
+// Validate checks the field values on SetPhoneResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetPhoneResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetPhoneResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetPhoneResponseMultiError, or nil if none found.
+func (m *SetPhoneResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetPhoneResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetPhoneResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetPhoneResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetPhoneResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetPhoneResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetPhoneResponseMultiError is an error wrapping multiple validation errors
+// returned by SetPhoneResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SetPhoneResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetPhoneResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetPhoneResponseMultiError) AllErrors() []error { return m }
+
+// SetPhoneResponseValidationError is the validation error returned by
+// SetPhoneResponse.Validate if the designated constraints aren't met.
+type SetPhoneResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetPhoneResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetPhoneResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetPhoneResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetPhoneResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetPhoneResponseValidationError) ErrorName() string { return "SetPhoneResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetPhoneResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetPhoneResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetPhoneResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetPhoneResponseValidationError{}
+
+// Validate checks the field values on VerifyPhoneRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyPhoneRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyPhoneRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyPhoneRequestMultiError, or nil if none found.
+func (m *VerifyPhoneRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyPhoneRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return VerifyPhoneRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyPhoneRequestMultiError is an error wrapping multiple validation errors
+// returned by VerifyPhoneRequest.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyPhoneRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyPhoneRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyPhoneRequestMultiError) AllErrors() []error { return m }
+
+// VerifyPhoneRequestValidationError is the validation error returned by
+// VerifyPhoneRequest.Validate if the designated constraints aren't met.
+type VerifyPhoneRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyPhoneRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyPhoneRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyPhoneRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyPhoneRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyPhoneRequestValidationError) ErrorName() string {
+	return "VerifyPhoneRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyPhoneRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyPhoneRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyPhoneRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyPhoneRequestValidationError{}
+
+// Validate checks the field values on VerifyPhoneResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyPhoneResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyPhoneResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyPhoneResponseMultiError, or nil if none found.
+func (m *VerifyPhoneResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyPhoneResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VerifyPhoneResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VerifyPhoneResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VerifyPhoneResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return VerifyPhoneResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyPhoneResponseMultiError is an error wrapping multiple validation
+// errors returned by VerifyPhoneResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyPhoneResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyPhoneResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyPhoneResponseMultiError) AllErrors() []error { return m }
+
+// VerifyPhoneResponseValidationError is the validation error returned by
+// VerifyPhoneResponse.Validate if the designated constraints aren't met.
+type VerifyPhoneResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyPhoneResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyPhoneResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyPhoneResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyPhoneResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyPhoneResponseValidationError) ErrorName() string {
+	return "VerifyPhoneResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyPhoneResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyPhoneResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyPhoneResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyPhoneResponseValidationError{}
+
+// Validate checks the field values on UploadAvatarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAvatarRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAvatarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAvatarRequestMultiError, or nil if none found.
+func (m *UploadAvatarRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAvatarRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *UploadAvatarRequest_Info:
+		if v == nil {
+			err := UploadAvatarRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadAvatarRequestValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadAvatarRequestValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadAvatarRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadAvatarRequest_Chunk:
+		if v == nil {
+			err := UploadAvatarRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return UploadAvatarRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAvatarRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadAvatarRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadAvatarRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAvatarRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAvatarRequestMultiError) AllErrors() []error { return m }
+
+// UploadAvatarRequestValidationError is the validation error returned by
+// UploadAvatarRequest.Validate if the designated constraints aren't met.
+type UploadAvatarRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAvatarRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAvatarRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAvatarRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAvatarRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAvatarRequestValidationError) ErrorName() string {
+	return "UploadAvatarRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAvatarRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAvatarRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAvatarRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAvatarRequestValidationError{}
+
+// Validate checks the field values on AvatarInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AvatarInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AvatarInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AvatarInfoMultiError, or
+// nil if none found.
+func (m *AvatarInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AvatarInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ContentType
+
+	if len(errors) > 0 {
+		return AvatarInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AvatarInfoMultiError is an error wrapping multiple validation errors
+// returned by AvatarInfo.ValidateAll() if the designated constraints aren't met.
+type AvatarInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AvatarInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AvatarInfoMultiError) AllErrors() []error { return m }
+
+// AvatarInfoValidationError is the validation error returned by
+// AvatarInfo.Validate if the designated constraints aren't met.
+type AvatarInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AvatarInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AvatarInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AvatarInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AvatarInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AvatarInfoValidationError) ErrorName() string { return "AvatarInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AvatarInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAvatarInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AvatarInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AvatarInfoValidationError{}
+
+// Validate checks the field values on UploadAvatarResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAvatarResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAvatarResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAvatarResponseMultiError, or nil if none found.
+func (m *UploadAvatarResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAvatarResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadAvatarResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadAvatarResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadAvatarResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadAvatarResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAvatarResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadAvatarResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UploadAvatarResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAvatarResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAvatarResponseMultiError) AllErrors() []error { return m }
+
+// UploadAvatarResponseValidationError is the validation error returned by
+// UploadAvatarResponse.Validate if the designated constraints aren't met.
+type UploadAvatarResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAvatarResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAvatarResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAvatarResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAvatarResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAvatarResponseValidationError) ErrorName() string {
+	return "UploadAvatarResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAvatarResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAvatarResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAvatarResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAvatarResponseValidationError{}
+
+// Validate checks the field values on Address with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Address) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Address with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AddressMultiError, or nil if none found.
+func (m *Address) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Address) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Label
+
+	// no validation rules for Recipient
+
+	// no validation rules for Line1
+
+	// no validation rules for Line2
+
+	// no validation rules for City
+
+	// no validation rules for Region
+
+	// no validation rules for PostalCode
+
+	// no validation rules for CountryCode
+
+	// no validation rules for Phone
+
+	// no validation rules for DefaultShipping
+
+	// no validation rules for DefaultBilling
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddressValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddressValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddressValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddressValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddressValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddressValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddressMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddressMultiError is an error wrapping multiple validation errors returned
+// by Address.ValidateAll() if the designated constraints aren't met.
+type AddressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddressMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddressMultiError) AllErrors() []error { return m }
+
+// AddressValidationError is the validation error returned by Address.Validate
+// if the designated constraints aren't met.
+type AddressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddressValidationError) ErrorName() string { return "AddressValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddressValidationError{}
+
+// Validate checks the field values on Passkey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Passkey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Passkey with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PasskeyMultiError, or nil if none found.
+func (m *Passkey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Passkey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Aaguid
+
+	// no validation rules for BackupEligible
+
+	// no validation rules for BackupState
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PasskeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PasskeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PasskeyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PasskeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PasskeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PasskeyValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PasskeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PasskeyMultiError is an error wrapping multiple validation errors returned
+// by Passkey.ValidateAll() if the designated constraints aren't met.
+type PasskeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PasskeyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PasskeyMultiError) AllErrors() []error { return m }
+
+// PasskeyValidationError is the validation error returned by Passkey.Validate
+// if the designated constraints aren't met.
+type PasskeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PasskeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PasskeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PasskeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PasskeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PasskeyValidationError) ErrorName() string { return "PasskeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PasskeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPasskey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PasskeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PasskeyValidationError{}
+
+// Validate checks the field values on ApiKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ApiKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ApiKeyMultiError, or nil if none found.
+func (m *ApiKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Prefix
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Revoked
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApiKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyMultiError is an error wrapping multiple validation errors returned by
+// ApiKey.ValidateAll() if the designated constraints aren't met.
+type ApiKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyMultiError) AllErrors() []error { return m }
+
+// ApiKeyValidationError is the validation error returned by ApiKey.Validate if
+// the designated constraints aren't met.
+type ApiKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyValidationError) ErrorName() string { return "ApiKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ApiKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyValidationError{}
+
+// Validate checks the field values on AddressInput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AddressInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddressInput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AddressInputMultiError, or
+// nil if none found.
+func (m *AddressInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddressInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Label
+
+	// no validation rules for Recipient
+
+	// no validation rules for Line1
+
+	// no validation rules for Line2
+
+	// no validation rules for City
+
+	// no validation rules for Region
+
+	// no validation rules for PostalCode
+
+	// no validation rules for CountryCode
+
+	// no validation rules for Phone
+
+	// no validation rules for DefaultShipping
+
+	// no validation rules for DefaultBilling
+
+	if len(errors) > 0 {
+		return AddressInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddressInputMultiError is an error wrapping multiple validation errors
+// returned by AddressInput.ValidateAll() if the designated constraints aren't met.
+type AddressInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddressInputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddressInputMultiError) AllErrors() []error { return m }
+
+// AddressInputValidationError is the validation error returned by
+// AddressInput.Validate if the designated constraints aren't met.
+type AddressInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddressInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddressInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddressInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddressInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddressInputValidationError) ErrorName() string { return "AddressInputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddressInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddressInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddressInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddressInputValidationError{}
+
+// Validate checks the field values on ListAddressesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAddressesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAddressesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAddressesRequestMultiError, or nil if none found.
+func (m *ListAddressesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAddressesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return ListAddressesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAddressesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAddressesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAddressesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAddressesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAddressesRequestMultiError) AllErrors() []error { return m }
+
+// ListAddressesRequestValidationError is the validation error returned by
+// ListAddressesRequest.Validate if the designated constraints aren't met.
+type ListAddressesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAddressesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAddressesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAddressesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAddressesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAddressesRequestValidationError) ErrorName() string {
+	return "ListAddressesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAddressesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAddressesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAddressesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAddressesRequestValidationError{}
+
+// Validate checks the field values on ListAddressesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAddressesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAddressesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAddressesResponseMultiError, or nil if none found.
+func (m *ListAddressesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAddressesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAddressesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAddressesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAddressesResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAddressesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAddressesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAddressesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAddressesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAddressesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAddressesResponseMultiError) AllErrors() []error { return m }
+
+// ListAddressesResponseValidationError is the validation error returned by
+// ListAddressesResponse.Validate if the designated constraints aren't met.
+type ListAddressesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAddressesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAddressesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAddressesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAddressesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAddressesResponseValidationError) ErrorName() string {
+	return "ListAddressesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAddressesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAddressesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAddressesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAddressesResponseValidationError{}
+
+// Validate checks the field values on GetAddressRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAddressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAddressRequestMultiError, or nil if none found.
+func (m *GetAddressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAddressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for AddressId
+
+	if len(errors) > 0 {
+		return GetAddressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAddressRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAddressRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAddressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAddressRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAddressRequestMultiError) AllErrors() []error { return m }
+
+// GetAddressRequestValidationError is the validation error returned by
+// GetAddressRequest.Validate if the designated constraints aren't met.
+type GetAddressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAddressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAddressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAddressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAddressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAddressRequestValidationError) ErrorName() string {
+	return "GetAddressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAddressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAddressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAddressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAddressRequestValidationError{}
+
+// Validate checks the field values on GetAddressResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAddressResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAddressResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAddressResponseMultiError, or nil if none found.
+func (m *GetAddressResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAddressResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAddressResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAddressResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAddressResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAddressResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAddressResponseMultiError is an error wrapping multiple validation errors
+// returned by GetAddressResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetAddressResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAddressResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAddressResponseMultiError) AllErrors() []error { return m }
+
+// GetAddressResponseValidationError is the validation error returned by
+// GetAddressResponse.Validate if the designated constraints aren't met.
+type GetAddressResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAddressResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAddressResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAddressResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAddressResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAddressResponseValidationError) ErrorName() string {
+	return "GetAddressResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAddressResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAddressResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAddressResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAddressResponseValidationError{}
+
+// Validate checks the field values on CreateAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAddressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAddressRequestMultiError, or nil if none found.
+func (m *CreateAddressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAddressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAddressRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAddressRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAddressRequestValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAddressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAddressRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAddressRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAddressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAddressRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAddressRequestMultiError) AllErrors() []error { return m }
+
+// CreateAddressRequestValidationError is the validation error returned by
+// CreateAddressRequest.Validate if the designated constraints aren't met.
+type CreateAddressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAddressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAddressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAddressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAddressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAddressRequestValidationError) ErrorName() string {
+	return "CreateAddressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAddressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAddressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAddressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAddressRequestValidationError{}
+
+// Validate checks the field values on CreateAddressResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAddressResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAddressResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAddressResponseMultiError, or nil if none found.
+func (m *CreateAddressResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAddressResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAddressResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAddressResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAddressResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAddressResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAddressResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAddressResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAddressResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAddressResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAddressResponseMultiError) AllErrors() []error { return m }
+
+// CreateAddressResponseValidationError is the validation error returned by
+// CreateAddressResponse.Validate if the designated constraints aren't met.
+type CreateAddressResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAddressResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAddressResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAddressResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAddressResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAddressResponseValidationError) ErrorName() string {
+	return "CreateAddressResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAddressResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAddressResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAddressResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAddressResponseValidationError{}
+
+// Validate checks the field values on UpdateAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAddressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAddressRequestMultiError, or nil if none found.
+func (m *UpdateAddressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAddressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for AddressId
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAddressRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAddressRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAddressRequestValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAddressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAddressRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateAddressRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAddressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAddressRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAddressRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAddressRequestValidationError is the validation error returned by
+// UpdateAddressRequest.Validate if the designated constraints aren't met.
+type UpdateAddressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAddressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAddressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAddressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAddressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAddressRequestValidationError) ErrorName() string {
+	return "UpdateAddressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAddressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAddressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAddressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAddressRequestValidationError{}
+
+// Validate checks the field values on UpdateAddressResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAddressResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAddressResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAddressResponseMultiError, or nil if none found.
+func (m *UpdateAddressResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAddressResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAddressResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAddressResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAddressResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAddressResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAddressResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateAddressResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAddressResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAddressResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAddressResponseMultiError) AllErrors() []error { return m }
+
+// UpdateAddressResponseValidationError is the validation error returned by
+// UpdateAddressResponse.Validate if the designated constraints aren't met.
+type UpdateAddressResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAddressResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAddressResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAddressResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAddressResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAddressResponseValidationError) ErrorName() string {
+	return "UpdateAddressResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAddressResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAddressResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAddressResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAddressResponseValidationError{}
+
+// Validate checks the field values on DeleteAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAddressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAddressRequestMultiError, or nil if none found.
+func (m *DeleteAddressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAddressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for AddressId
+
+	if len(errors) > 0 {
+		return DeleteAddressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAddressRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteAddressRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAddressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAddressRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAddressRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAddressRequestValidationError is the validation error returned by
+// DeleteAddressRequest.Validate if the designated constraints aren't met.
+type DeleteAddressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAddressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAddressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAddressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAddressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAddressRequestValidationError) ErrorName() string {
+	return "DeleteAddressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAddressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAddressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAddressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAddressRequestValidationError{}
+
+// Validate checks the field values on BeginPasskeyRegistrationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BeginPasskeyRegistrationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BeginPasskeyRegistrationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BeginPasskeyRegistrationRequestMultiError, or nil if none found.
+func (m *BeginPasskeyRegistrationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BeginPasskeyRegistrationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return BeginPasskeyRegistrationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BeginPasskeyRegistrationRequestMultiError is an error wrapping multiple
+// validation errors returned by BeginPasskeyRegistrationRequest.ValidateAll()
+// if the designated constraints aren't met.
+type BeginPasskeyRegistrationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BeginPasskeyRegistrationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BeginPasskeyRegistrationRequestMultiError) AllErrors() []error { return m }
+
+// BeginPasskeyRegistrationRequestValidationError is the validation error
+// returned by BeginPasskeyRegistrationRequest.Validate if the designated
+// constraints aren't met.
+type BeginPasskeyRegistrationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BeginPasskeyRegistrationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BeginPasskeyRegistrationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BeginPasskeyRegistrationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BeginPasskeyRegistrationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BeginPasskeyRegistrationRequestValidationError) ErrorName() string {
+	return "BeginPasskeyRegistrationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BeginPasskeyRegistrationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBeginPasskeyRegistrationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BeginPasskeyRegistrationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BeginPasskeyRegistrationRequestValidationError{}
+
+// Validate checks the field values on BeginPasskeyRegistrationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *BeginPasskeyRegistrationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BeginPasskeyRegistrationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BeginPasskeyRegistrationResponseMultiError, or nil if none found.
+func (m *BeginPasskeyRegistrationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BeginPasskeyRegistrationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CeremonyId
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BeginPasskeyRegistrationResponseValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BeginPasskeyRegistrationResponseValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BeginPasskeyRegistrationResponseValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BeginPasskeyRegistrationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BeginPasskeyRegistrationResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// BeginPasskeyRegistrationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BeginPasskeyRegistrationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BeginPasskeyRegistrationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BeginPasskeyRegistrationResponseMultiError) AllErrors() []error { return m }
+
+// BeginPasskeyRegistrationResponseValidationError is the validation error
+// returned by BeginPasskeyRegistrationResponse.Validate if the designated
+// constraints aren't met.
+type BeginPasskeyRegistrationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BeginPasskeyRegistrationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BeginPasskeyRegistrationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BeginPasskeyRegistrationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BeginPasskeyRegistrationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BeginPasskeyRegistrationResponseValidationError) ErrorName() string {
+	return "BeginPasskeyRegistrationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BeginPasskeyRegistrationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBeginPasskeyRegistrationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BeginPasskeyRegistrationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BeginPasskeyRegistrationResponseValidationError{}
+
+// Validate checks the field values on FinishPasskeyRegistrationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *FinishPasskeyRegistrationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishPasskeyRegistrationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// FinishPasskeyRegistrationRequestMultiError, or nil if none found.
+func (m *FinishPasskeyRegistrationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishPasskeyRegistrationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for CeremonyId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetCredential()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FinishPasskeyRegistrationRequestValidationError{
+					field:  "Credential",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FinishPasskeyRegistrationRequestValidationError{
+					field:  "Credential",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCredential()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FinishPasskeyRegistrationRequestValidationError{
+				field:  "Credential",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FinishPasskeyRegistrationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishPasskeyRegistrationRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// FinishPasskeyRegistrationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FinishPasskeyRegistrationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishPasskeyRegistrationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishPasskeyRegistrationRequestMultiError) AllErrors() []error { return m }
+
+// FinishPasskeyRegistrationRequestValidationError is the validation error
+// returned by FinishPasskeyRegistrationRequest.Validate if the designated
+// constraints aren't met.
+type FinishPasskeyRegistrationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishPasskeyRegistrationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishPasskeyRegistrationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishPasskeyRegistrationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishPasskeyRegistrationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishPasskeyRegistrationRequestValidationError) ErrorName() string {
+	return "FinishPasskeyRegistrationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishPasskeyRegistrationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishPasskeyRegistrationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishPasskeyRegistrationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishPasskeyRegistrationRequestValidationError{}
+
+// Validate checks the field values on FinishPasskeyRegistrationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *FinishPasskeyRegistrationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishPasskeyRegistrationResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// FinishPasskeyRegistrationResponseMultiError, or nil if none found.
+func (m *FinishPasskeyRegistrationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishPasskeyRegistrationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FinishPasskeyRegistrationResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FinishPasskeyRegistrationResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FinishPasskeyRegistrationResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FinishPasskeyRegistrationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishPasskeyRegistrationResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// FinishPasskeyRegistrationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type FinishPasskeyRegistrationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishPasskeyRegistrationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishPasskeyRegistrationResponseMultiError) AllErrors() []error { return m }
+
+// FinishPasskeyRegistrationResponseValidationError is the validation error
+// returned by FinishPasskeyRegistrationResponse.Validate if the designated
+// constraints aren't met.
+type FinishPasskeyRegistrationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishPasskeyRegistrationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishPasskeyRegistrationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishPasskeyRegistrationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishPasskeyRegistrationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishPasskeyRegistrationResponseValidationError) ErrorName() string {
+	return "FinishPasskeyRegistrationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishPasskeyRegistrationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishPasskeyRegistrationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishPasskeyRegistrationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishPasskeyRegistrationResponseValidationError{}
+
+// Validate checks the field values on ListPasskeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPasskeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPasskeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPasskeysRequestMultiError, or nil if none found.
+func (m *ListPasskeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPasskeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return ListPasskeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPasskeysRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPasskeysRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPasskeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPasskeysRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPasskeysRequestMultiError) AllErrors() []error { return m }
+
+// ListPasskeysRequestValidationError is the validation error returned by
+// ListPasskeysRequest.Validate if the designated constraints aren't met.
+type ListPasskeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPasskeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPasskeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPasskeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPasskeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPasskeysRequestValidationError) ErrorName() string {
+	return "ListPasskeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPasskeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPasskeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPasskeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPasskeysRequestValidationError{}
+
+// Validate checks the field values on ListPasskeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPasskeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPasskeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPasskeysResponseMultiError, or nil if none found.
+func (m *ListPasskeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPasskeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPasskeysResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPasskeysResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPasskeysResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPasskeysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPasskeysResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPasskeysResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPasskeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPasskeysResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPasskeysResponseMultiError) AllErrors() []error { return m }
+
+// ListPasskeysResponseValidationError is the validation error returned by
+// ListPasskeysResponse.Validate if the designated constraints aren't met.
+type ListPasskeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPasskeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPasskeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPasskeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPasskeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPasskeysResponseValidationError) ErrorName() string {
+	return "ListPasskeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPasskeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPasskeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPasskeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPasskeysResponseValidationError{}
+
+// Validate checks the field values on RenamePasskeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenamePasskeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenamePasskeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenamePasskeyRequestMultiError, or nil if none found.
+func (m *RenamePasskeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenamePasskeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for PasskeyId
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return RenamePasskeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenamePasskeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RenamePasskeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenamePasskeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenamePasskeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenamePasskeyRequestMultiError) AllErrors() []error { return m }
+
+// RenamePasskeyRequestValidationError is the validation error returned by
+// RenamePasskeyRequest.Validate if the designated constraints aren't met.
+type RenamePasskeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenamePasskeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenamePasskeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenamePasskeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenamePasskeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenamePasskeyRequestValidationError) ErrorName() string {
+	return "RenamePasskeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenamePasskeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenamePasskeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenamePasskeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenamePasskeyRequestValidationError{}
+
+// Validate checks the field values on RenamePasskeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenamePasskeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenamePasskeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenamePasskeyResponseMultiError, or nil if none found.
+func (m *RenamePasskeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenamePasskeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RenamePasskeyResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RenamePasskeyResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RenamePasskeyResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RenamePasskeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenamePasskeyResponseMultiError is an error wrapping multiple validation
+// errors returned by RenamePasskeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RenamePasskeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenamePasskeyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenamePasskeyResponseMultiError) AllErrors() []error { return m }
+
+// RenamePasskeyResponseValidationError is the validation error returned by
+// RenamePasskeyResponse.Validate if the designated constraints aren't met.
+type RenamePasskeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenamePasskeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenamePasskeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenamePasskeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenamePasskeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenamePasskeyResponseValidationError) ErrorName() string {
+	return "RenamePasskeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenamePasskeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenamePasskeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenamePasskeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenamePasskeyResponseValidationError{}
+
+// Validate checks the field values on DeletePasskeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePasskeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePasskeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePasskeyRequestMultiError, or nil if none found.
+func (m *DeletePasskeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePasskeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for PasskeyId
+
+	if len(errors) > 0 {
+		return DeletePasskeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePasskeyRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePasskeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePasskeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePasskeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePasskeyRequestMultiError) AllErrors() []error { return m }
+
+// DeletePasskeyRequestValidationError is the validation error returned by
+// DeletePasskeyRequest.Validate if the designated constraints aren't met.
+type DeletePasskeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePasskeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePasskeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePasskeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePasskeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePasskeyRequestValidationError) ErrorName() string {
+	return "DeletePasskeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePasskeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePasskeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePasskeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePasskeyRequestValidationError{}
+
+// Validate checks the field values on CreateApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateApiKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateApiKeyRequestMultiError, or nil if none found.
+func (m *CreateApiKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateApiKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateApiKeyRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateApiKeyRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateApiKeyRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateApiKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateApiKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateApiKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateApiKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateApiKeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateApiKeyRequestMultiError) AllErrors() []error { return m }
+
+// CreateApiKeyRequestValidationError is the validation error returned by
+// CreateApiKeyRequest.Validate if the designated constraints aren't met.
+type CreateApiKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateApiKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateApiKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateApiKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateApiKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateApiKeyRequestValidationError) ErrorName() string {
+	return "CreateApiKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateApiKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateApiKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateApiKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateApiKeyRequestValidationError{}
+
+// Validate checks the field values on CreateApiKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateApiKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateApiKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateApiKeyResponseMultiError, or nil if none found.
+func (m *CreateApiKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateApiKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateApiKeyResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateApiKeyResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateApiKeyResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return CreateApiKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateApiKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateApiKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateApiKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateApiKeyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateApiKeyResponseMultiError) AllErrors() []error { return m }
+
+// CreateApiKeyResponseValidationError is the validation error returned by
+// CreateApiKeyResponse.Validate if the designated constraints aren't met.
+type CreateApiKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateApiKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateApiKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateApiKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateApiKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateApiKeyResponseValidationError) ErrorName() string {
+	return "CreateApiKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateApiKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateApiKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateApiKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateApiKeyResponseValidationError{}
+
+// Validate checks the field values on ListApiKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListApiKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListApiKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListApiKeysRequestMultiError, or nil if none found.
+func (m *ListApiKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListApiKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return ListApiKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListApiKeysRequestMultiError is an error wrapping multiple validation errors
+// returned by ListApiKeysRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListApiKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListApiKeysRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListApiKeysRequestMultiError) AllErrors() []error { return m }
+
+// ListApiKeysRequestValidationError is the validation error returned by
+// ListApiKeysRequest.Validate if the designated constraints aren't met.
+type ListApiKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApiKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApiKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApiKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApiKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApiKeysRequestValidationError) ErrorName() string {
+	return "ListApiKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApiKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApiKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApiKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApiKeysRequestValidationError{}
+
+// Validate checks the field values on ListApiKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListApiKeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListApiKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListApiKeysResponseMultiError, or nil if none found.
+func (m *ListApiKeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListApiKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListApiKeysResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListApiKeysResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListApiKeysResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListApiKeysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListApiKeysResponseMultiError is an error wrapping multiple validation
+// errors returned by ListApiKeysResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListApiKeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListApiKeysResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListApiKeysResponseMultiError) AllErrors() []error { return m }
+
+// ListApiKeysResponseValidationError is the validation error returned by
+// ListApiKeysResponse.Validate if the designated constraints aren't met.
+type ListApiKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApiKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApiKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApiKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApiKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApiKeysResponseValidationError) ErrorName() string {
+	return "ListApiKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApiKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApiKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApiKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApiKeysResponseValidationError{}
+
+// Validate checks the field values on RevokeApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeApiKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeApiKeyRequestMultiError, or nil if none found.
+func (m *RevokeApiKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeApiKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ApiKeyId
+
+	if len(errors) > 0 {
+		return RevokeApiKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeApiKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeApiKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeApiKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeApiKeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeApiKeyRequestMultiError) AllErrors() []error { return m }
+
+// RevokeApiKeyRequestValidationError is the validation error returned by
+// RevokeApiKeyRequest.Validate if the designated constraints aren't met.
+type RevokeApiKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeApiKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeApiKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeApiKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeApiKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeApiKeyRequestValidationError) ErrorName() string {
+	return "RevokeApiKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeApiKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeApiKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeApiKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeApiKeyRequestValidationError{}
+
+// Validate checks the field values on RevokeApiKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeApiKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeApiKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeApiKeyResponseMultiError, or nil if none found.
+func (m *RevokeApiKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeApiKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevokeApiKeyResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevokeApiKeyResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevokeApiKeyResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RevokeApiKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeApiKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeApiKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeApiKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeApiKeyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeApiKeyResponseMultiError) AllErrors() []error { return m }
+
+// RevokeApiKeyResponseValidationError is the validation error returned by
+// RevokeApiKeyResponse.Validate if the designated constraints aren't met.
+type RevokeApiKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeApiKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeApiKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeApiKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeApiKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeApiKeyResponseValidationError) ErrorName() string {
+	return "RevokeApiKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeApiKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeApiKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeApiKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeApiKeyResponseValidationError{}
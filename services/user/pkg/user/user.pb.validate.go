@@ -64,8 +64,6 @@ func (m *User) validate(all bool) error {
 
 	// no validation rules for LastName
 
-	// no validation rules for Role
-
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
@@ -124,6 +122,12 @@ func (m *User) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Phone
+
+	// no validation rules for PhoneVerified
+
+	// no validation rules for AvatarUrls
+
 	if len(errors) > 0 {
 		return UserMultiError(errors)
 	}